@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/F0urward/proftwist-backend/config"
@@ -20,10 +21,29 @@ func main() {
 		log.Fatal(http.ListenAndServe(cfg.Metrics.Roadmap.Port, mux))
 	}()
 
-	httpServer := roadmapWire.InitializeRoadmapHttpServer(cfg, log, metrics)
+	wsServer := roadmapWire.InitializeRoadmapWsServer(cfg, log, metrics)
+
+	httpServer := roadmapWire.InitializeRoadmapHttpServer(cfg, wsServer, log, metrics)
 
 	grpcServer := roadmapWire.InitializeRoadmapGrpcServer(cfg, log, metrics)
 
+	if cfg.Workers.LinkCheck.Enabled {
+		linkCheckWorker := roadmapWire.InitializeLinkCheckWorker(cfg, metrics)
+		go linkCheckWorker.Start(context.Background())
+	}
+
+	if cfg.Workers.GoalReminder.Enabled {
+		goalReminderWorker := roadmapWire.InitializeGoalReminderWorker(cfg)
+		go goalReminderWorker.Start(context.Background())
+	}
+
+	for i := 0; i < cfg.Workers.Gamification.Count; i++ {
+		gamificationWorker := roadmapWire.InitializeGamificationWorker(cfg)
+		go gamificationWorker.Start(context.Background())
+	}
+
+	go wsServer.Run()
+
 	go httpServer.Run()
 
 	grpcServer.Run()
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/F0urward/proftwist-backend/config"
@@ -24,6 +25,16 @@ func main() {
 
 	grpcServer := roadmapinfoWire.InitializeRoadmapInfoGrpcServer(cfg, log, metrics)
 
+	if cfg.Workers.Reconciler.Enabled {
+		reconcilerWorker := roadmapinfoWire.InitializeReconcilerWorker(cfg, metrics)
+		go reconcilerWorker.Start(context.Background())
+	}
+
+	if cfg.Workers.Saga.Enabled {
+		sagaRecoveryWorker := roadmapinfoWire.InitializeSagaRecoveryWorker(cfg)
+		go sagaRecoveryWorker.Start(context.Background())
+	}
+
 	go httpServer.Run()
 
 	grpcServer.Run()
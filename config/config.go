@@ -18,13 +18,18 @@ type Config struct {
 	Service      ServiceConfig      `yaml:"service"`
 	Auth         AuthConfig         `yaml:"auth"`
 	GigaChat     GigaChatConfig     `yaml:"gigachat"`
+	LLM          LLMConfig          `yaml:"llm"`
 	WebSocket    WebSocketConfig    `yaml:"websocket"`
 	Kafka        KafkaConfig        `yaml:"kafka"`
 	ServiceHosts ServiceHostsConfig `yaml:"serviceHosts"`
 	Bot          BotConfig          `yaml:"bot"`
 	Workers      WorkersConfig      `yaml:"workers"`
 	Upload       UploadConfig       `yaml:"upload"`
+	Unfurl       UnfurlConfig       `yaml:"unfurl"`
 	Metrics      MetricsConfig      `yaml:"metrics"`
+	Certificate  CertificateConfig  `yaml:"certificate"`
+	Gamification GamificationConfig `yaml:"gamification"`
+	Calendar     CalendarConfig     `yaml:"calendar"`
 }
 
 type BotConfig struct {
@@ -73,6 +78,26 @@ type GigaChatConfig struct {
 	Insecure bool   `yaml:"insecure"`
 }
 
+type LLMConfig struct {
+	Providers map[string]LLMProviderConfig `yaml:"providers"`
+	Features  LLMFeaturesConfig            `yaml:"features"`
+}
+
+type LLMProviderConfig struct {
+	Type    string        `yaml:"type"`
+	BaseURL string        `yaml:"baseURL"`
+	APIKey  string        `yaml:"apiKey"`
+	Model   string        `yaml:"model"`
+	Timeout time.Duration `yaml:"timeout"`
+	Script  string        `yaml:"script"`
+}
+
+type LLMFeaturesConfig struct {
+	Roadmap    string `yaml:"roadmap"`
+	Moderation string `yaml:"moderation"`
+	Bot        string `yaml:"bot"`
+}
+
 type ServiceConfig struct {
 	HTTP HTTPConfig `yaml:"http"`
 	GRPC GRPCConfig `yaml:"grpc"`
@@ -131,12 +156,14 @@ type RedisConfig struct {
 }
 
 type AWSConfig struct {
-	Endpoint          string `yaml:"endpoint"`
-	FilesEndpoint     string `yaml:"filesEndpoint"`
-	MinioRootUser     string `yaml:"minioRootUser"`
-	MinioRootPassword string `yaml:"minioRootPassword"`
-	UseSSL            bool   `yaml:"useSSL"`
-	AvatarBucketName  string `yaml:"avatarBucketName"`
+	Endpoint              string `yaml:"endpoint"`
+	FilesEndpoint         string `yaml:"filesEndpoint"`
+	MinioRootUser         string `yaml:"minioRootUser"`
+	MinioRootPassword     string `yaml:"minioRootPassword"`
+	UseSSL                bool   `yaml:"useSSL"`
+	AvatarBucketName      string `yaml:"avatarBucketName"`
+	MaterialBucketName    string `yaml:"materialBucketName"`
+	CertificateBucketName string `yaml:"certificateBucketName"`
 }
 
 type WebSocketConfig struct {
@@ -158,11 +185,13 @@ type KafkaConfig struct {
 type KafkaConsumersConfig struct {
 	Notification ConsumerConfig `yaml:"notification"`
 	Bot          ConsumerConfig `yaml:"bot"`
+	Gamification ConsumerConfig `yaml:"gamification"`
 }
 
 type KafkaProducersConfig struct {
 	Notification ProducerConfig `yaml:"notification"`
 	Bot          ConsumerConfig `yaml:"bot"`
+	Gamification ProducerConfig `yaml:"gamification"`
 }
 
 type ConsumerConfig struct {
@@ -177,20 +206,124 @@ type ProducerConfig struct {
 type WorkersConfig struct {
 	Notification WorkersCountConfig `yaml:"notification"`
 	Bot          WorkersCountConfig `yaml:"bot"`
+	Gamification WorkersCountConfig `yaml:"gamification"`
+	LinkCheck    LinkCheckConfig    `yaml:"linkCheck"`
+	GoalReminder GoalReminderConfig `yaml:"goalReminder"`
+	Reconciler   ReconcilerConfig   `yaml:"reconciler"`
+	Saga         SagaConfig         `yaml:"saga"`
 }
 
 type WorkersCountConfig struct {
 	Count int `yaml:"count"`
 }
 
+type LinkCheckConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	Interval       time.Duration `yaml:"interval"`
+	RecheckAfter   time.Duration `yaml:"recheckAfter"`
+	BatchSize      int           `yaml:"batchSize"`
+	RequestTimeout time.Duration `yaml:"requestTimeout"`
+	HostDelay      time.Duration `yaml:"hostDelay"`
+	MaxRedirects   int           `yaml:"maxRedirects"`
+	UserAgent      string        `yaml:"userAgent"`
+}
+
+type GoalReminderConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	Interval    time.Duration `yaml:"interval"`
+	RemindEvery time.Duration `yaml:"remindEvery"`
+	GracePeriod time.Duration `yaml:"gracePeriod"`
+	BatchSize   int           `yaml:"batchSize"`
+}
+
+// ReconcilerConfig controls the consistency check between Mongo roadmaps and
+// Postgres roadmap info. Without Apply the reconciler runs in dry-run mode and
+// only reports what it finds. Records younger than GracePeriod are skipped, so
+// operations still in flight are not mistaken for inconsistencies.
+type ReconcilerConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	Interval    time.Duration `yaml:"interval"`
+	Apply       bool          `yaml:"apply"`
+	GracePeriod time.Duration `yaml:"gracePeriod"`
+}
+
+// SagaConfig controls fork and publish sagas. A failed step is retried up to
+// MaxAttempts times, RetryDelay apart. Sagas that have not moved for
+// StalledAfter, e.g. because the service restarted, are resumed by the worker.
+type SagaConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	Interval     time.Duration `yaml:"interval"`
+	MaxAttempts  int           `yaml:"maxAttempts"`
+	RetryDelay   time.Duration `yaml:"retryDelay"`
+	StalledAfter time.Duration `yaml:"stalledAfter"`
+	BatchSize    int           `yaml:"batchSize"`
+}
+
 type UploadConfig struct {
-	Avatar AvatarConfig `yaml:"avatar"`
+	Avatar   AvatarConfig   `yaml:"avatar"`
+	Material MaterialConfig `yaml:"material"`
 }
 
 type AvatarConfig struct {
 	MaxSize int64 `yaml:"maxSize"`
 }
 
+type MaterialConfig struct {
+	MaxSize int64 `yaml:"maxSize"`
+}
+
+type UnfurlConfig struct {
+	Timeout      time.Duration `yaml:"timeout"`
+	MaxBodySize  int64         `yaml:"maxBodySize"`
+	MaxRedirects int           `yaml:"maxRedirects"`
+	UserAgent    string        `yaml:"userAgent"`
+}
+
+type CertificateConfig struct {
+	SigningKey string `yaml:"signingKey"`
+}
+
+type GamificationConfig struct {
+	DefaultTimezone string            `yaml:"defaultTimezone"`
+	LevelXP         int               `yaml:"levelXP"`
+	Rules           []XPRuleConfig    `yaml:"rules"`
+	Badges          []BadgeRuleConfig `yaml:"badges"`
+}
+
+// XPRuleConfig sets how much XP an event type is worth. DailyLimit caps how
+// many events of the type earn XP per local day, and Streak makes the event
+// count as learning activity for daily streaks.
+type XPRuleConfig struct {
+	Event      string `yaml:"event"`
+	XP         int    `yaml:"xp"`
+	DailyLimit int    `yaml:"dailyLimit"`
+	Streak     bool   `yaml:"streak"`
+}
+
+// BadgeRuleConfig awards a badge once Metric reaches Threshold. Metric is an
+// event type, or one of "xp", "level" and "streak".
+type BadgeRuleConfig struct {
+	Code        string `yaml:"code"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	Metric      string `yaml:"metric"`
+	Threshold   int    `yaml:"threshold"`
+}
+
+// CalendarConfig sets how the study plan feed is scheduled. Sessions start at
+// SessionHour local time in Timezone and are planned up to Horizon ahead.
+// Feeds older than RefreshAfter are regenerated when a client polls them.
+type CalendarConfig struct {
+	FeedBaseURL       string        `yaml:"feedBaseURL"`
+	Timezone          string        `yaml:"timezone"`
+	SessionHour       int           `yaml:"sessionHour"`
+	SessionDuration   time.Duration `yaml:"sessionDuration"`
+	DefaultWeeklyPace int           `yaml:"defaultWeeklyPace"`
+	Horizon           time.Duration `yaml:"horizon"`
+	MaxEvents         int           `yaml:"maxEvents"`
+	RefreshAfter      time.Duration `yaml:"refreshAfter"`
+}
+
 type MetricsConfig struct {
 	Auth        ServicePort `yaml:"auth"`
 	Category    ServicePort `yaml:"category"`
@@ -279,6 +412,10 @@ func bindEnv(v *viper.Viper) error {
 		"gigachat.scope":    "GIGACHAT_SCOPE",
 		"gigachat.insecure": "GIGACHAT_INSECURE",
 
+		"llm.features.roadmap":    "LLM_ROADMAP_PROVIDER",
+		"llm.features.moderation": "LLM_MODERATION_PROVIDER",
+		"llm.features.bot":        "LLM_BOT_PROVIDER",
+
 		"websocket.writeWait":      "WEBSOCKET_WRITE_WAIT",
 		"websocket.pongWait":       "WEBSOCKET_PONG_WAIT",
 		"websocket.pingPeriod":     "WEBSOCKET_PING_PERIOD",
@@ -300,6 +437,12 @@ func bindEnv(v *viper.Viper) error {
 
 		"bot.botUserId":        "BOT_USER_ID",
 		"bot.botTriggerPhrase": "BOT_TRIGGER_PHRASE",
+
+		"certificate.signingKey": "CERTIFICATE_SIGNING_KEY",
+
+		"calendar.feedBaseURL": "CALENDAR_FEED_BASE_URL",
+
+		"workers.reconciler.apply": "RECONCILER_APPLY",
 	}
 
 	for key, env := range envBindings {
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS prompt_template (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    version INT NOT NULL,
+    system_prompt TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    weight INT NOT NULL DEFAULT 0 CHECK (weight BETWEEN 0 AND 100),
+    author_id UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (name, version)
+);
+
+CREATE INDEX IF NOT EXISTS prompt_template_active_idx ON prompt_template(name) WHERE weight > 0;
+
+CREATE TABLE IF NOT EXISTS prompt_usage (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_name TEXT NOT NULL,
+    version INT NOT NULL,
+    subject_id TEXT NOT NULL DEFAULT '',
+    outcome TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS prompt_usage_template_idx ON prompt_usage(template_name, version);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS prompt_usage_template_idx;
+DROP TABLE IF EXISTS prompt_usage;
+
+DROP INDEX IF EXISTS prompt_template_active_idx;
+DROP TABLE IF EXISTS prompt_template;
+
+-- +goose StatementEnd
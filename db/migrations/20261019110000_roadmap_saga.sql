@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS roadmap_saga (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    idempotency_key TEXT NOT NULL,
+    source_roadmap_info_id UUID NOT NULL,
+    roadmap_id TEXT NOT NULL,
+    roadmap_info_id UUID,
+    carry_progress BOOLEAN NOT NULL DEFAULT false,
+    status TEXT NOT NULL,
+    step TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (user_id, kind, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS roadmap_saga_pending_idx ON roadmap_saga(updated_at) WHERE status IN ('running', 'compensating');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS roadmap_saga_pending_idx;
+DROP TABLE IF EXISTS roadmap_saga;
+
+-- +goose StatementEnd
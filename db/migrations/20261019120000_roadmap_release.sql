@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE roadmap_info ADD COLUMN IF NOT EXISTS unpublished_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS roadmap_release (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    roadmap_info_id UUID NOT NULL REFERENCES roadmap_info(id) ON DELETE CASCADE,
+    draft_roadmap_info_id UUID REFERENCES roadmap_info(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    version INT,
+    status TEXT NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    reviewer_id UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    review_comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP,
+    released_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS roadmap_release_open_idx ON roadmap_release(roadmap_info_id)
+    WHERE status IN ('draft', 'in_review', 'approved', 'rejected');
+CREATE UNIQUE INDEX IF NOT EXISTS roadmap_release_draft_idx ON roadmap_release(draft_roadmap_info_id)
+    WHERE draft_roadmap_info_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS roadmap_release_version_idx ON roadmap_release(roadmap_info_id, version)
+    WHERE version IS NOT NULL;
+CREATE INDEX IF NOT EXISTS roadmap_release_status_idx ON roadmap_release(status);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS roadmap_release_status_idx;
+DROP INDEX IF EXISTS roadmap_release_version_idx;
+DROP INDEX IF EXISTS roadmap_release_draft_idx;
+DROP INDEX IF EXISTS roadmap_release_open_idx;
+DROP TABLE IF EXISTS roadmap_release;
+
+ALTER TABLE roadmap_info DROP COLUMN IF EXISTS unpublished_at;
+
+-- +goose StatementEnd
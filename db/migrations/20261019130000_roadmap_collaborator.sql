@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS roadmap_collaborator (
+    roadmap_info_id UUID NOT NULL REFERENCES roadmap_info(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    status TEXT NOT NULL,
+    invited_by UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (roadmap_info_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS roadmap_collaborator_user_idx ON roadmap_collaborator(user_id, status);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS roadmap_collaborator_user_idx;
+DROP TABLE IF EXISTS roadmap_collaborator;
+
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CalendarEventKind string
+
+const (
+	CalendarEventStudySession CalendarEventKind = "study_session"
+	CalendarEventGoalDeadline CalendarEventKind = "goal_deadline"
+)
+
+// CalendarFeed is a user's personal study plan published as an iCalendar feed.
+// Token is the secret part of the feed URL; rotating it revokes the old URL.
+// Events are regenerated when the user's progress or goals change, so the
+// schedule stays put between polls of calendar clients.
+type CalendarFeed struct {
+	ID          primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID      uuid.UUID          `json:"user_id" bson:"user_id"`
+	Token       string             `json:"token" bson:"token"`
+	Events      []CalendarEvent    `json:"events" bson:"events"`
+	GeneratedAt *time.Time         `json:"generated_at,omitempty" bson:"generated_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// CalendarEvent is a scheduled study session for a node, or the deadline of a
+// learning goal. Deadlines are all-day events on the local deadline date.
+type CalendarEvent struct {
+	UID         string             `json:"uid" bson:"uid"`
+	Kind        CalendarEventKind  `json:"kind" bson:"kind"`
+	RoadmapID   primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID      *uuid.UUID         `json:"node_id,omitempty" bson:"node_id,omitempty"`
+	Summary     string             `json:"summary" bson:"summary"`
+	Description string             `json:"description,omitempty" bson:"description,omitempty"`
+	Start       time.Time          `json:"start" bson:"start"`
+	End         time.Time          `json:"end" bson:"end"`
+	AllDay      bool               `json:"all_day" bson:"all_day"`
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Certificate confirms that a user completed a specific version of a roadmap.
+type Certificate struct {
+	ID             uuid.UUID          `json:"id" bson:"id"`
+	UserID         uuid.UUID          `json:"user_id" bson:"user_id"`
+	UserName       string             `json:"user_name" bson:"user_name"`
+	RoadmapID      primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	RoadmapName    string             `json:"roadmap_name" bson:"roadmap_name"`
+	RoadmapVersion string             `json:"roadmap_version" bson:"roadmap_version"`
+	IssuedAt       time.Time          `json:"issued_at" bson:"issued_at"`
+	Signature      string             `json:"signature" bson:"signature"`
+	File           *MaterialFile      `json:"file,omitempty" bson:"file,omitempty"`
+	URL            string             `json:"url,omitempty" bson:"url,omitempty"`
+}
+
+// SigningPayload is the canonical representation of the certificate fields
+// covered by its signature.
+func (c *Certificate) SigningPayload() string {
+	return strings.Join([]string{
+		c.ID.String(),
+		c.UserID.String(),
+		c.UserName,
+		c.RoadmapID.Hex(),
+		c.RoadmapName,
+		c.RoadmapVersion,
+		c.IssuedAt.UTC().Format(time.RFC3339),
+	}, "\n")
+}
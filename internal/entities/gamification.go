@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GamificationProfile holds the XP, level, streak and badges a user has
+// earned. Counters track how many events of every type earned XP, and Version
+// guards concurrent updates from several workers. DailyCounters and
+// RecentEvents are saved together with the XP, so daily limits and
+// redelivered events are checked against the same version of the profile.
+type GamificationProfile struct {
+	ID             primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID         uuid.UUID          `json:"user_id" bson:"user_id"`
+	XP             int                `json:"xp" bson:"xp"`
+	Level          int                `json:"level" bson:"level"`
+	Counters       map[string]int     `json:"counters" bson:"counters"`
+	CurrentStreak  int                `json:"current_streak" bson:"current_streak"`
+	LongestStreak  int                `json:"longest_streak" bson:"longest_streak"`
+	LastActiveDate string             `json:"last_active_date,omitempty" bson:"last_active_date,omitempty"`
+	Timezone       string             `json:"timezone" bson:"timezone"`
+	Badges         []EarnedBadge      `json:"badges" bson:"badges"`
+	DailyCounters  map[string]int     `json:"daily_counters" bson:"daily_counters"`
+	RecentEvents   []AppliedXPEvent   `json:"recent_events" bson:"recent_events"`
+	Version        int64              `json:"version" bson:"version"`
+	UpdatedAt      time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// AppliedXPEvent is an event already applied to the profile and the XP it
+// earned.
+type AppliedXPEvent struct {
+	EventID string `json:"event_id" bson:"event_id"`
+	XP      int    `json:"xp" bson:"xp"`
+}
+
+func (p *GamificationProfile) AppliedEvent(eventID string) *AppliedXPEvent {
+	for i := range p.RecentEvents {
+		if p.RecentEvents[i].EventID == eventID {
+			return &p.RecentEvents[i]
+		}
+	}
+	return nil
+}
+
+type EarnedBadge struct {
+	Code      string    `json:"code" bson:"code"`
+	AwardedAt time.Time `json:"awarded_at" bson:"awarded_at"`
+}
+
+func (p *GamificationProfile) Badge(code string) *EarnedBadge {
+	for i := range p.Badges {
+		if p.Badges[i].Code == code {
+			return &p.Badges[i]
+		}
+	}
+	return nil
+}
+
+// XPEvent is a processed activity event. EventID is set by the producer and
+// makes redelivered or repeated events award XP only once. Pending stays set
+// until the event has been applied to the profile, so an event whose
+// processing failed halfway is picked up again on redelivery.
+type XPEvent struct {
+	ID          primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	EventID     string             `json:"event_id" bson:"event_id"`
+	UserID      uuid.UUID          `json:"user_id" bson:"user_id"`
+	Type        string             `json:"type" bson:"type"`
+	SubjectID   string             `json:"subject_id,omitempty" bson:"subject_id,omitempty"`
+	XP          int                `json:"xp" bson:"xp"`
+	LocalDate   string             `json:"local_date" bson:"local_date"`
+	OccurredAt  time.Time          `json:"occurred_at" bson:"occurred_at"`
+	ProcessedAt time.Time          `json:"processed_at" bson:"processed_at"`
+	Pending     bool               `json:"pending,omitempty" bson:"pending,omitempty"`
+}
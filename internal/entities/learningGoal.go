@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LearningGoalStatus string
+
+const (
+	LearningGoalOnTrack   LearningGoalStatus = "on_track"
+	LearningGoalBehind    LearningGoalStatus = "behind"
+	LearningGoalOverdue   LearningGoalStatus = "overdue"
+	LearningGoalCompleted LearningGoalStatus = "completed"
+)
+
+// LearningGoal is the date by which a user wants to finish a roadmap. WeeklyPace
+// is the number of nodes the user plans to finish per week; zero means the
+// pace follows from the deadline alone.
+type LearningGoal struct {
+	ID               primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID           uuid.UUID          `json:"user_id" bson:"user_id"`
+	RoadmapID        primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	Deadline         time.Time          `json:"deadline" bson:"deadline"`
+	WeeklyPace       int                `json:"weekly_pace" bson:"weekly_pace"`
+	RemindersEnabled bool               `json:"reminders_enabled" bson:"reminders_enabled"`
+	CheckedAt        *time.Time         `json:"checked_at,omitempty" bson:"checked_at,omitempty"`
+	LastRemindedAt   *time.Time         `json:"last_reminded_at,omitempty" bson:"last_reminded_at,omitempty"`
+	CreatedAt        time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// LearningGoalProjection compares a goal with the user's progress. Paces are
+// measured in nodes per week.
+type LearningGoalProjection struct {
+	Status                LearningGoalStatus
+	TotalNodes            int
+	FinishedNodes         int
+	RemainingNodes        int
+	ActualPace            float64
+	RequiredPace          float64
+	ProjectedCompletionAt *time.Time
+}
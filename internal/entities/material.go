@@ -4,13 +4,124 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MaterialType string
+
+const (
+	MaterialTypeArticle       MaterialType = "article"
+	MaterialTypeVideo         MaterialType = "video"
+	MaterialTypeCourse        MaterialType = "course"
+	MaterialTypeBook          MaterialType = "book"
+	MaterialTypeDocumentation MaterialType = "documentation"
+	MaterialTypeExercise      MaterialType = "exercise"
+	MaterialTypeFile          MaterialType = "file"
+)
+
+type MaterialDifficulty string
+
+const (
+	MaterialDifficultyBeginner     MaterialDifficulty = "beginner"
+	MaterialDifficultyIntermediate MaterialDifficulty = "intermediate"
+	MaterialDifficultyAdvanced     MaterialDifficulty = "advanced"
 )
 
 type Material struct {
-	ID        uuid.UUID
-	Name      string
-	URL       string
-	AuthorID  uuid.UUID
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              uuid.UUID
+	Name            string
+	URL             string
+	Type            MaterialType
+	DurationMinutes int
+	Language        string
+	Difficulty      MaterialDifficulty
+	IsPaid          bool
+	File            *MaterialFile
+	Preview         *MaterialPreview
+	LinkCheck       *MaterialLinkCheck
+	AuthorID        uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type MaterialFile struct {
+	Bucket      string
+	Key         string
+	Name        string
+	Size        int64
+	ContentType string
+}
+
+// MaterialPreview is the link metadata fetched from the material page when it is added.
+type MaterialPreview struct {
+	Title       string
+	Description string
+	SiteName    string
+	FaviconURL  string
+	ImageURL    string
+	FetchedAt   time.Time
+}
+
+type MaterialLinkStatus string
+
+const (
+	MaterialLinkOK         MaterialLinkStatus = "ok"
+	MaterialLinkRedirected MaterialLinkStatus = "redirected"
+	MaterialLinkRestricted MaterialLinkStatus = "restricted"
+	MaterialLinkBlocked    MaterialLinkStatus = "blocked"
+	MaterialLinkBroken     MaterialLinkStatus = "broken"
+)
+
+// MaterialLinkCheck is the outcome of the last broken link check of a material URL.
+// Restricted links answered with 401, 403 or 429 and blocked links are disallowed
+// by robots.txt, so neither is reported as broken.
+type MaterialLinkCheck struct {
+	Status     MaterialLinkStatus
+	StatusCode int
+	FinalURL   string
+	Redirects  []string
+	Error      string
+	CheckedAt  time.Time
+}
+
+type MaterialLinkTarget struct {
+	RoadmapID primitive.ObjectID `bson:"roadmap_id"`
+	NodeID    uuid.UUID          `bson:"node_id"`
+	Material  Material           `bson:"material"`
+}
+
+type NodeSuggestions struct {
+	Description string
+	Materials   []MaterialSuggestion
+}
+
+type MaterialSuggestion struct {
+	Name string
+	Type MaterialType
+	URL  string
+}
+
+func (t MaterialType) IsValid() bool {
+	switch t {
+	case MaterialTypeArticle, MaterialTypeVideo, MaterialTypeCourse, MaterialTypeBook,
+		MaterialTypeDocumentation, MaterialTypeExercise, MaterialTypeFile:
+		return true
+	}
+	return false
+}
+
+// OrDefault treats materials created before types were introduced as articles.
+func (t MaterialType) OrDefault() MaterialType {
+	if t == "" {
+		return MaterialTypeArticle
+	}
+	return t
+}
+
+func (d MaterialDifficulty) IsValid() bool {
+	switch d {
+	case MaterialDifficultyBeginner, MaterialDifficultyIntermediate, MaterialDifficultyAdvanced:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MaterialVoteValue string
+
+const (
+	MaterialVoteNone MaterialVoteValue = ""
+	MaterialVoteUp   MaterialVoteValue = "up"
+	MaterialVoteDown MaterialVoteValue = "down"
+)
+
+// wilsonZ is the z-score for a 95% confidence interval.
+const wilsonZ = 1.96
+
+type MaterialVote struct {
+	ID         primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID     uuid.UUID          `json:"user_id" bson:"user_id"`
+	RoadmapID  primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID     uuid.UUID          `json:"node_id" bson:"node_id"`
+	MaterialID uuid.UUID          `json:"material_id" bson:"material_id"`
+	Value      MaterialVoteValue  `json:"value" bson:"value"`
+	Helpful    bool               `json:"helpful" bson:"helpful"`
+	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+type MaterialVoteStats struct {
+	MaterialID uuid.UUID `json:"material_id" bson:"_id"`
+	Upvotes    int       `json:"upvotes" bson:"upvotes"`
+	Downvotes  int       `json:"downvotes" bson:"downvotes"`
+	Helpful    int       `json:"helpful" bson:"helpful"`
+}
+
+func (v MaterialVoteValue) IsValid() bool {
+	switch v {
+	case MaterialVoteNone, MaterialVoteUp, MaterialVoteDown:
+		return true
+	}
+	return false
+}
+
+// IsEmpty reports whether the vote carries no signal and can be dropped.
+func (v *MaterialVote) IsEmpty() bool {
+	return v.Value == MaterialVoteNone && !v.Helpful
+}
+
+// WilsonScore returns the lower bound of the Wilson score interval for the
+// share of upvotes, so a few early upvotes do not outrank a well-reviewed material.
+func (s *MaterialVoteStats) WilsonScore() float64 {
+	if s == nil {
+		return 0
+	}
+
+	n := float64(s.Upvotes + s.Downvotes)
+	if n == 0 {
+		return 0
+	}
+
+	p := float64(s.Upvotes) / n
+	z2 := wilsonZ * wilsonZ
+
+	return (p + z2/(2*n) - wilsonZ*math.Sqrt((p*(1-p)+z2/(4*n))/n)) / (1 + z2/n)
+}
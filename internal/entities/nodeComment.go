@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CommentVoteValue string
+
+const (
+	CommentVoteNone CommentVoteValue = ""
+	CommentVoteUp   CommentVoteValue = "up"
+	CommentVoteDown CommentVoteValue = "down"
+)
+
+type CommentSort string
+
+const (
+	CommentSortTop CommentSort = "top"
+	CommentSortNew CommentSort = "new"
+)
+
+// NodeComment is a discussion entry attached to a roadmap node. Replies point
+// to the top level comment through ParentID, so threads are one level deep.
+type NodeComment struct {
+	ID         uuid.UUID          `json:"id" bson:"id"`
+	RoadmapID  primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID     uuid.UUID          `json:"node_id" bson:"node_id"`
+	ParentID   *uuid.UUID         `json:"parent_id,omitempty" bson:"parent_id"`
+	AuthorID   uuid.UUID          `json:"author_id" bson:"author_id"`
+	Content    string             `json:"content" bson:"content"`
+	Upvoters   []uuid.UUID        `json:"upvoters" bson:"upvoters"`
+	Downvoters []uuid.UUID        `json:"downvoters" bson:"downvoters"`
+	Score      int                `json:"score" bson:"score"`
+	ReplyCount int                `json:"reply_count" bson:"reply_count"`
+	IsEdited   bool               `json:"is_edited" bson:"is_edited"`
+	IsDeleted  bool               `json:"is_deleted" bson:"is_deleted"`
+	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+func (v CommentVoteValue) IsValid() bool {
+	switch v {
+	case CommentVoteNone, CommentVoteUp, CommentVoteDown:
+		return true
+	}
+	return false
+}
+
+func (s CommentSort) IsValid() bool {
+	return s == CommentSortTop || s == CommentSortNew
+}
+
+func (c *NodeComment) VoteOf(userID uuid.UUID) CommentVoteValue {
+	for _, id := range c.Upvoters {
+		if id == userID {
+			return CommentVoteUp
+		}
+	}
+	for _, id := range c.Downvoters {
+		if id == userID {
+			return CommentVoteDown
+		}
+	}
+	return CommentVoteNone
+}
@@ -10,14 +10,79 @@ import (
 type NodeProgressStatus string
 
 const (
-	NodeProgressPending    NodeProgressStatus = "ожидает"
-	NodeProgressInProgress NodeProgressStatus = "в процессе"
-	NodeProgressDone       NodeProgressStatus = "завершено"
-	NodeProgressSkipped    NodeProgressStatus = "пропущено"
+	NodeProgressPending    NodeProgressStatus = "pending"
+	NodeProgressInProgress NodeProgressStatus = "in_progress"
+	NodeProgressDone       NodeProgressStatus = "done"
+	NodeProgressSkipped    NodeProgressStatus = "skipped"
 )
 
+const DefaultLanguage = "ru"
+
+var nodeProgressStatusLabels = map[string]map[NodeProgressStatus]string{
+	"ru": {
+		NodeProgressPending:    "ожидает",
+		NodeProgressInProgress: "в процессе",
+		NodeProgressDone:       "завершено",
+		NodeProgressSkipped:    "пропущено",
+	},
+	"en": {
+		NodeProgressPending:    "pending",
+		NodeProgressInProgress: "in progress",
+		NodeProgressDone:       "done",
+		NodeProgressSkipped:    "skipped",
+	},
+}
+
+// ParseNodeProgressStatus accepts both status codes and the Russian labels
+// that were stored as statuses before the codes were introduced.
+func ParseNodeProgressStatus(value string) (NodeProgressStatus, bool) {
+	status := NodeProgressStatus(value)
+	if _, ok := nodeProgressStatusLabels[DefaultLanguage][status]; ok {
+		return status, true
+	}
+
+	for code, label := range nodeProgressStatusLabels[DefaultLanguage] {
+		if label == value {
+			return code, true
+		}
+	}
+
+	return "", false
+}
+
+func (s NodeProgressStatus) IsValid() bool {
+	_, ok := nodeProgressStatusLabels[DefaultLanguage][s]
+	return ok
+}
+
+// Label returns the status name in the given language, falling back to Russian.
+func (s NodeProgressStatus) Label(lang string) string {
+	labels, ok := nodeProgressStatusLabels[lang]
+	if !ok {
+		labels = nodeProgressStatusLabels[DefaultLanguage]
+	}
+	return labels[s]
+}
+
+func IsSupportedLanguage(lang string) bool {
+	_, ok := nodeProgressStatusLabels[lang]
+	return ok
+}
+
 type NodeProgress struct {
-	Status NodeProgressStatus `json:"status" bson:"status"`
+	Status           NodeProgressStatus `json:"status" bson:"status"`
+	StartedAt        *time.Time         `json:"started_at,omitempty" bson:"started_at,omitempty"`
+	CompletedAt      *time.Time         `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+	TimeSpentMinutes int                `json:"time_spent_minutes,omitempty" bson:"time_spent_minutes,omitempty"`
+	Confidence       int                `json:"confidence,omitempty" bson:"confidence,omitempty"`
+	UpdatedAt        time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// IsEmpty reports whether the progress carries nothing worth storing.
+func (p NodeProgress) IsEmpty() bool {
+	return (p.Status == "" || p.Status == NodeProgressPending) &&
+		p.TimeSpentMinutes == 0 &&
+		p.Confidence == 0
 }
 
 type UserProgress struct {
@@ -28,3 +93,65 @@ type UserProgress struct {
 	CreatedAt time.Time                  `json:"created_at" bson:"created_at"`
 	UpdatedAt time.Time                  `json:"updated_at" bson:"updated_at"`
 }
+
+// Normalize rewrites legacy status labels to status codes.
+func (p *UserProgress) Normalize() {
+	for nodeID, nodeProgress := range p.Progress {
+		if status, ok := ParseNodeProgressStatus(string(nodeProgress.Status)); ok && status != nodeProgress.Status {
+			nodeProgress.Status = status
+			p.Progress[nodeID] = nodeProgress
+		}
+	}
+}
+
+// ProgressSummary aggregates a user's progress over the trackable nodes of a roadmap.
+type ProgressSummary struct {
+	TotalNodes        int
+	DoneCount         int
+	InProgressCount   int
+	SkippedCount      int
+	PendingCount      int
+	CompletionPercent float64
+	TimeSpentMinutes  int
+	NextNodes         []ProgressSummaryNode
+	LastActivityAt    *time.Time
+}
+
+type ProgressSummaryNode struct {
+	ID     uuid.UUID
+	Label  string
+	Status NodeProgressStatus
+}
+
+type ProgressEventType string
+
+const (
+	ProgressEventStatusChanged   ProgressEventType = "status_changed"
+	ProgressEventTimeLogged      ProgressEventType = "time_logged"
+	ProgressEventConfidenceRated ProgressEventType = "confidence_rated"
+)
+
+// ProgressEvent is an append-only record of a single change to a user's node progress.
+type ProgressEvent struct {
+	ID               primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID           uuid.UUID          `json:"user_id" bson:"user_id"`
+	RoadmapID        primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID           uuid.UUID          `json:"node_id" bson:"node_id"`
+	Type             ProgressEventType  `json:"type" bson:"type"`
+	FromStatus       NodeProgressStatus `json:"from_status,omitempty" bson:"from_status,omitempty"`
+	ToStatus         NodeProgressStatus `json:"to_status,omitempty" bson:"to_status,omitempty"`
+	TimeSpentMinutes int                `json:"time_spent_minutes,omitempty" bson:"time_spent_minutes,omitempty"`
+	Confidence       int                `json:"confidence,omitempty" bson:"confidence,omitempty"`
+	CreatedAt        time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// NodeNote is a private Markdown note a user keeps on a roadmap node.
+type NodeNote struct {
+	ID        primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID    uuid.UUID          `json:"user_id" bson:"user_id"`
+	RoadmapID primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID    uuid.UUID          `json:"node_id" bson:"node_id"`
+	Content   string             `json:"content" bson:"content"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
+}
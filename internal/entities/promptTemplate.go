@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PromptRoadmapGeneration   = "roadmap_generation"
+	PromptRoadmapRegeneration = "roadmap_regeneration"
+	PromptNodeSuggestions     = "node_suggestions"
+	PromptNodeQuiz            = "node_quiz"
+	PromptModeration          = "moderation"
+)
+
+const (
+	PromptOutcomeGenerated = "generated"
+	PromptOutcomeFailed    = "failed"
+	PromptOutcomeAllowed   = "allowed"
+	PromptOutcomeRejected  = "rejected"
+)
+
+// PromptBuiltinVersion marks results produced with the template embedded into
+// the service binary, used while no version of the template is active.
+const PromptBuiltinVersion = 0
+
+type PromptTemplate struct {
+	ID           uuid.UUID
+	Name         string
+	Version      int
+	SystemPrompt string
+	Body         string
+	Weight       int
+	AuthorID     *uuid.UUID
+	UsageCount   int64
+	CreatedAt    time.Time
+}
+
+type PromptUsage struct {
+	Name    string `json:"name" bson:"name"`
+	Version int    `json:"version" bson:"version"`
+}
+
+func NewPromptUsage(name string, tmpl *PromptTemplate) PromptUsage {
+	if tmpl == nil {
+		return PromptUsage{Name: name, Version: PromptBuiltinVersion}
+	}
+	return PromptUsage{Name: tmpl.Name, Version: tmpl.Version}
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuizQuestionType string
+
+const (
+	QuizQuestionSingleChoice   QuizQuestionType = "single_choice"
+	QuizQuestionMultipleChoice QuizQuestionType = "multiple_choice"
+	QuizQuestionShortAnswer    QuizQuestionType = "short_answer"
+)
+
+func (t QuizQuestionType) IsValid() bool {
+	switch t {
+	case QuizQuestionSingleChoice, QuizQuestionMultipleChoice, QuizQuestionShortAnswer:
+		return true
+	}
+	return false
+}
+
+func (t QuizQuestionType) IsChoice() bool {
+	return t == QuizQuestionSingleChoice || t == QuizQuestionMultipleChoice
+}
+
+type QuizOption struct {
+	ID      uuid.UUID `json:"id" bson:"id"`
+	Text    string    `json:"text" bson:"text"`
+	Correct bool      `json:"correct" bson:"correct"`
+}
+
+type QuizQuestion struct {
+	ID              uuid.UUID        `json:"id" bson:"id"`
+	Type            QuizQuestionType `json:"type" bson:"type"`
+	Text            string           `json:"text" bson:"text"`
+	Options         []QuizOption     `json:"options,omitempty" bson:"options,omitempty"`
+	AcceptedAnswers []string         `json:"accepted_answers,omitempty" bson:"accepted_answers,omitempty"`
+	Explanation     string           `json:"explanation,omitempty" bson:"explanation,omitempty"`
+}
+
+// NodeQuiz is a self-assessment quiz attached to a roadmap node. When
+// PassingScore is set, an attempt scoring at least that many percent passes
+// the quiz, and AutoComplete marks the node done for the user.
+type NodeQuiz struct {
+	ID           primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	RoadmapID    primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID       uuid.UUID          `json:"node_id" bson:"node_id"`
+	Questions    []QuizQuestion     `json:"questions" bson:"questions"`
+	PassingScore int                `json:"passing_score" bson:"passing_score"`
+	AutoComplete bool               `json:"auto_complete" bson:"auto_complete"`
+	AuthorID     uuid.UUID          `json:"author_id" bson:"author_id"`
+	CreatedAt    time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+type QuizAnswer struct {
+	QuestionID uuid.UUID   `json:"question_id" bson:"question_id"`
+	OptionIDs  []uuid.UUID `json:"option_ids,omitempty" bson:"option_ids,omitempty"`
+	Text       string      `json:"text,omitempty" bson:"text,omitempty"`
+	Correct    bool        `json:"correct" bson:"correct"`
+}
+
+type QuizAttempt struct {
+	ID           primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID       uuid.UUID          `json:"user_id" bson:"user_id"`
+	RoadmapID    primitive.ObjectID `json:"roadmap_id" bson:"roadmap_id"`
+	NodeID       uuid.UUID          `json:"node_id" bson:"node_id"`
+	Answers      []QuizAnswer       `json:"answers" bson:"answers"`
+	CorrectCount int                `json:"correct_count" bson:"correct_count"`
+	TotalCount   int                `json:"total_count" bson:"total_count"`
+	Score        int                `json:"score" bson:"score"`
+	Passed       bool               `json:"passed" bson:"passed"`
+	CreatedAt    time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// NormalizeQuizAnswer brings a short answer to the form answers are compared
+// in: lower case, with surrounding and repeated whitespace removed.
+func NormalizeQuizAnswer(answer string) string {
+	return strings.ToLower(strings.Join(strings.Fields(answer), " "))
+}
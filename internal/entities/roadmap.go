@@ -15,11 +15,15 @@ const (
 )
 
 type Roadmap struct {
-	ID        primitive.ObjectID `json:"_id,omitempty"`
-	Nodes     []RoadmapNode      `json:"nodes,omitempty"`
-	Edges     []RoadmapEdge      `json:"edges,omitempty"`
-	CreatedAt time.Time          `json:"created_at"`
-	UpdatedAt time.Time          `json:"updated_at"`
+	ID                   primitive.ObjectID `json:"_id,omitempty"`
+	Nodes                []RoadmapNode      `json:"nodes,omitempty"`
+	Edges                []RoadmapEdge      `json:"edges,omitempty"`
+	Prompt               *PromptUsage       `json:"prompt,omitempty" bson:"prompt,omitempty"`
+	EnforcePrerequisites bool               `json:"enforce_prerequisites" bson:"enforce_prerequisites"`
+	UpdatedBy            *uuid.UUID         `json:"updated_by,omitempty" bson:"updated_by,omitempty"`
+	Version              int64              `json:"version" bson:"version"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
 }
 
 type RoadmapNode struct {
@@ -32,6 +36,8 @@ type RoadmapNode struct {
 	Dragging    bool       `json:"dragging"`
 	Description string     `json:"description"`
 	Materials   []Material `bson:"materials"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy   *uuid.UUID `json:"updated_by,omitempty" bson:"updated_by,omitempty"`
 }
 
 type NodeData struct {
@@ -45,9 +51,10 @@ type Measured struct {
 }
 
 type RoadmapEdge struct {
-	Source string `json:"source"`
-	Target string `json:"target"`
-	ID     string `json:"id"`
+	Source       string `json:"source"`
+	Target       string `json:"target"`
+	ID           string `json:"id"`
+	Prerequisite bool   `json:"prerequisite" bson:"prerequisite,omitempty"`
 }
 
 type Position struct {
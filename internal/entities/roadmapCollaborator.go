@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleOwner  CollaboratorRole = "owner"
+	CollaboratorRoleEditor CollaboratorRole = "editor"
+	CollaboratorRoleViewer CollaboratorRole = "viewer"
+)
+
+func (r CollaboratorRole) IsValid() bool {
+	switch r {
+	case CollaboratorRoleOwner, CollaboratorRoleEditor, CollaboratorRoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanView reports whether the role grants access to a private roadmap.
+func (r CollaboratorRole) CanView() bool {
+	return r.IsValid()
+}
+
+// CanEdit reports whether the role allows changing the roadmap content.
+func (r CollaboratorRole) CanEdit() bool {
+	return r == CollaboratorRoleOwner || r == CollaboratorRoleEditor
+}
+
+// CanManage reports whether the role allows managing collaborators,
+// publishing, releasing and deleting the roadmap.
+func (r CollaboratorRole) CanManage() bool {
+	return r == CollaboratorRoleOwner
+}
+
+type CollaboratorStatus string
+
+const (
+	CollaboratorStatusPending  CollaboratorStatus = "pending"
+	CollaboratorStatusAccepted CollaboratorStatus = "accepted"
+)
+
+// RoadmapCollaborator grants a user a role on someone else's roadmap. The
+// roadmap author is always an owner and has no collaborator row. A role only
+// takes effect once the invitation is accepted.
+type RoadmapCollaborator struct {
+	RoadmapInfoID uuid.UUID
+	UserID        uuid.UUID
+	Role          CollaboratorRole
+	Status        CollaboratorStatus
+	InvitedBy     uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
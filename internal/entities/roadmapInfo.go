@@ -17,4 +17,5 @@ type RoadmapInfo struct {
 	ReferencedRoadmapInfoID *uuid.UUID
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
+	UnpublishedAt           *time.Time
 }
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RoadmapLineageKind string
+
+const (
+	RoadmapLineageFork    RoadmapLineageKind = "fork"
+	RoadmapLineagePublish RoadmapLineageKind = "publish"
+	RoadmapLineageDraft   RoadmapLineageKind = "draft"
+)
+
+func (k RoadmapLineageKind) IsValid() bool {
+	return k == RoadmapLineageFork || k == RoadmapLineagePublish || k == RoadmapLineageDraft
+}
+
+// RoadmapLineage records which roadmap a fork or a published copy was made
+// from. NodeIDMap maps node IDs of the source roadmap to the new node IDs of
+// the copy, so progress on the source can be carried over.
+type RoadmapLineage struct {
+	ID              primitive.ObjectID      `json:"_id,omitempty" bson:"_id,omitempty"`
+	RoadmapID       primitive.ObjectID      `json:"roadmap_id" bson:"roadmap_id"`
+	SourceRoadmapID primitive.ObjectID      `json:"source_roadmap_id" bson:"source_roadmap_id"`
+	Kind            RoadmapLineageKind      `json:"kind" bson:"kind"`
+	NodeIDMap       map[uuid.UUID]uuid.UUID `json:"node_id_map" bson:"node_id_map"`
+	CreatedAt       time.Time               `json:"created_at" bson:"created_at"`
+}
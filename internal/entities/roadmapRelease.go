@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoadmapReleaseStatus string
+
+const (
+	RoadmapReleaseDraft    RoadmapReleaseStatus = "draft"
+	RoadmapReleaseInReview RoadmapReleaseStatus = "in_review"
+	RoadmapReleaseApproved RoadmapReleaseStatus = "approved"
+	RoadmapReleaseRejected RoadmapReleaseStatus = "rejected"
+	RoadmapReleaseReleased RoadmapReleaseStatus = "released"
+)
+
+// RoadmapRelease tracks a change to a published roadmap from draft to
+// release. The draft is a private copy the author edits; on release its
+// content replaces the published roadmap, which keeps its roadmap info ID, so
+// subscribers follow it across releases. DraftRoadmapInfoID is cleared once
+// the draft has been released.
+type RoadmapRelease struct {
+	ID                 uuid.UUID
+	RoadmapInfoID      uuid.UUID
+	DraftRoadmapInfoID *uuid.UUID
+	AuthorID           uuid.UUID
+	Version            int
+	Status             RoadmapReleaseStatus
+	Notes              string
+	ReviewerID         *uuid.UUID
+	ReviewComment      string
+	ReviewedAt         *time.Time
+	ReleasedAt         *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
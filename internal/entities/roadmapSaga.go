@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoadmapSagaKind string
+
+const (
+	RoadmapSagaFork    RoadmapSagaKind = "fork"
+	RoadmapSagaPublish RoadmapSagaKind = "publish"
+	RoadmapSagaDraft   RoadmapSagaKind = "draft"
+	RoadmapSagaRelease RoadmapSagaKind = "release"
+)
+
+type RoadmapSagaStatus string
+
+const (
+	RoadmapSagaRunning      RoadmapSagaStatus = "running"
+	RoadmapSagaCompleted    RoadmapSagaStatus = "completed"
+	RoadmapSagaCompensating RoadmapSagaStatus = "compensating"
+	RoadmapSagaCompensated  RoadmapSagaStatus = "compensated"
+)
+
+type RoadmapSagaStep string
+
+const (
+	RoadmapSagaStepCreateRoadmap     RoadmapSagaStep = "create_roadmap"
+	RoadmapSagaStepCreateRoadmapInfo RoadmapSagaStep = "create_roadmap_info"
+	RoadmapSagaStepCreateNodeChats   RoadmapSagaStep = "create_node_chats"
+	RoadmapSagaStepMigrateProgress   RoadmapSagaStep = "migrate_progress"
+	RoadmapSagaStepCreateRelease     RoadmapSagaStep = "create_release"
+	RoadmapSagaStepDeleteRoadmap     RoadmapSagaStep = "delete_roadmap"
+	RoadmapSagaStepApplyDraft        RoadmapSagaStep = "apply_draft"
+	RoadmapSagaStepUpdateRoadmapInfo RoadmapSagaStep = "update_roadmap_info"
+	RoadmapSagaStepMarkReleased      RoadmapSagaStep = "mark_released"
+	RoadmapSagaStepDeleteDraft       RoadmapSagaStep = "delete_draft"
+	RoadmapSagaStepDone              RoadmapSagaStep = "done"
+)
+
+// RoadmapSaga is the persisted state of a fork, publish or draft that spans
+// the roadmap, roadmap info and chat services. RoadmapID is chosen up front,
+// so every step can be retried without creating a second copy. Once
+// RoadmapInfoID is set the copy is visible to the user and the saga can only
+// move forward; before that a failure deletes the copied roadmap.
+//
+// A release works the other way round: SourceRoadmapInfoID is the approved
+// draft, RoadmapID is the published roadmap it replaces, and RoadmapInfoID is
+// set once the draft has been applied to it. A release that fails before
+// then has changed nothing and is simply given up.
+type RoadmapSaga struct {
+	ID                  uuid.UUID
+	Kind                RoadmapSagaKind
+	UserID              uuid.UUID
+	IdempotencyKey      string
+	SourceRoadmapInfoID uuid.UUID
+	RoadmapID           string
+	RoadmapInfoID       *uuid.UUID
+	CarryProgress       bool
+	Status              RoadmapSagaStatus
+	Step                RoadmapSagaStep
+	Attempts            int
+	LastError           string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
@@ -6,6 +6,11 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	UserRoleAdmin   = "admin"
+	UserRoleRegular = "regular"
+)
+
 type User struct {
 	ID           uuid.UUID
 	Username     string
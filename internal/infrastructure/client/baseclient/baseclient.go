@@ -24,6 +24,14 @@ func NewBaseClient() *BaseClient {
 	}
 }
 
+func NewBaseClientWithTimeout(timeout time.Duration) *BaseClient {
+	return &BaseClient{
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
 func NewInsecureBaseClient() *BaseClient {
 	transport := &http.Transport{
 		TLSClientConfig: &tls.Config{
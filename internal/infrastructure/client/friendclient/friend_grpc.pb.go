@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	FriendService_GetFriendshipStatus_FullMethodName = "/friendclient.FriendService/GetFriendshipStatus"
+	FriendService_GetFriendIDs_FullMethodName        = "/friendclient.FriendService/GetFriendIDs"
 )
 
 // FriendServiceClient is the client API for FriendService service.
@@ -29,6 +30,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FriendServiceClient interface {
 	GetFriendshipStatus(ctx context.Context, in *GetFriendshipStatusRequest, opts ...grpc.CallOption) (*GetFriendshipStatusResponse, error)
+	GetFriendIDs(ctx context.Context, in *GetFriendIDsRequest, opts ...grpc.CallOption) (*GetFriendIDsResponse, error)
 }
 
 type friendServiceClient struct {
@@ -49,11 +51,22 @@ func (c *friendServiceClient) GetFriendshipStatus(ctx context.Context, in *GetFr
 	return out, nil
 }
 
+func (c *friendServiceClient) GetFriendIDs(ctx context.Context, in *GetFriendIDsRequest, opts ...grpc.CallOption) (*GetFriendIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendIDsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFriendIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServiceServer is the server API for FriendService service.
 // All implementations must embed UnimplementedFriendServiceServer
 // for forward compatibility.
 type FriendServiceServer interface {
 	GetFriendshipStatus(context.Context, *GetFriendshipStatusRequest) (*GetFriendshipStatusResponse, error)
+	GetFriendIDs(context.Context, *GetFriendIDsRequest) (*GetFriendIDsResponse, error)
 	mustEmbedUnimplementedFriendServiceServer()
 }
 
@@ -67,6 +80,9 @@ type UnimplementedFriendServiceServer struct{}
 func (UnimplementedFriendServiceServer) GetFriendshipStatus(context.Context, *GetFriendshipStatusRequest) (*GetFriendshipStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriendshipStatus not implemented")
 }
+func (UnimplementedFriendServiceServer) GetFriendIDs(context.Context, *GetFriendIDsRequest) (*GetFriendIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendIDs not implemented")
+}
 func (UnimplementedFriendServiceServer) mustEmbedUnimplementedFriendServiceServer() {}
 func (UnimplementedFriendServiceServer) testEmbeddedByValue()                       {}
 
@@ -106,6 +122,24 @@ func _FriendService_GetFriendshipStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_GetFriendIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFriendIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFriendIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFriendIDs(ctx, req.(*GetFriendIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FriendService_ServiceDesc is the grpc.ServiceDesc for FriendService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +151,10 @@ var FriendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFriendshipStatus",
 			Handler:    _FriendService_GetFriendshipStatus_Handler,
 		},
+		{
+			MethodName: "GetFriendIDs",
+			Handler:    _FriendService_GetFriendIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/infrastructure/client/friendclient/proto/friend.proto",
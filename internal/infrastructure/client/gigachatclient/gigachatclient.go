@@ -22,11 +22,14 @@ const (
 	ModelsPath = "models"
 	ChatPath   = "chat/completions"
 	FilesPath  = "files"
+
+	DefaultModel = "GigaChat"
 )
 
 type Client struct {
 	BaseClient *baseclient.BaseClient
 	Token      *Token
+	Model      string
 	cfg        *config.Config
 }
 
@@ -37,6 +40,7 @@ func NewGigaChatClient(cfg *config.Config) *Client {
 	gigachatClient := &Client{
 		BaseClient: baseclient.NewInsecureBaseClient(),
 		Token:      new(Token),
+		Model:      DefaultModel,
 		cfg:        cfg,
 	}
 
@@ -88,6 +92,12 @@ func (c *Client) Chat(ctx context.Context, in *dto.ChatRequest) (*dto.ChatRespon
 
 	logger.Info("sending chat request")
 
+	if in.Model == "" {
+		withModel := *in
+		withModel.Model = c.Model
+		in = &withModel
+	}
+
 	reqBytes, err := json.Marshal(in)
 	if err != nil {
 		logger.WithError(err).Error("failed to marshal chat request")
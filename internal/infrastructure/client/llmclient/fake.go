@@ -0,0 +1,158 @@
+package llmclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
+)
+
+// FakeRule answers with Response to requests from Feature when Contains is
+// found in a message with the given role. Empty fields match anything.
+type FakeRule struct {
+	Feature  string `json:"feature"`
+	Role     string `json:"role"`
+	Contains string `json:"contains"`
+	Response string `json:"response"`
+}
+
+type FakeScript struct {
+	Rules   []FakeRule `json:"rules"`
+	Default string     `json:"default"`
+}
+
+// FakeProvider is a deterministic offline provider: rules are checked in
+// order and the first match wins, so the same request always gets the same
+// answer.
+type FakeProvider struct {
+	script FakeScript
+
+	mx    sync.Mutex
+	calls []dto.ChatRequest
+}
+
+func NewFakeProvider(script FakeScript) *FakeProvider {
+	return &FakeProvider{script: script}
+}
+
+func LoadFakeScript(path string) (FakeScript, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FakeScript{}, fmt.Errorf("failed to read fake llm script: %w", err)
+	}
+
+	var script FakeScript
+	if err := json.Unmarshal(data, &script); err != nil {
+		return FakeScript{}, fmt.Errorf("failed to parse fake llm script: %w", err)
+	}
+
+	return script, nil
+}
+
+func (p *FakeProvider) Chat(ctx context.Context, in *dto.ChatRequest) (*dto.ChatResponse, error) {
+	p.mx.Lock()
+	p.calls = append(p.calls, *in)
+	p.mx.Unlock()
+
+	content := p.script.Default
+	for _, rule := range p.script.Rules {
+		if p.matches(rule, in) {
+			content = rule.Response
+			break
+		}
+	}
+
+	return &dto.ChatResponse{
+		Model:   "fake",
+		Created: time.Now().Unix(),
+		Method:  "chat.completion",
+		Choices: []dto.Choice{
+			{
+				Index:        0,
+				FinishReason: "stop",
+				Message: dto.Message{
+					Role:    "assistant",
+					Content: content,
+				},
+			},
+		},
+	}, nil
+}
+
+func (p *FakeProvider) Calls() []dto.ChatRequest {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return append([]dto.ChatRequest(nil), p.calls...)
+}
+
+func (p *FakeProvider) matches(rule FakeRule, in *dto.ChatRequest) bool {
+	if rule.Feature != "" && rule.Feature != in.Feature {
+		return false
+	}
+
+	for _, message := range in.Messages {
+		if rule.Role != "" && rule.Role != message.Role {
+			continue
+		}
+		if strings.Contains(message.Content, rule.Contains) {
+			return true
+		}
+	}
+	return false
+}
+
+func DefaultFakeScript() FakeScript {
+	return FakeScript{
+		Rules: []FakeRule{
+			{
+				Feature:  FeatureModeration,
+				Response: `{"allowed": true, "categories": []}`,
+			},
+			{
+				Feature:  FeatureNodeSuggestions,
+				Response: fakeSuggestionsResponse,
+			},
+			{
+				Feature:  FeatureNodeQuiz,
+				Response: fakeQuizResponse,
+			},
+			{
+				Feature:  FeatureRoadmapGeneration,
+				Response: fakeRoadmapResponse,
+			},
+			{
+				Feature:  FeatureRoadmapRegeneration,
+				Response: fakeRoadmapResponse,
+			},
+		},
+		Default: "Это тестовый ответ ассистента.",
+	}
+}
+
+const fakeSuggestionsResponse = `{"description":"Тестовое описание темы.","materials":[` +
+	`{"name":"Документация","type":"documentation","url":"https://example.com/docs"},` +
+	`{"name":"Вводное видео","type":"video","url":"https://example.com/video"}` +
+	`]}`
+
+const fakeQuizResponse = `{"questions":[` +
+	`{"type":"single_choice","text":"Что изучают в первую очередь?","options":[{"text":"Основы","correct":true},{"text":"Инструменты","correct":false},{"text":"Практику","correct":false}],"explanation":"Основы нужны для всего остального."},` +
+	`{"type":"multiple_choice","text":"Что входит в тему?","options":[{"text":"Синтаксис","correct":true},{"text":"Инструменты","correct":true},{"text":"Кулинария","correct":false}],"explanation":"Синтаксис и инструменты относятся к теме."},` +
+	`{"type":"short_answer","text":"Как называется первый узел?","accepted_answers":["Основы"],"explanation":"Roadmap начинается с основ."}` +
+	`]}`
+
+const fakeRoadmapResponse = `{"nodes":[` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a01","type":"custom","position":{"x":0,"y":0},"data":{"label":"Основы","type":"root"},"measured":{"width":140,"height":70}},` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a02","type":"custom","position":{"x":-200,"y":150},"data":{"label":"Синтаксис","type":"primary"},"measured":{"width":140,"height":44}},` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a03","type":"custom","position":{"x":200,"y":150},"data":{"label":"Инструменты","type":"primary"},"measured":{"width":140,"height":44}},` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a04","type":"custom","position":{"x":-200,"y":300},"data":{"label":"Практика","type":"secondary"},"measured":{"width":140,"height":44}}` +
+	`],"edges":[` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2b01","source":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a01","target":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a02"},` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2b02","source":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a01","target":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a03"},` +
+	`{"id":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2b03","source":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a02","target":"6b0d8c52-0d3c-4a8e-9a51-1f0c1b7f2a04"}` +
+	`]}`
@@ -0,0 +1,38 @@
+package llmclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
+)
+
+func TestFakeProviderMatchesRulesByFeature(t *testing.T) {
+	provider := NewFakeProvider(DefaultFakeScript())
+
+	// A system prompt overridden by an admin may mention any other feature.
+	messages := []dto.Message{{Role: "system", Content: "Ты помогаешь авторам roadmap и модерации"}}
+
+	tests := []struct {
+		feature string
+		want    string
+	}{
+		{FeatureRoadmapGeneration, fakeRoadmapResponse},
+		{FeatureRoadmapRegeneration, fakeRoadmapResponse},
+		{FeatureNodeSuggestions, fakeSuggestionsResponse},
+		{FeatureNodeQuiz, fakeQuizResponse},
+		{FeatureModeration, `{"allowed": true, "categories": []}`},
+		{FeatureBot, DefaultFakeScript().Default},
+		{"", DefaultFakeScript().Default},
+	}
+
+	for _, tt := range tests {
+		resp, err := provider.Chat(context.Background(), &dto.ChatRequest{Feature: tt.feature, Messages: messages})
+		if err != nil {
+			t.Fatalf("%q: Chat returned error: %v", tt.feature, err)
+		}
+		if got := resp.Choices[0].Message.Content; got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.feature, got, tt.want)
+		}
+	}
+}
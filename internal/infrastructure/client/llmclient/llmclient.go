@@ -0,0 +1,89 @@
+package llmclient
+
+import (
+	"context"
+	"strings"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/openaiclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+)
+
+const (
+	ProviderTypeGigaChat = "gigachat"
+	ProviderTypeOpenAI   = "openai"
+	ProviderTypeFake     = "fake"
+
+	defaultProviderName = "gigachat"
+)
+
+// Features that talk to the LLM, set on ChatRequest.Feature.
+const (
+	FeatureRoadmapGeneration   = "roadmap_generation"
+	FeatureRoadmapRegeneration = "roadmap_regeneration"
+	FeatureNodeSuggestions     = "node_suggestions"
+	FeatureNodeQuiz            = "node_quiz"
+	FeatureModeration          = "moderation"
+	FeatureBot                 = "bot"
+)
+
+type Provider interface {
+	Chat(ctx context.Context, in *dto.ChatRequest) (*dto.ChatResponse, error)
+}
+
+func NewRoadmapProvider(cfg *config.Config) Provider {
+	return NewProvider(cfg, cfg.LLM.Features.Roadmap)
+}
+
+func NewModerationProvider(cfg *config.Config) Provider {
+	return NewProvider(cfg, cfg.LLM.Features.Moderation)
+}
+
+func NewBotProvider(cfg *config.Config) Provider {
+	return NewProvider(cfg, cfg.LLM.Features.Bot)
+}
+
+func NewProvider(cfg *config.Config, name string) Provider {
+	const op = "llmclient.NewProvider"
+	logger := ctxutil.GetLogger(context.Background()).WithFields(map[string]interface{}{
+		"op":       op,
+		"provider": name,
+	})
+
+	if name == "" {
+		name = defaultProviderName
+	}
+
+	providerCfg, ok := cfg.LLM.Providers[strings.ToLower(name)]
+	if !ok {
+		logger.Warn("llm provider is not configured, falling back to GigaChat")
+		return gigachatclient.NewGigaChatClient(cfg)
+	}
+
+	switch providerCfg.Type {
+	case ProviderTypeGigaChat:
+		client := gigachatclient.NewGigaChatClient(cfg)
+		if providerCfg.Model != "" {
+			client.Model = providerCfg.Model
+		}
+		return client
+	case ProviderTypeOpenAI:
+		return openaiclient.NewOpenAIClient(providerCfg)
+	case ProviderTypeFake:
+		script := DefaultFakeScript()
+		if providerCfg.Script != "" {
+			loaded, err := LoadFakeScript(providerCfg.Script)
+			if err != nil {
+				logger.WithError(err).Error("failed to load fake llm script, using default script")
+			} else {
+				script = loaded
+			}
+		}
+		return NewFakeProvider(script)
+	default:
+		logger.WithField("type", providerCfg.Type).Warn("unknown llm provider type, falling back to GigaChat")
+		return gigachatclient.NewGigaChatClient(cfg)
+	}
+}
@@ -0,0 +1,126 @@
+package openaiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/baseclient"
+	llmClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/openaiclient/dto"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+)
+
+const (
+	ChatPath = "chat/completions"
+
+	defaultTimeout = 120 * time.Second
+)
+
+type Client struct {
+	BaseClient *baseclient.BaseClient
+	baseURL    string
+	apiKey     string
+	model      string
+}
+
+func NewOpenAIClient(providerCfg config.LLMProviderConfig) *Client {
+	timeout := providerCfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	baseURL := providerCfg.BaseURL
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	return &Client{
+		BaseClient: baseclient.NewBaseClientWithTimeout(timeout),
+		baseURL:    baseURL,
+		apiKey:     providerCfg.APIKey,
+		model:      providerCfg.Model,
+	}
+}
+
+func (c *Client) Chat(ctx context.Context, in *llmClientDTO.ChatRequest) (*llmClientDTO.ChatResponse, error) {
+	const op = "OpenAIClient.Chat"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	completionReq := dto.ChatCompletionRequest{
+		Model:       in.Model,
+		Messages:    make([]dto.Message, 0, len(in.Messages)),
+		Temperature: in.Temperature,
+		TopP:        in.TopP,
+		N:           in.N,
+		MaxTokens:   in.MaxTokens,
+	}
+
+	if completionReq.Model == "" {
+		completionReq.Model = c.model
+	}
+
+	for _, message := range in.Messages {
+		completionReq.Messages = append(completionReq.Messages, dto.Message{
+			Role:    message.Role,
+			Content: message.Content,
+		})
+	}
+
+	logger.WithField("model", completionReq.Model).Info("sending chat completion request")
+
+	reqBytes, err := json.Marshal(completionReq)
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal chat completion request")
+		return nil, fmt.Errorf("%s: failed to marshal chat completion request: %w", op, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+ChatPath, bytes.NewReader(reqBytes))
+	if err != nil {
+		logger.WithError(err).Error("failed to create chat completion request")
+		return nil, fmt.Errorf("%s: failed to create chat completion request: %w", op, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	var completionResp dto.ChatCompletionResponse
+	if err := c.BaseClient.DoRequest(ctx, req, &completionResp); err != nil {
+		logger.WithError(err).Error("failed to send chat completion request")
+		return nil, fmt.Errorf("%s: failed to send chat completion request: %w", op, err)
+	}
+
+	chatResp := &llmClientDTO.ChatResponse{
+		Model:   completionResp.Model,
+		Created: completionResp.Created,
+		Method:  completionResp.Object,
+		Choices: make([]llmClientDTO.Choice, 0, len(completionResp.Choices)),
+		Usage: llmClientDTO.Usage{
+			PromptTokens:     completionResp.Usage.PromptTokens,
+			CompletionTokens: completionResp.Usage.CompletionTokens,
+			TotalTokens:      completionResp.Usage.TotalTokens,
+		},
+	}
+
+	for _, choice := range completionResp.Choices {
+		chatResp.Choices = append(chatResp.Choices, llmClientDTO.Choice{
+			Index:        choice.Index,
+			FinishReason: choice.FinishReason,
+			Message: llmClientDTO.Message{
+				Role:    choice.Message.Role,
+				Content: choice.Message.Content,
+			},
+		})
+	}
+
+	logger.Info("successfully received chat completion response")
+	return chatResp, nil
+}
@@ -381,9 +381,10 @@ type Edge struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	Id     string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Source       string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target       string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Id           string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Prerequisite bool   `protobuf:"varint,4,opt,name=prerequisite,proto3" json:"prerequisite,omitempty"`
 }
 
 func (x *Edge) Reset() {
@@ -437,6 +438,13 @@ func (x *Edge) GetId() string {
 	return ""
 }
 
+func (x *Edge) GetPrerequisite() bool {
+	if x != nil {
+		return x.Prerequisite
+	}
+	return false
+}
+
 type RoadmapWithMaterials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -845,7 +853,9 @@ type RegenerateNodeIDsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Roadmap *RoadmapWithMaterials `protobuf:"bytes,1,opt,name=roadmap,proto3" json:"roadmap,omitempty"`
+	Roadmap         *RoadmapWithMaterials `protobuf:"bytes,1,opt,name=roadmap,proto3" json:"roadmap,omitempty"`
+	TargetRoadmapId string                `protobuf:"bytes,2,opt,name=target_roadmap_id,json=targetRoadmapId,proto3" json:"target_roadmap_id,omitempty"`
+	LineageKind     string                `protobuf:"bytes,3,opt,name=lineage_kind,json=lineageKind,proto3" json:"lineage_kind,omitempty"`
 }
 
 func (x *RegenerateNodeIDsRequest) Reset() {
@@ -885,6 +895,20 @@ func (x *RegenerateNodeIDsRequest) GetRoadmap() *RoadmapWithMaterials {
 	return nil
 }
 
+func (x *RegenerateNodeIDsRequest) GetTargetRoadmapId() string {
+	if x != nil {
+		return x.TargetRoadmapId
+	}
+	return ""
+}
+
+func (x *RegenerateNodeIDsRequest) GetLineageKind() string {
+	if x != nil {
+		return x.LineageKind
+	}
+	return ""
+}
+
 type RegenerateNodeIDsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -938,6 +962,332 @@ func (x *RegenerateNodeIDsResponse) GetError() string {
 	return ""
 }
 
+type MigrateProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoadmapId string `protobuf:"bytes,2,opt,name=roadmap_id,json=roadmapId,proto3" json:"roadmap_id,omitempty"`
+}
+
+func (x *MigrateProgressRequest) Reset() {
+	*x = MigrateProgressRequest{}
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateProgressRequest) ProtoMessage() {}
+
+func (x *MigrateProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateProgressRequest.ProtoReflect.Descriptor instead.
+func (*MigrateProgressRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MigrateProgressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MigrateProgressRequest) GetRoadmapId() string {
+	if x != nil {
+		return x.RoadmapId
+	}
+	return ""
+}
+
+type MigrateProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MigratedNodes int32  `protobuf:"varint,1,opt,name=migrated_nodes,json=migratedNodes,proto3" json:"migrated_nodes,omitempty"`
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MigrateProgressResponse) Reset() {
+	*x = MigrateProgressResponse{}
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateProgressResponse) ProtoMessage() {}
+
+func (x *MigrateProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateProgressResponse.ProtoReflect.Descriptor instead.
+func (*MigrateProgressResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MigrateProgressResponse) GetMigratedNodes() int32 {
+	if x != nil {
+		return x.MigratedNodes
+	}
+	return 0
+}
+
+func (x *MigrateProgressResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListRoadmapIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedBefore *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+}
+
+func (x *ListRoadmapIDsRequest) Reset() {
+	*x = ListRoadmapIDsRequest{}
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoadmapIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoadmapIDsRequest) ProtoMessage() {}
+
+func (x *ListRoadmapIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoadmapIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoadmapIDsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListRoadmapIDsRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type ListRoadmapIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids   []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Error string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListRoadmapIDsResponse) Reset() {
+	*x = ListRoadmapIDsResponse{}
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoadmapIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoadmapIDsResponse) ProtoMessage() {}
+
+func (x *ListRoadmapIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoadmapIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoadmapIDsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListRoadmapIDsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListRoadmapIDsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ApplyDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DraftRoadmapId string `protobuf:"bytes,1,opt,name=draft_roadmap_id,json=draftRoadmapId,proto3" json:"draft_roadmap_id,omitempty"`
+	RoadmapId      string `protobuf:"bytes,2,opt,name=roadmap_id,json=roadmapId,proto3" json:"roadmap_id,omitempty"`
+	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ApplyDraftRequest) Reset() {
+	*x = ApplyDraftRequest{}
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDraftRequest) ProtoMessage() {}
+
+func (x *ApplyDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDraftRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDraftRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApplyDraftRequest) GetDraftRoadmapId() string {
+	if x != nil {
+		return x.DraftRoadmapId
+	}
+	return ""
+}
+
+func (x *ApplyDraftRequest) GetRoadmapId() string {
+	if x != nil {
+		return x.RoadmapId
+	}
+	return ""
+}
+
+func (x *ApplyDraftRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApplyDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddedNodes   int32  `protobuf:"varint,1,opt,name=added_nodes,json=addedNodes,proto3" json:"added_nodes,omitempty"`
+	RemovedNodes int32  `protobuf:"varint,2,opt,name=removed_nodes,json=removedNodes,proto3" json:"removed_nodes,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ApplyDraftResponse) Reset() {
+	*x = ApplyDraftResponse{}
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDraftResponse) ProtoMessage() {}
+
+func (x *ApplyDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDraftResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDraftResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApplyDraftResponse) GetAddedNodes() int32 {
+	if x != nil {
+		return x.AddedNodes
+	}
+	return 0
+}
+
+func (x *ApplyDraftResponse) GetRemovedNodes() int32 {
+	if x != nil {
+		return x.RemovedNodes
+	}
+	return 0
+}
+
+func (x *ApplyDraftResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_internal_infrastructure_client_roadmapclient_proto_roadmap_proto protoreflect.FileDescriptor
 
 var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDesc = []byte{
@@ -993,100 +1343,160 @@ var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDes
 	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4d,
 	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x73, 0x22, 0x46, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x6c, 0x73, 0x22, 0x6a, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xff, 0x01, 0x0a, 0x14, 0x52,
-	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x65,
-	0x64, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x6f, 0x61,
-	0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52,
-	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbc, 0x01, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61,
-	0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68,
-	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x29, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x0e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
-	0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52,
-	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44,
-	0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x57,
-	0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74,
-	0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x6f, 0x61, 0x64,
-	0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69,
-	0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x6f, 0x61,
-	0x64, 0x6d, 0x61, 0x70, 0x22, 0x70, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72,
+	0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x22, 0xff,
+	0x01, 0x0a, 0x14, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xbc, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x6f,
+	0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22,
+	0x65, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65,
 	0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61,
 	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
 	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xf7, 0x02, 0x0a, 0x0e, 0x52, 0x6f, 0x61, 0x64, 0x6d,
-	0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x61,
-	0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d,
-	0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x2a, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x6f,
-	0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42,
 	0x79, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x12, 0x27, 0x2e,
-	0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x3b, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61,
+	0x70, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x07,
+	0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa8, 0x01,
+	0x0a, 0x18, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x6f,
+	0x61, 0x64, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x6f,
+	0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65,
+	0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e,
+	0x65, 0x61, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x70, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x6f, 0x61,
+	0x64, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x16, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x17,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x22, 0x40, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x75, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x5f, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x8b, 0x05, 0x0a, 0x0e,
+	0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45,
+	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d,
+	0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1c, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68,
+	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x11, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x44, 0x73, 0x12, 0x27, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x6f,
+	0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d,
+	0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x12, 0x20, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x3b, 0x72,
+	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1101,7 +1511,7 @@ func file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDe
 	return file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDescData
 }
 
-var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_goTypes = []any{
 	(*Position)(nil),                     // 0: roadmapclient.Position
 	(*NodeData)(nil),                     // 1: roadmapclient.NodeData
@@ -1118,38 +1528,51 @@ var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_goType
 	(*GetByIDWithMaterialsResponse)(nil), // 12: roadmapclient.GetByIDWithMaterialsResponse
 	(*RegenerateNodeIDsRequest)(nil),     // 13: roadmapclient.RegenerateNodeIDsRequest
 	(*RegenerateNodeIDsResponse)(nil),    // 14: roadmapclient.RegenerateNodeIDsResponse
-	(*timestamp.Timestamp)(nil),          // 15: google.protobuf.Timestamp
+	(*MigrateProgressRequest)(nil),       // 15: roadmapclient.MigrateProgressRequest
+	(*MigrateProgressResponse)(nil),      // 16: roadmapclient.MigrateProgressResponse
+	(*ListRoadmapIDsRequest)(nil),        // 17: roadmapclient.ListRoadmapIDsRequest
+	(*ListRoadmapIDsResponse)(nil),       // 18: roadmapclient.ListRoadmapIDsResponse
+	(*ApplyDraftRequest)(nil),            // 19: roadmapclient.ApplyDraftRequest
+	(*ApplyDraftResponse)(nil),           // 20: roadmapclient.ApplyDraftResponse
+	(*timestamp.Timestamp)(nil),          // 21: google.protobuf.Timestamp
 }
 var file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_depIdxs = []int32{
-	15, // 0: roadmapclient.Material.created_at:type_name -> google.protobuf.Timestamp
-	15, // 1: roadmapclient.Material.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 0: roadmapclient.Material.created_at:type_name -> google.protobuf.Timestamp
+	21, // 1: roadmapclient.Material.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: roadmapclient.NodeWithMaterials.position:type_name -> roadmapclient.Position
 	1,  // 3: roadmapclient.NodeWithMaterials.data:type_name -> roadmapclient.NodeData
 	2,  // 4: roadmapclient.NodeWithMaterials.measured:type_name -> roadmapclient.Measured
 	3,  // 5: roadmapclient.NodeWithMaterials.materials:type_name -> roadmapclient.Material
 	4,  // 6: roadmapclient.RoadmapWithMaterials.nodes:type_name -> roadmapclient.NodeWithMaterials
 	5,  // 7: roadmapclient.RoadmapWithMaterials.edges:type_name -> roadmapclient.Edge
-	15, // 8: roadmapclient.RoadmapWithMaterials.created_at:type_name -> google.protobuf.Timestamp
-	15, // 9: roadmapclient.RoadmapWithMaterials.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 8: roadmapclient.RoadmapWithMaterials.created_at:type_name -> google.protobuf.Timestamp
+	21, // 9: roadmapclient.RoadmapWithMaterials.updated_at:type_name -> google.protobuf.Timestamp
 	4,  // 10: roadmapclient.CreateRequest.nodes:type_name -> roadmapclient.NodeWithMaterials
 	5,  // 11: roadmapclient.CreateRequest.edges:type_name -> roadmapclient.Edge
 	6,  // 12: roadmapclient.CreateResponse.roadmap:type_name -> roadmapclient.RoadmapWithMaterials
 	6,  // 13: roadmapclient.GetByIDWithMaterialsResponse.roadmap:type_name -> roadmapclient.RoadmapWithMaterials
 	6,  // 14: roadmapclient.RegenerateNodeIDsRequest.roadmap:type_name -> roadmapclient.RoadmapWithMaterials
 	6,  // 15: roadmapclient.RegenerateNodeIDsResponse.roadmap:type_name -> roadmapclient.RoadmapWithMaterials
-	7,  // 16: roadmapclient.RoadmapService.Create:input_type -> roadmapclient.CreateRequest
-	9,  // 17: roadmapclient.RoadmapService.Delete:input_type -> roadmapclient.DeleteRequest
-	11, // 18: roadmapclient.RoadmapService.GetByIDWithMaterials:input_type -> roadmapclient.GetByIDWithMaterialsRequest
-	13, // 19: roadmapclient.RoadmapService.RegenerateNodeIDs:input_type -> roadmapclient.RegenerateNodeIDsRequest
-	8,  // 20: roadmapclient.RoadmapService.Create:output_type -> roadmapclient.CreateResponse
-	10, // 21: roadmapclient.RoadmapService.Delete:output_type -> roadmapclient.DeleteResponse
-	12, // 22: roadmapclient.RoadmapService.GetByIDWithMaterials:output_type -> roadmapclient.GetByIDWithMaterialsResponse
-	14, // 23: roadmapclient.RoadmapService.RegenerateNodeIDs:output_type -> roadmapclient.RegenerateNodeIDsResponse
-	20, // [20:24] is the sub-list for method output_type
-	16, // [16:20] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	21, // 16: roadmapclient.ListRoadmapIDsRequest.created_before:type_name -> google.protobuf.Timestamp
+	7,  // 17: roadmapclient.RoadmapService.Create:input_type -> roadmapclient.CreateRequest
+	9,  // 18: roadmapclient.RoadmapService.Delete:input_type -> roadmapclient.DeleteRequest
+	11, // 19: roadmapclient.RoadmapService.GetByIDWithMaterials:input_type -> roadmapclient.GetByIDWithMaterialsRequest
+	13, // 20: roadmapclient.RoadmapService.RegenerateNodeIDs:input_type -> roadmapclient.RegenerateNodeIDsRequest
+	15, // 21: roadmapclient.RoadmapService.MigrateProgress:input_type -> roadmapclient.MigrateProgressRequest
+	17, // 22: roadmapclient.RoadmapService.ListRoadmapIDs:input_type -> roadmapclient.ListRoadmapIDsRequest
+	19, // 23: roadmapclient.RoadmapService.ApplyDraft:input_type -> roadmapclient.ApplyDraftRequest
+	8,  // 24: roadmapclient.RoadmapService.Create:output_type -> roadmapclient.CreateResponse
+	10, // 25: roadmapclient.RoadmapService.Delete:output_type -> roadmapclient.DeleteResponse
+	12, // 26: roadmapclient.RoadmapService.GetByIDWithMaterials:output_type -> roadmapclient.GetByIDWithMaterialsResponse
+	14, // 27: roadmapclient.RoadmapService.RegenerateNodeIDs:output_type -> roadmapclient.RegenerateNodeIDsResponse
+	16, // 28: roadmapclient.RoadmapService.MigrateProgress:output_type -> roadmapclient.MigrateProgressResponse
+	18, // 29: roadmapclient.RoadmapService.ListRoadmapIDs:output_type -> roadmapclient.ListRoadmapIDsResponse
+	20, // 30: roadmapclient.RoadmapService.ApplyDraft:output_type -> roadmapclient.ApplyDraftResponse
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_init() }
@@ -1163,7 +1586,7 @@ func file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_init(
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_infrastructure_client_roadmapclient_proto_roadmap_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,9 @@ const (
 	RoadmapService_Delete_FullMethodName               = "/roadmapclient.RoadmapService/Delete"
 	RoadmapService_GetByIDWithMaterials_FullMethodName = "/roadmapclient.RoadmapService/GetByIDWithMaterials"
 	RoadmapService_RegenerateNodeIDs_FullMethodName    = "/roadmapclient.RoadmapService/RegenerateNodeIDs"
+	RoadmapService_MigrateProgress_FullMethodName      = "/roadmapclient.RoadmapService/MigrateProgress"
+	RoadmapService_ListRoadmapIDs_FullMethodName       = "/roadmapclient.RoadmapService/ListRoadmapIDs"
+	RoadmapService_ApplyDraft_FullMethodName           = "/roadmapclient.RoadmapService/ApplyDraft"
 )
 
 // RoadmapServiceClient is the client API for RoadmapService service.
@@ -35,6 +38,9 @@ type RoadmapServiceClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	GetByIDWithMaterials(ctx context.Context, in *GetByIDWithMaterialsRequest, opts ...grpc.CallOption) (*GetByIDWithMaterialsResponse, error)
 	RegenerateNodeIDs(ctx context.Context, in *RegenerateNodeIDsRequest, opts ...grpc.CallOption) (*RegenerateNodeIDsResponse, error)
+	MigrateProgress(ctx context.Context, in *MigrateProgressRequest, opts ...grpc.CallOption) (*MigrateProgressResponse, error)
+	ListRoadmapIDs(ctx context.Context, in *ListRoadmapIDsRequest, opts ...grpc.CallOption) (*ListRoadmapIDsResponse, error)
+	ApplyDraft(ctx context.Context, in *ApplyDraftRequest, opts ...grpc.CallOption) (*ApplyDraftResponse, error)
 }
 
 type roadmapServiceClient struct {
@@ -85,6 +91,36 @@ func (c *roadmapServiceClient) RegenerateNodeIDs(ctx context.Context, in *Regene
 	return out, nil
 }
 
+func (c *roadmapServiceClient) MigrateProgress(ctx context.Context, in *MigrateProgressRequest, opts ...grpc.CallOption) (*MigrateProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateProgressResponse)
+	err := c.cc.Invoke(ctx, RoadmapService_MigrateProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roadmapServiceClient) ListRoadmapIDs(ctx context.Context, in *ListRoadmapIDsRequest, opts ...grpc.CallOption) (*ListRoadmapIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoadmapIDsResponse)
+	err := c.cc.Invoke(ctx, RoadmapService_ListRoadmapIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roadmapServiceClient) ApplyDraft(ctx context.Context, in *ApplyDraftRequest, opts ...grpc.CallOption) (*ApplyDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyDraftResponse)
+	err := c.cc.Invoke(ctx, RoadmapService_ApplyDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoadmapServiceServer is the server API for RoadmapService service.
 // All implementations must embed UnimplementedRoadmapServiceServer
 // for forward compatibility.
@@ -93,6 +129,9 @@ type RoadmapServiceServer interface {
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	GetByIDWithMaterials(context.Context, *GetByIDWithMaterialsRequest) (*GetByIDWithMaterialsResponse, error)
 	RegenerateNodeIDs(context.Context, *RegenerateNodeIDsRequest) (*RegenerateNodeIDsResponse, error)
+	MigrateProgress(context.Context, *MigrateProgressRequest) (*MigrateProgressResponse, error)
+	ListRoadmapIDs(context.Context, *ListRoadmapIDsRequest) (*ListRoadmapIDsResponse, error)
+	ApplyDraft(context.Context, *ApplyDraftRequest) (*ApplyDraftResponse, error)
 	mustEmbedUnimplementedRoadmapServiceServer()
 }
 
@@ -115,6 +154,15 @@ func (UnimplementedRoadmapServiceServer) GetByIDWithMaterials(context.Context, *
 func (UnimplementedRoadmapServiceServer) RegenerateNodeIDs(context.Context, *RegenerateNodeIDsRequest) (*RegenerateNodeIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegenerateNodeIDs not implemented")
 }
+func (UnimplementedRoadmapServiceServer) MigrateProgress(context.Context, *MigrateProgressRequest) (*MigrateProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateProgress not implemented")
+}
+func (UnimplementedRoadmapServiceServer) ListRoadmapIDs(context.Context, *ListRoadmapIDsRequest) (*ListRoadmapIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoadmapIDs not implemented")
+}
+func (UnimplementedRoadmapServiceServer) ApplyDraft(context.Context, *ApplyDraftRequest) (*ApplyDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDraft not implemented")
+}
 func (UnimplementedRoadmapServiceServer) mustEmbedUnimplementedRoadmapServiceServer() {}
 func (UnimplementedRoadmapServiceServer) testEmbeddedByValue()                        {}
 
@@ -208,6 +256,60 @@ func _RoadmapService_RegenerateNodeIDs_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoadmapService_MigrateProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadmapServiceServer).MigrateProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadmapService_MigrateProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadmapServiceServer).MigrateProgress(ctx, req.(*MigrateProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoadmapService_ListRoadmapIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoadmapIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadmapServiceServer).ListRoadmapIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadmapService_ListRoadmapIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadmapServiceServer).ListRoadmapIDs(ctx, req.(*ListRoadmapIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoadmapService_ApplyDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadmapServiceServer).ApplyDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadmapService_ApplyDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadmapServiceServer).ApplyDraft(ctx, req.(*ApplyDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoadmapService_ServiceDesc is the grpc.ServiceDesc for RoadmapService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -231,6 +333,18 @@ var RoadmapService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegenerateNodeIDs",
 			Handler:    _RoadmapService_RegenerateNodeIDs_Handler,
 		},
+		{
+			MethodName: "MigrateProgress",
+			Handler:    _RoadmapService_MigrateProgress_Handler,
+		},
+		{
+			MethodName: "ListRoadmapIDs",
+			Handler:    _RoadmapService_ListRoadmapIDs_Handler,
+		},
+		{
+			MethodName: "ApplyDraft",
+			Handler:    _RoadmapService_ApplyDraft_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/infrastructure/client/roadmapclient/proto/roadmap.proto",
@@ -207,6 +207,7 @@ type GetByRoadmapIDRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	RoadmapId string `protobuf:"bytes,1,opt,name=roadmap_id,json=roadmapId,proto3" json:"roadmap_id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
 func (x *GetByRoadmapIDRequest) Reset() {
@@ -246,6 +247,13 @@ func (x *GetByRoadmapIDRequest) GetRoadmapId() string {
 	return ""
 }
 
+func (x *GetByRoadmapIDRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type GetByRoadmapIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -253,6 +261,7 @@ type GetByRoadmapIDResponse struct {
 
 	RoadmapInfo *RoadmapInfo `protobuf:"bytes,1,opt,name=roadmap_info,json=roadmapInfo,proto3" json:"roadmap_info,omitempty"`
 	Error       string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Role        string       `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 }
 
 func (x *GetByRoadmapIDResponse) Reset() {
@@ -299,6 +308,111 @@ func (x *GetByRoadmapIDResponse) GetError() string {
 	return ""
 }
 
+type GetSubscribedRoadmapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetSubscribedRoadmapsRequest) Reset() {
+	*x = GetSubscribedRoadmapsRequest{}
+	mi := &file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscribedRoadmapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribedRoadmapsRequest) ProtoMessage() {}
+
+func (x *GetSubscribedRoadmapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribedRoadmapsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscribedRoadmapsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetByRoadmapIDResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetSubscribedRoadmapsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSubscribedRoadmapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoadmapInfos []*RoadmapInfo `protobuf:"bytes,1,rep,name=roadmap_infos,json=roadmapInfos,proto3" json:"roadmap_infos,omitempty"`
+	Error        string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetSubscribedRoadmapsResponse) Reset() {
+	*x = GetSubscribedRoadmapsResponse{}
+	mi := &file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscribedRoadmapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribedRoadmapsResponse) ProtoMessage() {}
+
+func (x *GetSubscribedRoadmapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribedRoadmapsResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscribedRoadmapsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSubscribedRoadmapsResponse) GetRoadmapInfos() []*RoadmapInfo {
+	if x != nil {
+		return x.RoadmapInfos
+	}
+	return nil
+}
+
+func (x *GetSubscribedRoadmapsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto protoreflect.FileDescriptor
 
 var file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_rawDesc = []byte{
@@ -341,25 +455,47 @@ var file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_prot
 	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f,
 	0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
 	0x0a, 0x0a, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x22, 0x71, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x6f, 0x61, 0x64, 0x6d,
-	0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72,
-	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x32, 0x7b, 0x0a, 0x12, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52,
-	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d,
-	0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x79, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x61, 0x64,
-	0x6d, 0x61, 0x70, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61,
+	0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x37,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52,
+	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x32, 0xf7, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x72,
+	0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70,
+	0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x64, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x73, 0x12, 0x2f, 0x2e, 0x72, 0x6f, 0x61,
+	0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x6f, 0x61, 0x64,
+	0x6d, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x6f,
+	0x61, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x6f, 0x61,
+	0x64, 0x6d, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a,
 	0x13, 0x2e, 0x3b, 0x72, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
@@ -376,26 +512,31 @@ func file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_pro
 	return file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_rawDescData
 }
 
-var file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_goTypes = []any{
-	(*Author)(nil),                 // 0: roadmapinfoclient.Author
-	(*RoadmapInfo)(nil),            // 1: roadmapinfoclient.RoadmapInfo
-	(*GetByRoadmapIDRequest)(nil),  // 2: roadmapinfoclient.GetByRoadmapIDRequest
-	(*GetByRoadmapIDResponse)(nil), // 3: roadmapinfoclient.GetByRoadmapIDResponse
-	(*timestamp.Timestamp)(nil),    // 4: google.protobuf.Timestamp
+	(*Author)(nil),                        // 0: roadmapinfoclient.Author
+	(*RoadmapInfo)(nil),                   // 1: roadmapinfoclient.RoadmapInfo
+	(*GetByRoadmapIDRequest)(nil),         // 2: roadmapinfoclient.GetByRoadmapIDRequest
+	(*GetByRoadmapIDResponse)(nil),        // 3: roadmapinfoclient.GetByRoadmapIDResponse
+	(*GetSubscribedRoadmapsRequest)(nil),  // 4: roadmapinfoclient.GetSubscribedRoadmapsRequest
+	(*GetSubscribedRoadmapsResponse)(nil), // 5: roadmapinfoclient.GetSubscribedRoadmapsResponse
+	(*timestamp.Timestamp)(nil),           // 6: google.protobuf.Timestamp
 }
 var file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_depIdxs = []int32{
 	0, // 0: roadmapinfoclient.RoadmapInfo.author:type_name -> roadmapinfoclient.Author
-	4, // 1: roadmapinfoclient.RoadmapInfo.created_at:type_name -> google.protobuf.Timestamp
-	4, // 2: roadmapinfoclient.RoadmapInfo.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 1: roadmapinfoclient.RoadmapInfo.created_at:type_name -> google.protobuf.Timestamp
+	6, // 2: roadmapinfoclient.RoadmapInfo.updated_at:type_name -> google.protobuf.Timestamp
 	1, // 3: roadmapinfoclient.GetByRoadmapIDResponse.roadmap_info:type_name -> roadmapinfoclient.RoadmapInfo
-	2, // 4: roadmapinfoclient.RoadmapInfoService.GetByRoadmapID:input_type -> roadmapinfoclient.GetByRoadmapIDRequest
-	3, // 5: roadmapinfoclient.RoadmapInfoService.GetByRoadmapID:output_type -> roadmapinfoclient.GetByRoadmapIDResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1, // 4: roadmapinfoclient.GetSubscribedRoadmapsResponse.roadmap_infos:type_name -> roadmapinfoclient.RoadmapInfo
+	2, // 5: roadmapinfoclient.RoadmapInfoService.GetByRoadmapID:input_type -> roadmapinfoclient.GetByRoadmapIDRequest
+	4, // 6: roadmapinfoclient.RoadmapInfoService.GetSubscribedRoadmaps:input_type -> roadmapinfoclient.GetSubscribedRoadmapsRequest
+	3, // 7: roadmapinfoclient.RoadmapInfoService.GetByRoadmapID:output_type -> roadmapinfoclient.GetByRoadmapIDResponse
+	5, // 8: roadmapinfoclient.RoadmapInfoService.GetSubscribedRoadmaps:output_type -> roadmapinfoclient.GetSubscribedRoadmapsResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_init() }
@@ -409,7 +550,7 @@ func file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_pro
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_infrastructure_client_roadmapinfoclient_proto_roadmapinfo_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoadmapInfoService_GetByRoadmapID_FullMethodName = "/roadmapinfoclient.RoadmapInfoService/GetByRoadmapID"
+	RoadmapInfoService_GetByRoadmapID_FullMethodName        = "/roadmapinfoclient.RoadmapInfoService/GetByRoadmapID"
+	RoadmapInfoService_GetSubscribedRoadmaps_FullMethodName = "/roadmapinfoclient.RoadmapInfoService/GetSubscribedRoadmaps"
 )
 
 // RoadmapInfoServiceClient is the client API for RoadmapInfoService service.
@@ -29,6 +30,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RoadmapInfoServiceClient interface {
 	GetByRoadmapID(ctx context.Context, in *GetByRoadmapIDRequest, opts ...grpc.CallOption) (*GetByRoadmapIDResponse, error)
+	GetSubscribedRoadmaps(ctx context.Context, in *GetSubscribedRoadmapsRequest, opts ...grpc.CallOption) (*GetSubscribedRoadmapsResponse, error)
 }
 
 type roadmapInfoServiceClient struct {
@@ -49,11 +51,22 @@ func (c *roadmapInfoServiceClient) GetByRoadmapID(ctx context.Context, in *GetBy
 	return out, nil
 }
 
+func (c *roadmapInfoServiceClient) GetSubscribedRoadmaps(ctx context.Context, in *GetSubscribedRoadmapsRequest, opts ...grpc.CallOption) (*GetSubscribedRoadmapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscribedRoadmapsResponse)
+	err := c.cc.Invoke(ctx, RoadmapInfoService_GetSubscribedRoadmaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoadmapInfoServiceServer is the server API for RoadmapInfoService service.
 // All implementations must embed UnimplementedRoadmapInfoServiceServer
 // for forward compatibility.
 type RoadmapInfoServiceServer interface {
 	GetByRoadmapID(context.Context, *GetByRoadmapIDRequest) (*GetByRoadmapIDResponse, error)
+	GetSubscribedRoadmaps(context.Context, *GetSubscribedRoadmapsRequest) (*GetSubscribedRoadmapsResponse, error)
 	mustEmbedUnimplementedRoadmapInfoServiceServer()
 }
 
@@ -67,6 +80,9 @@ type UnimplementedRoadmapInfoServiceServer struct{}
 func (UnimplementedRoadmapInfoServiceServer) GetByRoadmapID(context.Context, *GetByRoadmapIDRequest) (*GetByRoadmapIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByRoadmapID not implemented")
 }
+func (UnimplementedRoadmapInfoServiceServer) GetSubscribedRoadmaps(context.Context, *GetSubscribedRoadmapsRequest) (*GetSubscribedRoadmapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribedRoadmaps not implemented")
+}
 func (UnimplementedRoadmapInfoServiceServer) mustEmbedUnimplementedRoadmapInfoServiceServer() {}
 func (UnimplementedRoadmapInfoServiceServer) testEmbeddedByValue()                            {}
 
@@ -106,6 +122,24 @@ func _RoadmapInfoService_GetByRoadmapID_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoadmapInfoService_GetSubscribedRoadmaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscribedRoadmapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadmapInfoServiceServer).GetSubscribedRoadmaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadmapInfoService_GetSubscribedRoadmaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadmapInfoServiceServer).GetSubscribedRoadmaps(ctx, req.(*GetSubscribedRoadmapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoadmapInfoService_ServiceDesc is the grpc.ServiceDesc for RoadmapInfoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +151,10 @@ var RoadmapInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByRoadmapID",
 			Handler:    _RoadmapInfoService_GetByRoadmapID_Handler,
 		},
+		{
+			MethodName: "GetSubscribedRoadmaps",
+			Handler:    _RoadmapInfoService_GetSubscribedRoadmaps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/infrastructure/client/roadmapinfoclient/proto/roadmapinfo.proto",
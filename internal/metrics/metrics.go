@@ -23,6 +23,14 @@ type Metrics interface {
 	IncGRPCError(method, code string)
 	SetGRPCInFlight(count int)
 
+	IncLLMOutputParse(feature, outcome string)
+	AddLLMOutputRepairs(feature, kind string, count int)
+
+	SetBrokenLinks(countsByRoadmap map[string]int)
+
+	SetConsistencyIssues(countsByKind map[string]int)
+	IncConsistencyRepair(kind, outcome string)
+
 	Handler() http.Handler
 }
 
@@ -40,6 +48,14 @@ type MetricsImpl struct {
 	grpcRequestDuration  *prometheus.HistogramVec
 	grpcErrorsTotal      *prometheus.CounterVec
 	grpcRequestsInFlight prometheus.Gauge
+
+	llmOutputParseTotal   *prometheus.CounterVec
+	llmOutputRepairsTotal *prometheus.CounterVec
+
+	roadmapBrokenLinks *prometheus.GaugeVec
+
+	consistencyIssues       *prometheus.GaugeVec
+	consistencyRepairsTotal *prometheus.CounterVec
 }
 
 func NewMetrics(
@@ -124,6 +140,46 @@ func NewMetrics(
 				Help: "Current number of gRPC requests being processed",
 			},
 		),
+
+		llmOutputParseTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "llm_output_parse_total",
+				Help: "Total number of parsed LLM responses by outcome",
+			},
+			[]string{"feature", "outcome"},
+		),
+
+		llmOutputRepairsTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "llm_output_repairs_total",
+				Help: "Total number of repairs applied to LLM responses",
+			},
+			[]string{"feature", "kind"},
+		),
+
+		roadmapBrokenLinks: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "roadmap_broken_links",
+				Help: "Number of broken material links per roadmap found by the last link check",
+			},
+			[]string{"roadmap_id"},
+		),
+
+		consistencyIssues: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "consistency_issues",
+				Help: "Number of inconsistencies between stores found by the last reconciler run",
+			},
+			[]string{"kind"},
+		),
+
+		consistencyRepairsTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "consistency_repairs_total",
+				Help: "Total number of inconsistencies repaired by the reconciler by outcome",
+			},
+			[]string{"kind", "outcome"},
+		),
 	}
 }
 
@@ -167,6 +223,34 @@ func (m *MetricsImpl) SetGRPCInFlight(count int) {
 	m.grpcRequestsInFlight.Set(float64(count))
 }
 
+func (m *MetricsImpl) IncLLMOutputParse(feature, outcome string) {
+	m.llmOutputParseTotal.WithLabelValues(feature, outcome).Inc()
+}
+
+func (m *MetricsImpl) AddLLMOutputRepairs(feature, kind string, count int) {
+	m.llmOutputRepairsTotal.WithLabelValues(feature, kind).Add(float64(count))
+}
+
+// SetBrokenLinks replaces all per-roadmap values so fixed roadmaps drop out of the gauge.
+func (m *MetricsImpl) SetBrokenLinks(countsByRoadmap map[string]int) {
+	m.roadmapBrokenLinks.Reset()
+	for roadmapID, count := range countsByRoadmap {
+		m.roadmapBrokenLinks.WithLabelValues(roadmapID).Set(float64(count))
+	}
+}
+
+// SetConsistencyIssues replaces all per-kind values so resolved kinds drop out of the gauge.
+func (m *MetricsImpl) SetConsistencyIssues(countsByKind map[string]int) {
+	m.consistencyIssues.Reset()
+	for kind, count := range countsByKind {
+		m.consistencyIssues.WithLabelValues(kind).Set(float64(count))
+	}
+}
+
+func (m *MetricsImpl) IncConsistencyRepair(kind, outcome string) {
+	m.consistencyRepairsTotal.WithLabelValues(kind, outcome).Inc()
+}
+
 func (m *MetricsImpl) Handler() http.Handler {
 	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
 }
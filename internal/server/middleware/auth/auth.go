@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
 	"github.com/F0urward/proftwist-backend/internal/utils"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
@@ -108,3 +109,18 @@ func (a *AuthMiddleware) OptionalAuthMiddleware(next http.Handler) http.Handler
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+func (a *AuthMiddleware) AdminMiddleware(next http.Handler) http.Handler {
+	return a.AuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := ctxutil.GetLogger(r.Context())
+
+		role, _ := r.Context().Value(utils.RoleKey{}).(string)
+		if role != entities.UserRoleAdmin {
+			logger.WithField("role", role).Warn("admin role required")
+			utils.JSONError(r.Context(), w, http.StatusForbidden, "forbidden")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}))
+}
@@ -15,13 +15,25 @@ const (
 type WebSocketMessageType string
 
 const (
-	WebSocketMessageTypeSendMessage WebSocketMessageType = "send_message"
-	WebSocketMessageTypeTyping      WebSocketMessageType = "typing"
+	WebSocketMessageTypeSendMessage      WebSocketMessageType = "send_message"
+	WebSocketMessageTypeTyping           WebSocketMessageType = "typing"
+	WebSocketMessageTypeRoadmapJoin      WebSocketMessageType = "roadmap_join"
+	WebSocketMessageTypeRoadmapLeave     WebSocketMessageType = "roadmap_leave"
+	WebSocketMessageTypeRoadmapCursor    WebSocketMessageType = "roadmap_cursor"
+	WebSocketMessageTypeRoadmapOperation WebSocketMessageType = "roadmap_operation"
 
 	WebSocketMessageTypeMessageSent        WebSocketMessageType = "message_sent"
 	WebSocketMessageTypeTypingNotification WebSocketMessageType = "typing_notification"
 	WebSocketMessageTypeUserJoined         WebSocketMessageType = "user_joined"
 	WebSocketMessageTypeUserLeft           WebSocketMessageType = "user_left"
+	WebSocketMessageTypeGoalReminder       WebSocketMessageType = "goal_reminder"
+	WebSocketMessageTypeCollaboratorInvite WebSocketMessageType = "collaborator_invite"
+
+	WebSocketMessageTypeRoadmapSnapshot         WebSocketMessageType = "roadmap_snapshot"
+	WebSocketMessageTypeRoadmapPresence         WebSocketMessageType = "roadmap_presence"
+	WebSocketMessageTypeRoadmapCursorUpdate     WebSocketMessageType = "roadmap_cursor_update"
+	WebSocketMessageTypeRoadmapOperationApplied WebSocketMessageType = "roadmap_operation_applied"
+	WebSocketMessageTypeRoadmapEditError        WebSocketMessageType = "roadmap_edit_error"
 )
 
 type WebSocketMessage struct {
@@ -82,3 +94,54 @@ type UserLeftNotificationData struct {
 	Username string    `json:"username"`
 	LeftAt   time.Time `json:"left_at"`
 }
+
+type GoalReminderNotificationData struct {
+	RoadmapID             string     `json:"roadmap_id"`
+	RoadmapName           string     `json:"roadmap_name"`
+	Status                string     `json:"status"`
+	Deadline              time.Time  `json:"deadline"`
+	RemainingNodes        int        `json:"remaining_nodes"`
+	ActualPace            float64    `json:"actual_pace"`
+	RequiredPace          float64    `json:"required_pace"`
+	ProjectedCompletionAt *time.Time `json:"projected_completion_at,omitempty"`
+}
+
+type CollaboratorInviteNotificationData struct {
+	RoadmapInfoID string `json:"roadmap_info_id"`
+	RoadmapName   string `json:"roadmap_name"`
+	Role          string `json:"role"`
+	InvitedBy     string `json:"invited_by"`
+	InviterName   string `json:"inviter_name"`
+}
+
+type RoadmapPresenceStatus string
+
+const (
+	RoadmapPresenceJoined RoadmapPresenceStatus = "joined"
+	RoadmapPresenceLeft   RoadmapPresenceStatus = "left"
+)
+
+type RoadmapEditingData struct {
+	RoadmapID string `json:"roadmap_id"`
+}
+
+type RoadmapCursorData struct {
+	RoadmapID       string   `json:"roadmap_id"`
+	UserID          string   `json:"user_id,omitempty"`
+	X               float64  `json:"x"`
+	Y               float64  `json:"y"`
+	SelectedNodeIDs []string `json:"selected_node_ids,omitempty"`
+}
+
+type RoadmapPresenceData struct {
+	RoadmapID string                `json:"roadmap_id"`
+	UserID    string                `json:"user_id"`
+	Status    RoadmapPresenceStatus `json:"status"`
+	At        time.Time             `json:"at"`
+}
+
+type RoadmapEditErrorData struct {
+	RoadmapID   string `json:"roadmap_id"`
+	OperationID string `json:"operation_id,omitempty"`
+	Reason      string `json:"reason"`
+}
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -648,7 +649,398 @@ func (v *SendMessageData) UnmarshalJSON(data []byte) error {
 func (v *SendMessageData) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto5(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6(in *jlexer.Lexer, out *MessageSentData) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6(in *jlexer.Lexer, out *RoadmapPresenceData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserID = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = RoadmapPresenceStatus(in.String())
+			}
+		case "at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.At).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6(out *jwriter.Writer, in RoadmapPresenceData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"at\":"
+		out.RawString(prefix)
+		out.Raw((in.At).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapPresenceData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapPresenceData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapPresenceData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapPresenceData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7(in *jlexer.Lexer, out *RoadmapEditingData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7(out *jwriter.Writer, in RoadmapEditingData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapEditingData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapEditingData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapEditingData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapEditingData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto8(in *jlexer.Lexer, out *RoadmapEditErrorData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "operation_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OperationID = string(in.String())
+			}
+		case "reason":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Reason = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto8(out *jwriter.Writer, in RoadmapEditErrorData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	if in.OperationID != "" {
+		const prefix string = ",\"operation_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OperationID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapEditErrorData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapEditErrorData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapEditErrorData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapEditErrorData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto8(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto9(in *jlexer.Lexer, out *RoadmapCursorData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserID = string(in.String())
+			}
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.X = float64(in.Float64())
+			}
+		case "y":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Y = float64(in.Float64())
+			}
+		case "selected_node_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.SelectedNodeIDs = nil
+			} else {
+				in.Delim('[')
+				if out.SelectedNodeIDs == nil {
+					if !in.IsDelim(']') {
+						out.SelectedNodeIDs = make([]string, 0, 4)
+					} else {
+						out.SelectedNodeIDs = []string{}
+					}
+				} else {
+					out.SelectedNodeIDs = (out.SelectedNodeIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v3 = string(in.String())
+					}
+					out.SelectedNodeIDs = append(out.SelectedNodeIDs, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto9(out *jwriter.Writer, in RoadmapCursorData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.X))
+	}
+	{
+		const prefix string = ",\"y\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Y))
+	}
+	if len(in.SelectedNodeIDs) != 0 {
+		const prefix string = ",\"selected_node_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.SelectedNodeIDs {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapCursorData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapCursorData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapCursorData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapCursorData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto9(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto10(in *jlexer.Lexer, out *MessageSentData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -711,15 +1103,15 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v3 interface{}
-					if m, ok := v3.(easyjson.Unmarshaler); ok {
+					var v6 interface{}
+					if m, ok := v6.(easyjson.Unmarshaler); ok {
 						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v3.(json.Unmarshaler); ok {
+					} else if m, ok := v6.(json.Unmarshaler); ok {
 						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						v3 = in.Interface()
+						v6 = in.Interface()
 					}
-					(out.Metadata)[key] = v3
+					(out.Metadata)[key] = v6
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -742,7 +1134,7 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6(out *jwriter.Writer, in MessageSentData) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto10(out *jwriter.Writer, in MessageSentData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -781,21 +1173,21 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6
 		out.RawString(prefix)
 		{
 			out.RawByte('{')
-			v4First := true
-			for v4Name, v4Value := range in.Metadata {
-				if v4First {
-					v4First = false
+			v7First := true
+			for v7Name, v7Value := range in.Metadata {
+				if v7First {
+					v7First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v4Name))
+				out.String(string(v7Name))
 				out.RawByte(':')
-				if m, ok := v4Value.(easyjson.Marshaler); ok {
+				if m, ok := v7Value.(easyjson.Marshaler); ok {
 					m.MarshalEasyJSON(out)
-				} else if m, ok := v4Value.(json.Marshaler); ok {
+				} else if m, ok := v7Value.(json.Marshaler); ok {
 					out.Raw(m.MarshalJSON())
 				} else {
-					out.Raw(json.Marshal(v4Value))
+					out.Raw(json.Marshal(v7Value))
 				}
 			}
 			out.RawByte('}')
@@ -812,27 +1204,27 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6
 // MarshalJSON supports json.Marshaler interface
 func (v MessageSentData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageSentData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto6(w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageSentData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageSentData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto6(l, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto10(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7(in *jlexer.Lexer, out *MessageDeliveredData) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto11(in *jlexer.Lexer, out *MessageDeliveredData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -882,7 +1274,7 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7(out *jwriter.Writer, in MessageDeliveredData) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto11(out *jwriter.Writer, in MessageDeliveredData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -912,23 +1304,286 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7
 // MarshalJSON supports json.Marshaler interface
 func (v MessageDeliveredData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageDeliveredData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto7(w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageDeliveredData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageDeliveredData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto7(l, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto11(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto12(in *jlexer.Lexer, out *GoalReminderNotificationData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "roadmap_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapName = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
+		case "deadline":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Deadline).UnmarshalJSON(data))
+				}
+			}
+		case "remaining_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RemainingNodes = int(in.Int())
+			}
+		case "actual_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualPace = float64(in.Float64())
+			}
+		case "required_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RequiredPace = float64(in.Float64())
+			}
+		case "projected_completion_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ProjectedCompletionAt = nil
+			} else {
+				if out.ProjectedCompletionAt == nil {
+					out.ProjectedCompletionAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.ProjectedCompletionAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto12(out *jwriter.Writer, in GoalReminderNotificationData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"roadmap_name\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapName))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"deadline\":"
+		out.RawString(prefix)
+		out.Raw((in.Deadline).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"remaining_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.RemainingNodes))
+	}
+	{
+		const prefix string = ",\"actual_pace\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ActualPace))
+	}
+	{
+		const prefix string = ",\"required_pace\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RequiredPace))
+	}
+	if in.ProjectedCompletionAt != nil {
+		const prefix string = ",\"projected_completion_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ProjectedCompletionAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GoalReminderNotificationData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GoalReminderNotificationData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GoalReminderNotificationData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GoalReminderNotificationData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto12(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto13(in *jlexer.Lexer, out *CollaboratorInviteNotificationData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_info_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapInfoID = string(in.String())
+			}
+		case "roadmap_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapName = string(in.String())
+			}
+		case "role":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Role = string(in.String())
+			}
+		case "invited_by":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InvitedBy = string(in.String())
+			}
+		case "inviter_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InviterName = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto13(out *jwriter.Writer, in CollaboratorInviteNotificationData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_info_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapInfoID))
+	}
+	{
+		const prefix string = ",\"roadmap_name\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapName))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"invited_by\":"
+		out.RawString(prefix)
+		out.String(string(in.InvitedBy))
+	}
+	{
+		const prefix string = ",\"inviter_name\":"
+		out.RawString(prefix)
+		out.String(string(in.InviterName))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollaboratorInviteNotificationData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollaboratorInviteNotificationData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendInternalServerWsDto13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollaboratorInviteNotificationData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollaboratorInviteNotificationData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendInternalServerWsDto13(l, v)
 }
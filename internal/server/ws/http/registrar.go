@@ -8,14 +8,16 @@ import (
 
 type WebSocketHttpRegistrar struct {
 	handler *WebSocketHandler
+	path    string
 }
 
-func NewWebSocketHttpRegistrar(handler *WebSocketHandler) httpServer.HttpRegistrar {
+func NewWebSocketHttpRegistrar(handler *WebSocketHandler, path string) httpServer.HttpRegistrar {
 	return &WebSocketHttpRegistrar{
 		handler: handler,
+		path:    path,
 	}
 }
 
 func (r *WebSocketHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
-	s.MUX.Handle("/api/v1/chats/ws", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handler.HandleConnection))).Methods("GET")
+	s.MUX.Handle(r.path, s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handler.HandleConnection))).Methods("GET")
 }
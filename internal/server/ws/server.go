@@ -19,6 +19,8 @@ type WsRegistrar interface {
 
 type MessageHandler func(*WsClient, dto.WebSocketMessage) error
 
+type DisconnectHandler func(*WsClient)
+
 type WsServer struct {
 	config          *config.Config
 	upgrader        websocket.Upgrader
@@ -28,6 +30,7 @@ type WsServer struct {
 	unregister      chan *WsClient
 	broadcast       chan dto.WebSocketMessage
 	messageHandlers map[dto.WebSocketMessageType]MessageHandler
+	disconnectHooks []DisconnectHandler
 	mutex           sync.RWMutex
 	logger          logger.Logger
 	Registrars      []WsRegistrar
@@ -67,6 +70,14 @@ func (s *WsServer) RegisterMessageHandler(messageType dto.WebSocketMessageType,
 	s.messageHandlers[messageType] = handler
 }
 
+// RegisterDisconnectHandler adds a hook that runs after a client has been
+// unregistered, so registrars can drop any state kept for it.
+func (s *WsServer) RegisterDisconnectHandler(handler DisconnectHandler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.disconnectHooks = append(s.disconnectHooks, handler)
+}
+
 func (s *WsServer) HandleWebSocket(w http.ResponseWriter, r *http.Request, userID string) error {
 	s.logger.WithFields(logrus.Fields{
 		"user_id":     userID,
@@ -118,7 +129,8 @@ func (s *WsServer) Run() {
 
 		case client := <-s.unregister:
 			s.mutex.Lock()
-			if _, ok := s.clients[client]; ok {
+			_, registered := s.clients[client]
+			if registered {
 				delete(s.clients, client)
 				close(client.Send)
 
@@ -134,8 +146,15 @@ func (s *WsServer) Run() {
 					}
 				}
 			}
+			hooks := s.disconnectHooks
 			s.mutex.Unlock()
 
+			if registered {
+				for _, hook := range hooks {
+					go hook(client)
+				}
+			}
+
 			client.mu.Lock()
 			if !client.closed && client.Conn != nil {
 				client.closed = true
@@ -190,6 +209,28 @@ func (s *WsServer) SendToUser(userID string, message dto.WebSocketMessage) error
 	return nil
 }
 
+// SendToClient delivers the message to a single connection. Messages for a
+// client that has already disconnected are dropped.
+func (s *WsServer) SendToClient(client *WsClient, message dto.WebSocketMessage) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, ok := s.clients[client]; !ok {
+		return nil
+	}
+
+	select {
+	case client.Send <- message:
+	default:
+		s.logger.WithFields(logrus.Fields{
+			"client_id": client.ID,
+			"user_id":   client.UserID,
+		}).Warn("Failed to send message to client - channel full")
+		go s.closeClient(client)
+	}
+	return nil
+}
+
 func (s *WsServer) SendToUsers(userIDs []string, message dto.WebSocketMessage) error {
 	for _, userID := range userIDs {
 		if err := s.SendToUser(userID, message); err != nil {
@@ -32,7 +32,7 @@ func AllHttpRegistrars(
 	wsHandlers *wsServerHTTPHandlers.WebSocketHandler,
 ) []httpServer.HttpRegistrar {
 	chatRegistrar := chatHTTPHandlers.NewChatHttpRegistrar(chatHandlers)
-	wsRegistrar := wsServerHTTPHandlers.NewWebSocketHttpRegistrar(wsHandlers)
+	wsRegistrar := wsServerHTTPHandlers.NewWebSocketHttpRegistrar(wsHandlers, "/api/v1/chats/ws")
 
 	return []httpServer.HttpRegistrar{
 		chatRegistrar,
@@ -74,6 +74,15 @@ func ProvideBotPublisher(cfg *config.Config) chat.BotPublisher {
 	return chatAdapters.NewBotPublisher(producer)
 }
 
+func ProvideGamificationPublisher(cfg *config.Config) chat.GamificationPublisher {
+	producerConfig := kafka.ProducerConfig{
+		Broker: cfg.Kafka.Broker,
+		Topic:  cfg.Kafka.Producers.Gamification.Topic,
+	}
+	producer := kafka.NewProducer(producerConfig)
+	return chatAdapters.NewGamificationPublisher(producer)
+}
+
 func ProvideNotificationConsumerConfig(cfg *config.Config) kafka.ConsumerConfig {
 	return kafka.ConsumerConfig{
 		Broker:  cfg.Kafka.Broker,
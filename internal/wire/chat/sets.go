@@ -19,7 +19,7 @@ import (
 	authClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
 	chatClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
 	friendClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
-	gigachatClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	llmClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
 	db "github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
 
 	wsServerHTTPHandlers "github.com/F0urward/proftwist-backend/internal/server/ws/http"
@@ -57,7 +57,7 @@ var ClientsSet = wire.NewSet(
 	authClient.NewAuthClient,
 	friendClient.NewFriendClient,
 	chatClient.NewChatClient,
-	gigachatClient.NewGigaChatClient,
+	llmClient.NewBotProvider,
 )
 
 var BrokerSet = wire.NewSet(
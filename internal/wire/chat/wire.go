@@ -34,6 +34,7 @@ func InitializeChatWsServer(cfg *config.Config, log logger.Logger) *wsServer.WsS
 		ChatSet,
 		ProvideNotificationPublisher,
 		ProvideBotPublisher,
+		ProvideGamificationPublisher,
 		AllWsRegistrars,
 		wsServer.New,
 	)
@@ -46,6 +47,7 @@ func InitializeChatHttpServer(cfg *config.Config, wsServer *wsServer.WsServer, l
 		ChatSet,
 		ProvideNotificationPublisher,
 		ProvideBotPublisher,
+		ProvideGamificationPublisher,
 		WsSet,
 		AllHttpRegistrars,
 		httpServer.New,
@@ -63,6 +65,7 @@ func InitializeChatGrpcServer(cfg *config.Config, wsServer *wsServer.WsServer, l
 		ChatSet,
 		ProvideNotificationPublisher,
 		ProvideBotPublisher,
+		ProvideGamificationPublisher,
 		AllGrpcRegistrars,
 		grpcServer.New,
 		logginginterceptor.NewLoggingUnaryServerInterceptor,
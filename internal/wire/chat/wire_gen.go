@@ -12,7 +12,7 @@ import (
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
-	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
 	"github.com/F0urward/proftwist-backend/internal/metrics"
 	"github.com/F0urward/proftwist-backend/internal/server/grpc"
@@ -51,9 +51,10 @@ func InitializeChatWsServer(cfg *config.Config, log logger.Logger) *ws.WsServer
 	chatRepository := repository.NewChatPostgresRepository(db)
 	notificationPublisher := ProvideNotificationPublisher(cfg)
 	botPublisher := ProvideBotPublisher(cfg)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
 	authServiceClient := authclient.NewAuthClient(cfg)
 	friendServiceClient := friendclient.NewFriendClient(cfg)
-	chatUsecase := usecase.NewChatUsecase(chatRepository, notificationPublisher, botPublisher, authServiceClient, friendServiceClient, cfg)
+	chatUsecase := usecase.NewChatUsecase(chatRepository, notificationPublisher, botPublisher, gamificationPublisher, authServiceClient, friendServiceClient, cfg)
 	wsHandlers := ws2.NewChatWsHandlers(chatUsecase)
 	wsRegistrar := ws2.NewChatWsRegistrar(wsHandlers)
 	v := AllWsRegistrars(wsRegistrar)
@@ -71,8 +72,9 @@ func InitializeChatHttpServer(cfg *config.Config, wsServer *ws.WsServer, log log
 	chatRepository := repository.NewChatPostgresRepository(db)
 	notificationPublisher := ProvideNotificationPublisher(cfg)
 	botPublisher := ProvideBotPublisher(cfg)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
 	friendServiceClient := friendclient.NewFriendClient(cfg)
-	chatUsecase := usecase.NewChatUsecase(chatRepository, notificationPublisher, botPublisher, authServiceClient, friendServiceClient, cfg)
+	chatUsecase := usecase.NewChatUsecase(chatRepository, notificationPublisher, botPublisher, gamificationPublisher, authServiceClient, friendServiceClient, cfg)
 	handlers := http2.NewChatHandler(chatUsecase)
 	webSocketHandler := http3.NewWebSocketHandler(wsServer)
 	v := AllHttpRegistrars(handlers, webSocketHandler)
@@ -87,9 +89,10 @@ func InitializeChatGrpcServer(cfg *config.Config, wsServer *ws.WsServer, log log
 	chatRepository := repository.NewChatPostgresRepository(db)
 	notificationPublisher := ProvideNotificationPublisher(cfg)
 	botPublisher := ProvideBotPublisher(cfg)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
 	authServiceClient := authclient.NewAuthClient(cfg)
 	friendServiceClient := friendclient.NewFriendClient(cfg)
-	chatUsecase := usecase.NewChatUsecase(chatRepository, notificationPublisher, botPublisher, authServiceClient, friendServiceClient, cfg)
+	chatUsecase := usecase.NewChatUsecase(chatRepository, notificationPublisher, botPublisher, gamificationPublisher, authServiceClient, friendServiceClient, cfg)
 	chatServiceServer := grpc2.NewChatServer(chatUsecase)
 	grpcRegistrar := grpc2.NewChatGrpcRegistrar(chatServiceServer)
 	v := AllGrpcRegistrars(grpcRegistrar)
@@ -109,8 +112,8 @@ func InitializeNotificationWorker(cfg *config.Config, wsServer *ws.WsServer) *wo
 func InitializeBotWorker(cfg *config.Config) *worker.BotWorker {
 	consumerConfig := ProvideBotConsumerConfig(cfg)
 	consumer := kafka.NewConsumer(consumerConfig)
-	client := gigachatclient.NewGigaChatClient(cfg)
-	gigachatWebapi := repository2.NewGigachatWebapi(client)
+	provider := llmclient.NewBotProvider(cfg)
+	gigachatWebapi := repository2.NewGigachatWebapi(provider)
 	chatServiceClient := chatclient.NewChatClient(cfg)
 	botUsecase := bot.NewBotUsecase(gigachatWebapi, chatServiceClient, cfg)
 	handlers := kafka3.NewBotHandlers(botUsecase)
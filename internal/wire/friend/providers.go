@@ -1,9 +1,13 @@
 package friend
 
 import (
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker/kafka"
 	"github.com/F0urward/proftwist-backend/internal/metrics"
 	grpcServer "github.com/F0urward/proftwist-backend/internal/server/grpc"
 	httpServer "github.com/F0urward/proftwist-backend/internal/server/http"
+	friend "github.com/F0urward/proftwist-backend/services/friend"
+	friendAdapters "github.com/F0urward/proftwist-backend/services/friend/adapter"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -35,3 +39,12 @@ func AllGrpcRegistrars(
 		friendGrpcRegistrar,
 	}
 }
+
+func ProvideGamificationPublisher(cfg *config.Config) friend.GamificationPublisher {
+	producerConfig := kafka.ProducerConfig{
+		Broker: cfg.Kafka.Broker,
+		Topic:  cfg.Kafka.Producers.Gamification.Topic,
+	}
+	producer := kafka.NewProducer(producerConfig)
+	return friendAdapters.NewGamificationPublisher(producer)
+}
@@ -30,6 +30,7 @@ func InitializeFriendHttpServer(cfg *config.Config, log logger.Logger, mtrs metr
 	wire.Build(
 		ClientsSet,
 		FriendSet,
+		ProvideGamificationPublisher,
 		AllHttpRegistrars,
 		httpServer.New,
 		authmiddleware.NewAuthMiddleware,
@@ -44,6 +45,7 @@ func InitializeFriendGrpcServer(cfg *config.Config, log logger.Logger, mtrs metr
 	wire.Build(
 		ClientsSet,
 		FriendSet,
+		ProvideGamificationPublisher,
 		AllGrpcRegistrars,
 		grpcServer.New,
 		logginginterceptor.NewLoggingUnaryServerInterceptor,
@@ -43,7 +43,8 @@ func InitializeFriendHttpServer(cfg *config.Config, log logger.Logger, mtrs metr
 	db := postgres.NewDatabase(cfg)
 	friendRepository := repository.NewFriendPostgresRepository(db)
 	chatServiceClient := chatclient.NewChatClient(cfg)
-	friendUsecase := usecase.NewFriendUsecase(friendRepository, authServiceClient, chatServiceClient)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
+	friendUsecase := usecase.NewFriendUsecase(friendRepository, authServiceClient, chatServiceClient, gamificationPublisher)
 	handlers := http2.NewFriendHandlers(friendUsecase)
 	httpRegistrar := http2.NewFriendHttpRegistrar(handlers)
 	v := AllHttpRegistrars(httpRegistrar)
@@ -58,7 +59,8 @@ func InitializeFriendGrpcServer(cfg *config.Config, log logger.Logger, mtrs metr
 	friendRepository := repository.NewFriendPostgresRepository(db)
 	authServiceClient := authclient.NewAuthClient(cfg)
 	chatServiceClient := chatclient.NewChatClient(cfg)
-	friendUsecase := usecase.NewFriendUsecase(friendRepository, authServiceClient, chatServiceClient)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
+	friendUsecase := usecase.NewFriendUsecase(friendRepository, authServiceClient, chatServiceClient, gamificationPublisher)
 	friendServiceServer := grpc2.NewFriendServer(friendUsecase)
 	grpcRegistrar := grpc2.NewFriendGrpcRegistrar(friendServiceServer)
 	v := AllGrpcRegistrars(grpcRegistrar)
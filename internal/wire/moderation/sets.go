@@ -3,11 +3,15 @@ package moderation
 import (
 	"github.com/google/wire"
 
+	promptRepository "github.com/F0urward/proftwist-backend/services/prompt/repository"
+	promptUsecase "github.com/F0urward/proftwist-backend/services/prompt/usecase"
+
 	moderationGrpc "github.com/F0urward/proftwist-backend/services/moderation/delivery/grpc"
 	moderationRepository "github.com/F0urward/proftwist-backend/services/moderation/repository"
 	moderationUsecase "github.com/F0urward/proftwist-backend/services/moderation/usecase"
 
-	gigachatClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	llmClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	db "github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
 )
 
 var ModerationSet = wire.NewSet(
@@ -17,6 +21,12 @@ var ModerationSet = wire.NewSet(
 	moderationGrpc.NewModerationGrpcRegistrar,
 )
 
+var PromptSet = wire.NewSet(
+	promptRepository.NewPromptPostgresRepository,
+	promptUsecase.NewPromptUsecase,
+)
+
 var ClientsSet = wire.NewSet(
-	gigachatClient.NewGigaChatClient,
+	db.NewDatabase,
+	llmClient.NewModerationProvider,
 )
@@ -25,6 +25,7 @@ func InitializeModerationGrpcServer(cfg *config.Config, log logger.Logger, mtrs
 	wire.Build(
 		ClientsSet,
 		ModerationSet,
+		PromptSet,
 		AllGrpcRegistrars,
 		grpcServer.New,
 		logginginterceptor.NewLoggingUnaryServerInterceptor,
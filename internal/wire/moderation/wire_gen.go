@@ -8,7 +8,8 @@ package moderation
 
 import (
 	"github.com/F0urward/proftwist-backend/config"
-	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
 	"github.com/F0urward/proftwist-backend/internal/metrics"
 	"github.com/F0urward/proftwist-backend/internal/server/grpc"
 	"github.com/F0urward/proftwist-backend/internal/server/interceptor/logging"
@@ -16,7 +17,9 @@ import (
 	"github.com/F0urward/proftwist-backend/pkg/logger"
 	grpc2 "github.com/F0urward/proftwist-backend/services/moderation/delivery/grpc"
 	"github.com/F0urward/proftwist-backend/services/moderation/repository"
-	"github.com/F0urward/proftwist-backend/services/moderation/usecase"
+	usecase2 "github.com/F0urward/proftwist-backend/services/moderation/usecase"
+	repository2 "github.com/F0urward/proftwist-backend/services/prompt/repository"
+	"github.com/F0urward/proftwist-backend/services/prompt/usecase"
 )
 
 // Injectors from wire.go:
@@ -29,9 +32,12 @@ func InitializeMetrics() metrics.Metrics {
 func InitializeModerationGrpcServer(cfg *config.Config, log logger.Logger, mtrs metrics.Metrics) *grpc.GrpcServer {
 	loggingUnaryServerInterceptor := logging.NewLoggingUnaryServerInterceptor(log)
 	metricsUnaryServerInterceptor := metrics2.NewMetricsUnaryServerInterceptor(mtrs)
-	client := gigachatclient.NewGigaChatClient(cfg)
-	gigachatWebapi := repository.NewModerationGigaChatWebapi(client)
-	moderationUsecase := usecase.NewModerationUsecase(gigachatWebapi)
+	provider := llmclient.NewModerationProvider(cfg)
+	gigachatWebapi := repository.NewModerationGigaChatWebapi(provider)
+	db := postgres.NewDatabase(cfg)
+	promptRepository := repository2.NewPromptPostgresRepository(db)
+	promptUsecase := usecase.NewPromptUsecase(promptRepository)
+	moderationUsecase := usecase2.NewModerationUsecase(gigachatWebapi, promptUsecase)
 	moderationServiceServer := grpc2.NewModerationServer(moderationUsecase)
 	grpcRegistrar := grpc2.NewModerationGrpcRegistrar(moderationServiceServer)
 	v := AllGrpcRegistrars(grpcRegistrar)
@@ -1,9 +1,22 @@
 package roadmap
 
 import (
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker/kafka"
 	"github.com/F0urward/proftwist-backend/internal/metrics"
 	grpcServer "github.com/F0urward/proftwist-backend/internal/server/grpc"
 	httpServer "github.com/F0urward/proftwist-backend/internal/server/http"
+	wsServer "github.com/F0urward/proftwist-backend/internal/server/ws"
+	wsServerHTTPHandlers "github.com/F0urward/proftwist-backend/internal/server/ws/http"
+	"github.com/F0urward/proftwist-backend/services/comment"
+	commentHttp "github.com/F0urward/proftwist-backend/services/comment/delivery/http"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+	gamificationHttp "github.com/F0urward/proftwist-backend/services/gamification/delivery/http"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+	promptHttp "github.com/F0urward/proftwist-backend/services/prompt/delivery/http"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+	roadmapAdapters "github.com/F0urward/proftwist-backend/services/roadmap/adapter"
+	roadmapHttp "github.com/F0urward/proftwist-backend/services/roadmap/delivery/http"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -21,10 +34,25 @@ func Metrics() metrics.Metrics {
 }
 
 func AllHttpRegistrars(
-	roadmapHttpRegistrar httpServer.HttpRegistrar,
+	roadmapHandlers roadmap.Handlers,
+	promptHandlers prompt.Handlers,
+	commentHandlers comment.Handlers,
+	gamificationHandlers gamification.Handlers,
+	wsHandlers *wsServerHTTPHandlers.WebSocketHandler,
 ) []httpServer.HttpRegistrar {
+	roadmapRegistrar := roadmapHttp.NewRoadmapHttpRegistrar(roadmapHandlers)
+	promptRegistrar := promptHttp.NewPromptHttpRegistrar(promptHandlers)
+	commentRegistrar := commentHttp.NewCommentHttpRegistrar(commentHandlers)
+	gamificationRegistrar := gamificationHttp.NewGamificationHttpRegistrar(gamificationHandlers)
+	wsRegistrar := wsServerHTTPHandlers.NewWebSocketHttpRegistrar(wsHandlers, "/api/v1/roadmaps/ws")
+
+	// The WebSocket route goes first so /api/v1/roadmaps/{roadmap_id} does not swallow it.
 	return []httpServer.HttpRegistrar{
-		roadmapHttpRegistrar,
+		wsRegistrar,
+		roadmapRegistrar,
+		promptRegistrar,
+		commentRegistrar,
+		gamificationRegistrar,
 	}
 }
 
@@ -35,3 +63,37 @@ func AllGrpcRegistrars(
 		roadmapGrpcRegistrar,
 	}
 }
+
+func AllWsRegistrars(
+	roadmapWsRegistrar wsServer.WsRegistrar,
+) []wsServer.WsRegistrar {
+	return []wsServer.WsRegistrar{
+		roadmapWsRegistrar,
+	}
+}
+
+func ProvideGamificationPublisher(cfg *config.Config) roadmap.GamificationPublisher {
+	producerConfig := kafka.ProducerConfig{
+		Broker: cfg.Kafka.Broker,
+		Topic:  cfg.Kafka.Producers.Gamification.Topic,
+	}
+	producer := kafka.NewProducer(producerConfig)
+	return roadmapAdapters.NewGamificationPublisher(producer)
+}
+
+func ProvideNotificationPublisher(cfg *config.Config) roadmap.NotificationPublisher {
+	producerConfig := kafka.ProducerConfig{
+		Broker: cfg.Kafka.Broker,
+		Topic:  cfg.Kafka.Producers.Notification.Topic,
+	}
+	producer := kafka.NewProducer(producerConfig)
+	return roadmapAdapters.NewNotificationPublisher(producer)
+}
+
+func ProvideGamificationConsumerConfig(cfg *config.Config) kafka.ConsumerConfig {
+	return kafka.ConsumerConfig{
+		Broker:  cfg.Kafka.Broker,
+		Topic:   cfg.Kafka.Consumers.Gamification.Topic,
+		GroupID: cfg.Kafka.Consumers.Gamification.GroupID,
+	}
+}
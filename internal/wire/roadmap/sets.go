@@ -3,37 +3,111 @@ package roadmap
 import (
 	"github.com/google/wire"
 
+	commentHttp "github.com/F0urward/proftwist-backend/services/comment/delivery/http"
+	commentRepository "github.com/F0urward/proftwist-backend/services/comment/repository"
+	commentUsecase "github.com/F0urward/proftwist-backend/services/comment/usecase"
+	gamificationBroker "github.com/F0urward/proftwist-backend/services/gamification/delivery/broker"
+	gamificationHttp "github.com/F0urward/proftwist-backend/services/gamification/delivery/http"
+	gamificationRepository "github.com/F0urward/proftwist-backend/services/gamification/repository"
+	gamificationUsecase "github.com/F0urward/proftwist-backend/services/gamification/usecase"
+	promptHttp "github.com/F0urward/proftwist-backend/services/prompt/delivery/http"
+	promptRepository "github.com/F0urward/proftwist-backend/services/prompt/repository"
+	promptUsecase "github.com/F0urward/proftwist-backend/services/prompt/usecase"
 	roadmapGrpc "github.com/F0urward/proftwist-backend/services/roadmap/delivery/grpc"
 	roadmapHttp "github.com/F0urward/proftwist-backend/services/roadmap/delivery/http"
+	roadmapWs "github.com/F0urward/proftwist-backend/services/roadmap/delivery/ws"
 	roadmapRepository "github.com/F0urward/proftwist-backend/services/roadmap/repository"
 	roadmapUsecase "github.com/F0urward/proftwist-backend/services/roadmap/usecase"
 
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker/kafka"
 	authClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
 	chatClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
-	gigachatClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	friendClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
+	llmClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
 	moderationClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
 	roadmapInfoClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	awsClient "github.com/F0urward/proftwist-backend/internal/infrastructure/db/aws"
 	mongoClient "github.com/F0urward/proftwist-backend/internal/infrastructure/db/mongo"
 	db "github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
+
+	wsServerHTTPHandlers "github.com/F0urward/proftwist-backend/internal/server/ws/http"
 )
 
 var RoadmapSet = wire.NewSet(
 	roadmapRepository.NewRoadmapMongoRepository,
+	roadmapRepository.NewRoadmapAWSRepository,
 	roadmapRepository.NewRoadmapGigaChatWebapi,
+	roadmapRepository.NewRoadmapUnfurlWebapi,
 	roadmapUsecase.NewRoadmapUsecase,
 	roadmapHttp.NewRoadmapHandlers,
-	roadmapHttp.NewRoadmapHttpRegistrar,
 	roadmapGrpc.NewRoadmapServer,
 	roadmapGrpc.NewRoadmapGrpcRegistrar,
+	roadmapWs.NewRoadmapWsHandlers,
+	roadmapWs.NewRoadmapWsRegistrar,
+)
+
+var WsSet = wire.NewSet(
+	wsServerHTTPHandlers.NewWebSocketHandler,
+)
+
+var LinkCheckSet = wire.NewSet(
+	roadmapRepository.NewRoadmapMongoRepository,
+	roadmapRepository.NewRoadmapLinkCheckWebapi,
+	roadmapUsecase.NewLinkCheckUsecase,
+	mongoClient.NewClient,
+	mongoClient.NewDatabase,
+)
+
+var GoalReminderSet = wire.NewSet(
+	roadmapRepository.NewRoadmapMongoRepository,
+	roadmapUsecase.NewGoalReminderUsecase,
+	mongoClient.NewClient,
+	mongoClient.NewDatabase,
+	roadmapInfoClient.NewRoadmapInfoClient,
+)
+
+var CommentSet = wire.NewSet(
+	commentRepository.NewCommentMongoRepository,
+	commentUsecase.NewCommentUsecase,
+	commentHttp.NewCommentHandlers,
+)
+
+var GamificationSet = wire.NewSet(
+	gamificationRepository.NewGamificationMongoRepository,
+	gamificationUsecase.NewGamificationUsecase,
+	gamificationHttp.NewGamificationHandlers,
+)
+
+var GamificationWorkerSet = wire.NewSet(
+	gamificationRepository.NewGamificationMongoRepository,
+	gamificationUsecase.NewGamificationUsecase,
+	gamificationBroker.NewGamificationBrokerHandlers,
+	mongoClient.NewClient,
+	mongoClient.NewDatabase,
+	authClient.NewAuthClient,
+	friendClient.NewFriendClient,
+)
+
+var PromptSet = wire.NewSet(
+	promptRepository.NewPromptPostgresRepository,
+	promptUsecase.NewPromptUsecase,
+	promptHttp.NewPromptHandlers,
 )
 
 var ClientsSet = wire.NewSet(
 	db.NewDatabase,
 	mongoClient.NewClient,
 	mongoClient.NewDatabase,
-	gigachatClient.NewGigaChatClient,
+	awsClient.NewClient,
+	llmClient.NewRoadmapProvider,
 	chatClient.NewChatClient,
 	roadmapInfoClient.NewRoadmapInfoClient,
 	authClient.NewAuthClient,
+	friendClient.NewFriendClient,
 	moderationClient.NewModerationClient,
 )
+
+var BrokerSet = wire.NewSet(
+	kafka.NewConsumer,
+	kafka.NewProducer,
+)
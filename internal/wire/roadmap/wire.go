@@ -16,6 +16,8 @@ import (
 	corsmiddleware "github.com/F0urward/proftwist-backend/internal/server/middleware/cors"
 	loggingmiddleware "github.com/F0urward/proftwist-backend/internal/server/middleware/logging"
 	metricsmiddleware "github.com/F0urward/proftwist-backend/internal/server/middleware/metrics"
+	wsServer "github.com/F0urward/proftwist-backend/internal/server/ws"
+	"github.com/F0urward/proftwist-backend/internal/worker"
 	"github.com/F0urward/proftwist-backend/pkg/logger"
 )
 
@@ -26,10 +28,27 @@ func InitializeMetrics() metrics.Metrics {
 	return nil
 }
 
-func InitializeRoadmapHttpServer(cfg *config.Config, log logger.Logger, mtrs metrics.Metrics) *httpServer.HttpServer {
+func InitializeRoadmapWsServer(cfg *config.Config, log logger.Logger, mtrs metrics.Metrics) *wsServer.WsServer {
 	wire.Build(
 		ClientsSet,
 		RoadmapSet,
+		PromptSet,
+		ProvideGamificationPublisher,
+		AllWsRegistrars,
+		wsServer.New,
+	)
+	return &wsServer.WsServer{}
+}
+
+func InitializeRoadmapHttpServer(cfg *config.Config, wsServer *wsServer.WsServer, log logger.Logger, mtrs metrics.Metrics) *httpServer.HttpServer {
+	wire.Build(
+		ClientsSet,
+		RoadmapSet,
+		PromptSet,
+		CommentSet,
+		GamificationSet,
+		ProvideGamificationPublisher,
+		WsSet,
 		AllHttpRegistrars,
 		httpServer.New,
 		authmiddleware.NewAuthMiddleware,
@@ -44,6 +63,8 @@ func InitializeRoadmapGrpcServer(cfg *config.Config, log logger.Logger, mtrs met
 	wire.Build(
 		ClientsSet,
 		RoadmapSet,
+		PromptSet,
+		ProvideGamificationPublisher,
 		AllGrpcRegistrars,
 		grpcServer.New,
 		logginginterceptor.NewLoggingUnaryServerInterceptor,
@@ -51,3 +72,30 @@ func InitializeRoadmapGrpcServer(cfg *config.Config, log logger.Logger, mtrs met
 	)
 	return &grpcServer.GrpcServer{}
 }
+
+func InitializeLinkCheckWorker(cfg *config.Config, mtrs metrics.Metrics) *worker.LinkCheckWorker {
+	wire.Build(
+		LinkCheckSet,
+		worker.NewLinkCheckWorker,
+	)
+	return &worker.LinkCheckWorker{}
+}
+
+func InitializeGoalReminderWorker(cfg *config.Config) *worker.GoalReminderWorker {
+	wire.Build(
+		GoalReminderSet,
+		ProvideNotificationPublisher,
+		worker.NewGoalReminderWorker,
+	)
+	return &worker.GoalReminderWorker{}
+}
+
+func InitializeGamificationWorker(cfg *config.Config) *worker.GamificationWorker {
+	wire.Build(
+		GamificationWorkerSet,
+		ProvideGamificationConsumerConfig,
+		BrokerSet,
+		worker.NewGamificationWorker,
+	)
+	return &worker.GamificationWorker{}
+}
@@ -8,12 +8,16 @@ package roadmap
 
 import (
 	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker/kafka"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
-	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/db/aws"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/db/mongo"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
 	"github.com/F0urward/proftwist-backend/internal/metrics"
 	"github.com/F0urward/proftwist-backend/internal/server/grpc"
 	"github.com/F0urward/proftwist-backend/internal/server/http"
@@ -23,9 +27,23 @@ import (
 	"github.com/F0urward/proftwist-backend/internal/server/middleware/cors"
 	"github.com/F0urward/proftwist-backend/internal/server/middleware/logging"
 	metrics2 "github.com/F0urward/proftwist-backend/internal/server/middleware/metrics"
+	"github.com/F0urward/proftwist-backend/internal/server/ws"
+	http6 "github.com/F0urward/proftwist-backend/internal/server/ws/http"
+	"github.com/F0urward/proftwist-backend/internal/worker"
 	"github.com/F0urward/proftwist-backend/pkg/logger"
+	http4 "github.com/F0urward/proftwist-backend/services/comment/delivery/http"
+	repository3 "github.com/F0urward/proftwist-backend/services/comment/repository"
+	usecase2 "github.com/F0urward/proftwist-backend/services/comment/usecase"
+	kafka2 "github.com/F0urward/proftwist-backend/services/gamification/delivery/broker"
+	http5 "github.com/F0urward/proftwist-backend/services/gamification/delivery/http"
+	repository4 "github.com/F0urward/proftwist-backend/services/gamification/repository"
+	usecase3 "github.com/F0urward/proftwist-backend/services/gamification/usecase"
+	http3 "github.com/F0urward/proftwist-backend/services/prompt/delivery/http"
+	repository2 "github.com/F0urward/proftwist-backend/services/prompt/repository"
+	"github.com/F0urward/proftwist-backend/services/prompt/usecase"
 	grpc2 "github.com/F0urward/proftwist-backend/services/roadmap/delivery/grpc"
 	http2 "github.com/F0urward/proftwist-backend/services/roadmap/delivery/http"
+	ws2 "github.com/F0urward/proftwist-backend/services/roadmap/delivery/ws"
 	"github.com/F0urward/proftwist-backend/services/roadmap/repository"
 	"github.com/F0urward/proftwist-backend/services/roadmap/usecase"
 )
@@ -37,7 +55,32 @@ func InitializeMetrics() metrics.Metrics {
 	return metricsMetrics
 }
 
-func InitializeRoadmapHttpServer(cfg *config.Config, log logger.Logger, mtrs metrics.Metrics) *http.HttpServer {
+func InitializeRoadmapWsServer(cfg *config.Config, log logger.Logger, mtrs metrics.Metrics) *ws.WsServer {
+	client := mongo.NewClient(cfg)
+	database := mongo.NewDatabase(client, cfg)
+	mongoRepository := repository.NewRoadmapMongoRepository(database)
+	minioClient := aws.NewClient(cfg)
+	awsRepository := repository.NewRoadmapAWSRepository(minioClient)
+	provider := llmclient.NewRoadmapProvider(cfg)
+	gigachatWebapi := repository.NewRoadmapGigaChatWebapi(provider, mtrs)
+	unfurlWebapi := repository.NewRoadmapUnfurlWebapi(cfg)
+	roadmapInfoServiceClient := roadmapinfoclient.NewRoadmapInfoClient(cfg)
+	chatServiceClient := chatclient.NewChatClient(cfg)
+	authServiceClient := authclient.NewAuthClient(cfg)
+	moderationServiceClient := moderationclient.NewModerationClient(cfg)
+	db := postgres.NewDatabase(cfg)
+	promptRepository := repository2.NewPromptPostgresRepository(db)
+	promptUsecase := usecase.NewPromptUsecase(promptRepository)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
+	roadmapUsecase := roadmap.NewRoadmapUsecase(cfg, mongoRepository, awsRepository, gigachatWebapi, unfurlWebapi, roadmapInfoServiceClient, chatServiceClient, authServiceClient, moderationServiceClient, promptUsecase, gamificationPublisher)
+	wsHandlers := ws2.NewRoadmapWsHandlers(roadmapUsecase)
+	wsRegistrar := ws2.NewRoadmapWsRegistrar(wsHandlers)
+	v := AllWsRegistrars(wsRegistrar)
+	wsServer := ws.New(cfg, log, v...)
+	return wsServer
+}
+
+func InitializeRoadmapHttpServer(cfg *config.Config, wsServer *ws.WsServer, log logger.Logger, mtrs metrics.Metrics) *http.HttpServer {
 	authServiceClient := authclient.NewAuthClient(cfg)
 	authMiddleware := auth.NewAuthMiddleware(authServiceClient, cfg)
 	corsMiddleware := cors.NewCORSMiddleware(cfg)
@@ -46,15 +89,30 @@ func InitializeRoadmapHttpServer(cfg *config.Config, log logger.Logger, mtrs met
 	client := mongo.NewClient(cfg)
 	database := mongo.NewDatabase(client, cfg)
 	mongoRepository := repository.NewRoadmapMongoRepository(database)
-	gigachatclientClient := gigachatclient.NewGigaChatClient(cfg)
-	gigachatWebapi := repository.NewRoadmapGigaChatWebapi(gigachatclientClient)
+	minioClient := aws.NewClient(cfg)
+	awsRepository := repository.NewRoadmapAWSRepository(minioClient)
+	provider := llmclient.NewRoadmapProvider(cfg)
+	gigachatWebapi := repository.NewRoadmapGigaChatWebapi(provider, mtrs)
+	unfurlWebapi := repository.NewRoadmapUnfurlWebapi(cfg)
 	roadmapInfoServiceClient := roadmapinfoclient.NewRoadmapInfoClient(cfg)
 	chatServiceClient := chatclient.NewChatClient(cfg)
 	moderationServiceClient := moderationclient.NewModerationClient(cfg)
-	usecase := roadmap.NewRoadmapUsecase(mongoRepository, gigachatWebapi, roadmapInfoServiceClient, chatServiceClient, authServiceClient, moderationServiceClient)
-	handlers := http2.NewRoadmapHandlers(usecase)
-	httpRegistrar := http2.NewRoadmapHttpRegistrar(handlers)
-	v := AllHttpRegistrars(httpRegistrar)
+	db := postgres.NewDatabase(cfg)
+	promptRepository := repository2.NewPromptPostgresRepository(db)
+	promptUsecase := usecase.NewPromptUsecase(promptRepository)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
+	roadmapUsecase := roadmap.NewRoadmapUsecase(cfg, mongoRepository, awsRepository, gigachatWebapi, unfurlWebapi, roadmapInfoServiceClient, chatServiceClient, authServiceClient, moderationServiceClient, promptUsecase, gamificationPublisher)
+	handlers := http2.NewRoadmapHandlers(roadmapUsecase, cfg)
+	promptHandlers := http3.NewPromptHandlers(promptUsecase)
+	commentMongoRepository := repository3.NewCommentMongoRepository(database)
+	commentUsecase := usecase2.NewCommentUsecase(commentMongoRepository, mongoRepository, authServiceClient, moderationServiceClient)
+	commentHandlers := http4.NewCommentHandlers(commentUsecase)
+	gamificationMongoRepository := repository4.NewGamificationMongoRepository(database)
+	friendServiceClient := friendclient.NewFriendClient(cfg)
+	gamificationUsecase := usecase3.NewGamificationUsecase(cfg, gamificationMongoRepository, authServiceClient, friendServiceClient)
+	gamificationHandlers := http5.NewGamificationHandlers(gamificationUsecase)
+	webSocketHandler := http6.NewWebSocketHandler(wsServer)
+	v := AllHttpRegistrars(handlers, promptHandlers, commentHandlers, gamificationHandlers, webSocketHandler)
 	httpServer := http.New(cfg, authMiddleware, corsMiddleware, metricsMiddleware, loggingMiddleware, v...)
 	return httpServer
 }
@@ -65,16 +123,58 @@ func InitializeRoadmapGrpcServer(cfg *config.Config, log logger.Logger, mtrs met
 	client := mongo.NewClient(cfg)
 	database := mongo.NewDatabase(client, cfg)
 	mongoRepository := repository.NewRoadmapMongoRepository(database)
-	gigachatclientClient := gigachatclient.NewGigaChatClient(cfg)
-	gigachatWebapi := repository.NewRoadmapGigaChatWebapi(gigachatclientClient)
+	minioClient := aws.NewClient(cfg)
+	awsRepository := repository.NewRoadmapAWSRepository(minioClient)
+	provider := llmclient.NewRoadmapProvider(cfg)
+	gigachatWebapi := repository.NewRoadmapGigaChatWebapi(provider, mtrs)
+	unfurlWebapi := repository.NewRoadmapUnfurlWebapi(cfg)
 	roadmapInfoServiceClient := roadmapinfoclient.NewRoadmapInfoClient(cfg)
 	chatServiceClient := chatclient.NewChatClient(cfg)
 	authServiceClient := authclient.NewAuthClient(cfg)
 	moderationServiceClient := moderationclient.NewModerationClient(cfg)
-	usecase := roadmap.NewRoadmapUsecase(mongoRepository, gigachatWebapi, roadmapInfoServiceClient, chatServiceClient, authServiceClient, moderationServiceClient)
-	roadmapServiceServer := grpc2.NewRoadmapServer(usecase)
+	db := postgres.NewDatabase(cfg)
+	promptRepository := repository2.NewPromptPostgresRepository(db)
+	promptUsecase := usecase.NewPromptUsecase(promptRepository)
+	gamificationPublisher := ProvideGamificationPublisher(cfg)
+	roadmapUsecase := roadmap.NewRoadmapUsecase(cfg, mongoRepository, awsRepository, gigachatWebapi, unfurlWebapi, roadmapInfoServiceClient, chatServiceClient, authServiceClient, moderationServiceClient, promptUsecase, gamificationPublisher)
+	roadmapServiceServer := grpc2.NewRoadmapServer(roadmapUsecase)
 	grpcRegistrar := grpc2.NewRoadmapGrpcRegistrar(roadmapServiceServer)
 	v := AllGrpcRegistrars(grpcRegistrar)
 	grpcServer := grpc.New(cfg, loggingUnaryServerInterceptor, metricsUnaryServerInterceptor, v...)
 	return grpcServer
 }
+
+func InitializeLinkCheckWorker(cfg *config.Config, mtrs metrics.Metrics) *worker.LinkCheckWorker {
+	client := mongo.NewClient(cfg)
+	database := mongo.NewDatabase(client, cfg)
+	mongoRepository := repository.NewRoadmapMongoRepository(database)
+	linkCheckWebapi := repository.NewRoadmapLinkCheckWebapi(cfg)
+	linkCheckUsecase := roadmap.NewLinkCheckUsecase(cfg, mongoRepository, linkCheckWebapi, mtrs)
+	linkCheckWorker := worker.NewLinkCheckWorker(cfg, linkCheckUsecase)
+	return linkCheckWorker
+}
+
+func InitializeGoalReminderWorker(cfg *config.Config) *worker.GoalReminderWorker {
+	client := mongo.NewClient(cfg)
+	database := mongo.NewDatabase(client, cfg)
+	mongoRepository := repository.NewRoadmapMongoRepository(database)
+	roadmapInfoServiceClient := roadmapinfoclient.NewRoadmapInfoClient(cfg)
+	notificationPublisher := ProvideNotificationPublisher(cfg)
+	goalReminderUsecase := roadmap.NewGoalReminderUsecase(cfg, mongoRepository, roadmapInfoServiceClient, notificationPublisher)
+	goalReminderWorker := worker.NewGoalReminderWorker(cfg, goalReminderUsecase)
+	return goalReminderWorker
+}
+
+func InitializeGamificationWorker(cfg *config.Config) *worker.GamificationWorker {
+	consumerConfig := ProvideGamificationConsumerConfig(cfg)
+	consumer := kafka.NewConsumer(consumerConfig)
+	client := mongo.NewClient(cfg)
+	database := mongo.NewDatabase(client, cfg)
+	mongoRepository := repository4.NewGamificationMongoRepository(database)
+	authServiceClient := authclient.NewAuthClient(cfg)
+	friendServiceClient := friendclient.NewFriendClient(cfg)
+	gamificationUsecase := usecase3.NewGamificationUsecase(cfg, mongoRepository, authServiceClient, friendServiceClient)
+	brokerHandlers := kafka2.NewGamificationBrokerHandlers(gamificationUsecase)
+	gamificationWorker := worker.NewGamificationWorker(consumer, brokerHandlers)
+	return gamificationWorker
+}
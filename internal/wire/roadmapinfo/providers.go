@@ -1,9 +1,13 @@
 package roadmapinfo
 
 import (
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker/kafka"
 	"github.com/F0urward/proftwist-backend/internal/metrics"
 	grpcServer "github.com/F0urward/proftwist-backend/internal/server/grpc"
 	httpServer "github.com/F0urward/proftwist-backend/internal/server/http"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+	roadmapInfoAdapters "github.com/F0urward/proftwist-backend/services/roadmapinfo/adapter"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -35,3 +39,12 @@ func AllGrpcRegistrars(
 		roadmapInfoGrpcRegistrar,
 	}
 }
+
+func ProvideNotificationPublisher(cfg *config.Config) roadmapinfo.NotificationPublisher {
+	producerConfig := kafka.ProducerConfig{
+		Broker: cfg.Kafka.Broker,
+		Topic:  cfg.Kafka.Producers.Notification.Topic,
+	}
+	producer := kafka.NewProducer(producerConfig)
+	return roadmapInfoAdapters.NewNotificationPublisher(producer)
+}
@@ -9,6 +9,8 @@ import (
 	roadmapInfoUsecase "github.com/F0urward/proftwist-backend/services/roadmapinfo/usecase"
 
 	authClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	chatClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
+	friendClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
 	moderationClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
 	roadmapClient "github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
 	db "github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
@@ -17,6 +19,8 @@ import (
 var RoadmapInfoSet = wire.NewSet(
 	roadmapInfoRepository.NewRoadmapInfoPostgresRepository,
 	roadmapInfoUsecase.NewRoadmapInfoUsecase,
+	roadmapInfoUsecase.NewSagaUsecase,
+	ProvideNotificationPublisher,
 	roadmapInfoHandlers.NewRoadmapInfoHandlers,
 	roadmapInfoHandlers.NewRoadmapInfoHttpRegistrar,
 	roadmapInfoGrpc.NewRoadmapInfoServer,
@@ -28,4 +32,22 @@ var ClientsSet = wire.NewSet(
 	roadmapClient.NewRoadmapClient,
 	authClient.NewAuthClient,
 	moderationClient.NewModerationClient,
+	chatClient.NewChatClient,
+	friendClient.NewFriendClient,
+)
+
+var ReconcilerSet = wire.NewSet(
+	roadmapInfoRepository.NewRoadmapInfoPostgresRepository,
+	roadmapInfoUsecase.NewReconcilerUsecase,
+	db.NewDatabase,
+	roadmapClient.NewRoadmapClient,
+	chatClient.NewChatClient,
+)
+
+var SagaSet = wire.NewSet(
+	roadmapInfoRepository.NewRoadmapInfoPostgresRepository,
+	roadmapInfoUsecase.NewSagaUsecase,
+	db.NewDatabase,
+	roadmapClient.NewRoadmapClient,
+	chatClient.NewChatClient,
 )
@@ -16,6 +16,7 @@ import (
 	corsmiddleware "github.com/F0urward/proftwist-backend/internal/server/middleware/cors"
 	loggingmiddleware "github.com/F0urward/proftwist-backend/internal/server/middleware/logging"
 	metricsmiddleware "github.com/F0urward/proftwist-backend/internal/server/middleware/metrics"
+	"github.com/F0urward/proftwist-backend/internal/worker"
 	"github.com/F0urward/proftwist-backend/pkg/logger"
 )
 
@@ -51,3 +52,19 @@ func InitializeRoadmapInfoGrpcServer(cfg *config.Config, log logger.Logger, mtrs
 	)
 	return &grpcServer.GrpcServer{}
 }
+
+func InitializeReconcilerWorker(cfg *config.Config, mtrs metrics.Metrics) *worker.ReconcilerWorker {
+	wire.Build(
+		ReconcilerSet,
+		worker.NewReconcilerWorker,
+	)
+	return &worker.ReconcilerWorker{}
+}
+
+func InitializeSagaRecoveryWorker(cfg *config.Config) *worker.SagaRecoveryWorker {
+	wire.Build(
+		SagaSet,
+		worker.NewSagaRecoveryWorker,
+	)
+	return &worker.SagaRecoveryWorker{}
+}
@@ -9,6 +9,8 @@ package roadmapinfo
 import (
 	"github.com/F0urward/proftwist-backend/config"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/db/postgres"
@@ -21,6 +23,7 @@ import (
 	"github.com/F0urward/proftwist-backend/internal/server/middleware/cors"
 	"github.com/F0urward/proftwist-backend/internal/server/middleware/logging"
 	metrics2 "github.com/F0urward/proftwist-backend/internal/server/middleware/metrics"
+	"github.com/F0urward/proftwist-backend/internal/worker"
 	"github.com/F0urward/proftwist-backend/pkg/logger"
 	grpc2 "github.com/F0urward/proftwist-backend/services/roadmapinfo/delivery/grpc"
 	http2 "github.com/F0urward/proftwist-backend/services/roadmapinfo/delivery/http"
@@ -45,7 +48,11 @@ func InitializeRoadmapInfoHttpServer(cfg *config.Config, log logger.Logger, mtrs
 	roadmapinfoRepository := repository.NewRoadmapInfoPostgresRepository(db)
 	roadmapServiceClient := roadmapclient.NewRoadmapClient(cfg)
 	moderationServiceClient := moderationclient.NewModerationClient(cfg)
-	roadmapinfoUsecase := usecase.NewRoadmapInfoUsecase(roadmapinfoRepository, roadmapServiceClient, authServiceClient, moderationServiceClient)
+	friendServiceClient := friendclient.NewFriendClient(cfg)
+	chatServiceClient := chatclient.NewChatClient(cfg)
+	sagaUsecase := usecase.NewSagaUsecase(cfg, roadmapinfoRepository, roadmapServiceClient, chatServiceClient)
+	notificationPublisher := ProvideNotificationPublisher(cfg)
+	roadmapinfoUsecase := usecase.NewRoadmapInfoUsecase(roadmapinfoRepository, roadmapServiceClient, authServiceClient, moderationServiceClient, friendServiceClient, sagaUsecase, notificationPublisher)
 	handlers := http2.NewRoadmapInfoHandlers(roadmapinfoUsecase)
 	httpRegistrar := http2.NewRoadmapInfoHttpRegistrar(handlers)
 	v := AllHttpRegistrars(httpRegistrar)
@@ -61,10 +68,34 @@ func InitializeRoadmapInfoGrpcServer(cfg *config.Config, log logger.Logger, mtrs
 	roadmapServiceClient := roadmapclient.NewRoadmapClient(cfg)
 	authServiceClient := authclient.NewAuthClient(cfg)
 	moderationServiceClient := moderationclient.NewModerationClient(cfg)
-	roadmapinfoUsecase := usecase.NewRoadmapInfoUsecase(roadmapinfoRepository, roadmapServiceClient, authServiceClient, moderationServiceClient)
+	friendServiceClient := friendclient.NewFriendClient(cfg)
+	chatServiceClient := chatclient.NewChatClient(cfg)
+	sagaUsecase := usecase.NewSagaUsecase(cfg, roadmapinfoRepository, roadmapServiceClient, chatServiceClient)
+	notificationPublisher := ProvideNotificationPublisher(cfg)
+	roadmapinfoUsecase := usecase.NewRoadmapInfoUsecase(roadmapinfoRepository, roadmapServiceClient, authServiceClient, moderationServiceClient, friendServiceClient, sagaUsecase, notificationPublisher)
 	roadmapInfoServiceServer := grpc2.NewRoadmapInfoServer(roadmapinfoUsecase)
 	grpcRegistrar := grpc2.NewRoadmapInfoGrpcRegistrar(roadmapInfoServiceServer)
 	v := AllGrpcRegistrars(grpcRegistrar)
 	grpcServer := grpc.New(cfg, loggingUnaryServerInterceptor, metricsUnaryServerInterceptor, v...)
 	return grpcServer
 }
+
+func InitializeReconcilerWorker(cfg *config.Config, mtrs metrics.Metrics) *worker.ReconcilerWorker {
+	db := postgres.NewDatabase(cfg)
+	roadmapinfoRepository := repository.NewRoadmapInfoPostgresRepository(db)
+	roadmapServiceClient := roadmapclient.NewRoadmapClient(cfg)
+	chatServiceClient := chatclient.NewChatClient(cfg)
+	reconcilerUsecase := usecase.NewReconcilerUsecase(cfg, roadmapinfoRepository, roadmapServiceClient, chatServiceClient, mtrs)
+	reconcilerWorker := worker.NewReconcilerWorker(cfg, reconcilerUsecase)
+	return reconcilerWorker
+}
+
+func InitializeSagaRecoveryWorker(cfg *config.Config) *worker.SagaRecoveryWorker {
+	db := postgres.NewDatabase(cfg)
+	roadmapinfoRepository := repository.NewRoadmapInfoPostgresRepository(db)
+	roadmapServiceClient := roadmapclient.NewRoadmapClient(cfg)
+	chatServiceClient := chatclient.NewChatClient(cfg)
+	sagaUsecase := usecase.NewSagaUsecase(cfg, roadmapinfoRepository, roadmapServiceClient, chatServiceClient)
+	sagaRecoveryWorker := worker.NewSagaRecoveryWorker(cfg, sagaUsecase)
+	return sagaRecoveryWorker
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+)
+
+type GamificationWorker struct {
+	consumer broker.Consumer
+	h        gamification.BrokerHandlers
+}
+
+func NewGamificationWorker(consumer broker.Consumer, h gamification.BrokerHandlers) *GamificationWorker {
+	return &GamificationWorker{consumer: consumer, h: h}
+}
+
+func (w *GamificationWorker) Start(ctx context.Context) {
+	go func() {
+		for {
+			msg, err := w.consumer.ReadMessage(ctx)
+			if err != nil {
+				continue
+			}
+			_ = w.h.HandleMessage(ctx, msg)
+		}
+	}()
+}
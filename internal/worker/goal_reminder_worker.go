@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type GoalReminderWorker struct {
+	uc       roadmap.GoalReminderUsecase
+	interval time.Duration
+}
+
+func NewGoalReminderWorker(cfg *config.Config, uc roadmap.GoalReminderUsecase) *GoalReminderWorker {
+	return &GoalReminderWorker{uc: uc, interval: cfg.Workers.GoalReminder.Interval}
+}
+
+func (w *GoalReminderWorker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			_ = w.uc.SendGoalReminders(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type LinkCheckWorker struct {
+	uc       roadmap.LinkCheckUsecase
+	interval time.Duration
+}
+
+func NewLinkCheckWorker(cfg *config.Config, uc roadmap.LinkCheckUsecase) *LinkCheckWorker {
+	return &LinkCheckWorker{uc: uc, interval: cfg.Workers.LinkCheck.Interval}
+}
+
+func (w *LinkCheckWorker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			_ = w.uc.CheckMaterialLinks(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
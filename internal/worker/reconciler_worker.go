@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+)
+
+type ReconcilerWorker struct {
+	uc       roadmapinfo.ReconcilerUsecase
+	interval time.Duration
+}
+
+func NewReconcilerWorker(cfg *config.Config, uc roadmapinfo.ReconcilerUsecase) *ReconcilerWorker {
+	return &ReconcilerWorker{uc: uc, interval: cfg.Workers.Reconciler.Interval}
+}
+
+func (w *ReconcilerWorker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			_ = w.uc.Reconcile(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+)
+
+type SagaRecoveryWorker struct {
+	uc       roadmapinfo.SagaUsecase
+	interval time.Duration
+}
+
+func NewSagaRecoveryWorker(cfg *config.Config, uc roadmapinfo.SagaUsecase) *SagaRecoveryWorker {
+	return &SagaRecoveryWorker{uc: uc, interval: cfg.Workers.Saga.Interval}
+}
+
+func (w *SagaRecoveryWorker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			_ = w.uc.ResumeStalled(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
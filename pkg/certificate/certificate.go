@@ -0,0 +1,195 @@
+package certificate
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	ContentType = "image/png"
+
+	width   = 1600
+	height  = 1130
+	margin  = 48
+	padding = 120
+)
+
+var (
+	backgroundColor = color.RGBA{R: 0xfb, G: 0xfa, B: 0xf6, A: 0xff}
+	accentColor     = color.RGBA{R: 0x2f, G: 0x4b, B: 0x8a, A: 0xff}
+	textColor       = color.RGBA{R: 0x22, G: 0x22, B: 0x22, A: 0xff}
+	mutedColor      = color.RGBA{R: 0x6b, G: 0x6b, B: 0x6b, A: 0xff}
+)
+
+// Content is what gets printed on a completion certificate.
+type Content struct {
+	ID             string
+	UserName       string
+	RoadmapName    string
+	RoadmapVersion string
+	IssuedAt       time.Time
+	Signature      string
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of payload.
+func Sign(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is a valid signature of payload, comparing
+// in constant time.
+func Verify(key []byte, payload, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// RenderPNG draws the certificate as a landscape PNG image.
+func RenderPNG(content Content) ([]byte, error) {
+	faces, err := newFaces()
+	if err != nil {
+		return nil, err
+	}
+	defer faces.close()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(backgroundColor), image.Point{}, draw.Src)
+	drawFrame(img, margin, 6, accentColor)
+	drawFrame(img, margin+18, 2, accentColor)
+
+	maxTextWidth := width - 2*padding
+
+	drawCentered(img, faces.title, accentColor, "СЕРТИФИКАТ", 280, maxTextWidth)
+	drawCentered(img, faces.regular, mutedColor, "о прохождении роадмапа", 350, maxTextWidth)
+	drawCentered(img, faces.regular, textColor, "Настоящим подтверждается, что", 460, maxTextWidth)
+	drawCentered(img, faces.name, textColor, content.UserName, 550, maxTextWidth)
+	drawCentered(img, faces.regular, textColor, "успешно завершил(а) роадмап", 640, maxTextWidth)
+	drawCentered(img, faces.name, accentColor, "«"+content.RoadmapName+"»", 730, maxTextWidth)
+
+	drawCentered(img, faces.small, mutedColor, fmt.Sprintf("Дата выдачи: %s", content.IssuedAt.UTC().Format("02.01.2006")), 880, maxTextWidth)
+	drawCentered(img, faces.small, mutedColor, fmt.Sprintf("Сертификат № %s · версия %s", content.ID, content.RoadmapVersion), 930, maxTextWidth)
+	drawCentered(img, faces.small, mutedColor, fmt.Sprintf("Подпись: %s", content.Signature), 980, maxTextWidth)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode certificate image: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type faces struct {
+	title   font.Face
+	name    font.Face
+	regular font.Face
+	small   font.Face
+}
+
+func newFaces() (*faces, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse regular font: %w", err)
+	}
+
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bold font: %w", err)
+	}
+
+	f := &faces{}
+	for _, spec := range []struct {
+		face *font.Face
+		font *opentype.Font
+		size float64
+	}{
+		{&f.title, bold, 72},
+		{&f.name, bold, 48},
+		{&f.regular, regular, 32},
+		{&f.small, regular, 20},
+	} {
+		face, err := opentype.NewFace(spec.font, &opentype.FaceOptions{Size: spec.size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			f.close()
+			return nil, fmt.Errorf("failed to create font face: %w", err)
+		}
+		*spec.face = face
+	}
+
+	return f, nil
+}
+
+func (f *faces) close() {
+	for _, face := range []font.Face{f.title, f.name, f.regular, f.small} {
+		if face != nil {
+			face.Close()
+		}
+	}
+}
+
+func drawFrame(img *image.RGBA, inset, thickness int, c color.Color) {
+	src := image.NewUniform(c)
+	outer := image.Rect(inset, inset, width-inset, height-inset)
+
+	for _, r := range []image.Rectangle{
+		image.Rect(outer.Min.X, outer.Min.Y, outer.Max.X, outer.Min.Y+thickness),
+		image.Rect(outer.Min.X, outer.Max.Y-thickness, outer.Max.X, outer.Max.Y),
+		image.Rect(outer.Min.X, outer.Min.Y, outer.Min.X+thickness, outer.Max.Y),
+		image.Rect(outer.Max.X-thickness, outer.Min.Y, outer.Max.X, outer.Max.Y),
+	} {
+		draw.Draw(img, r, src, image.Point{}, draw.Src)
+	}
+}
+
+// drawCentered draws a single line centered horizontally on the baseline y,
+// shortening it with an ellipsis when it does not fit into maxWidth.
+func drawCentered(img *image.RGBA, face font.Face, c color.Color, text string, y, maxWidth int) {
+	text = fitText(face, text, maxWidth)
+
+	drawer := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+	}
+
+	textWidth := drawer.MeasureString(text).Ceil()
+	drawer.Dot = fixed.P((width-textWidth)/2, y)
+	drawer.DrawString(text)
+}
+
+func fitText(face font.Face, text string, maxWidth int) string {
+	if font.MeasureString(face, text).Ceil() <= maxWidth {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := string(runes) + "…"
+		if font.MeasureString(face, candidate).Ceil() <= maxWidth {
+			return candidate
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,38 @@
+package document
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+var allowedDocumentContentTypes = map[string]string{
+	"application/pdf": "pdf",
+}
+
+// Office documents are zip archives, so they can only be told apart by the
+// extension once the content is known to be a zip.
+var allowedArchiveDocumentContentTypes = map[string]string{
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
+	".odt":  "application/vnd.oasis.opendocument.text",
+}
+
+func CheckDocumentFileContentType(fileContent []byte, fileName string) (string, error) {
+	contentType := http.DetectContentType(fileContent)
+
+	if _, ok := allowedDocumentContentTypes[contentType]; ok {
+		return contentType, nil
+	}
+
+	if contentType == "application/zip" {
+		extension := strings.ToLower(filepath.Ext(fileName))
+		if archiveContentType, ok := allowedArchiveDocumentContentTypes[extension]; ok {
+			return archiveContentType, nil
+		}
+	}
+
+	return "", errors.New("this content type is not allowed")
+}
@@ -0,0 +1,142 @@
+package ical
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	dateTimeFormat = "20060102T150405Z"
+	dateFormat     = "20060102"
+
+	// RFC 5545 limits content lines to 75 octets, excluding the line break.
+	maxLineLength = 75
+)
+
+// Calendar is a VCALENDAR with its events.
+type Calendar struct {
+	ProdID string
+	Name   string
+	// RefreshInterval hints clients how often to poll the feed.
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Event is a VEVENT. Timed events are written in UTC. All-day events use the
+// date of Start and last until the day after the date of End.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Stamp       time.Time
+}
+
+// Render writes the calendar as an RFC 5545 document.
+func Render(calendar Calendar) []byte {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+escapeText(calendar.ProdID))
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	if calendar.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+escapeText(calendar.Name))
+	}
+	if calendar.RefreshInterval > 0 {
+		duration := formatDuration(calendar.RefreshInterval)
+		writeLine(&buf, "REFRESH-INTERVAL;VALUE=DURATION:"+duration)
+		writeLine(&buf, "X-PUBLISHED-TTL:"+duration)
+	}
+
+	for _, event := range calendar.Events {
+		writeLine(&buf, "BEGIN:VEVENT")
+		writeLine(&buf, "UID:"+escapeText(event.UID))
+		writeLine(&buf, "DTSTAMP:"+event.Stamp.UTC().Format(dateTimeFormat))
+		if event.AllDay {
+			end := event.End
+			if end.Before(event.Start) {
+				end = event.Start
+			}
+			writeLine(&buf, "DTSTART;VALUE=DATE:"+event.Start.Format(dateFormat))
+			writeLine(&buf, "DTEND;VALUE=DATE:"+end.AddDate(0, 0, 1).Format(dateFormat))
+			writeLine(&buf, "TRANSP:TRANSPARENT")
+		} else {
+			writeLine(&buf, "DTSTART:"+event.Start.UTC().Format(dateTimeFormat))
+			writeLine(&buf, "DTEND:"+event.End.UTC().Format(dateTimeFormat))
+		}
+		writeLine(&buf, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&buf, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		writeLine(&buf, "END:VEVENT")
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// escapeText escapes a TEXT value as described in RFC 5545, section 3.3.11.
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// writeLine folds the line into chunks of at most 75 octets without splitting
+// UTF-8 sequences. Continuation lines start with a single space.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineLength - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		d = time.Minute
+	}
+
+	var b strings.Builder
+	b.WriteString("P")
+	if days := d / (24 * time.Hour); days > 0 {
+		b.WriteString(strconv.Itoa(int(days)) + "D")
+		d -= days * 24 * time.Hour
+	}
+	if d > 0 {
+		b.WriteString("T")
+		if hours := d / time.Hour; hours > 0 {
+			b.WriteString(strconv.Itoa(int(hours)) + "H")
+			d -= hours * time.Hour
+		}
+		if minutes := d / time.Minute; minutes > 0 {
+			b.WriteString(strconv.Itoa(int(minutes)) + "M")
+		}
+	}
+	return b.String()
+}
@@ -4,17 +4,17 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
-	gigachatClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient/dto"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	llmClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
 	"github.com/F0urward/proftwist-backend/services/bot"
 )
 
 type GigachatWebapi struct {
-	client *gigachatclient.Client
+	client llmclient.Provider
 }
 
-func NewGigachatWebapi(client *gigachatclient.Client) bot.GigachatWebapi {
+func NewGigachatWebapi(client llmclient.Provider) bot.GigachatWebapi {
 	return &GigachatWebapi{client: client}
 }
 
@@ -35,9 +35,8 @@ func (r *GigachatWebapi) GetBotResponse(ctx context.Context, query, chatTitle st
 Текущий чат: "` + chatTitle + `"
 ВАЖНО: Отвечай текстом без форматирования. Никаких переносов строки типа \n, Markdown, эмодзи, или специальных символов. Текст в ОДНУ СТРОКУ`
 
-	chatReq := &gigachatClientDTO.ChatRequest{
-		Model: "GigaChat",
-		Messages: []gigachatClientDTO.Message{
+	chatReq := &llmClientDTO.ChatRequest{
+		Messages: []llmClientDTO.Message{
 			{
 				Role:    "system",
 				Content: systemPrompt,
@@ -50,6 +49,7 @@ func (r *GigachatWebapi) GetBotResponse(ctx context.Context, query, chatTitle st
 		Temperature:       float64Ptr(0.7),
 		MaxTokens:         int64Ptr(1024),
 		RepetitionPenalty: float64Ptr(1.1),
+		Feature:           llmclient.FeatureBot,
 	}
 
 	chatResp, err := r.client.Chat(ctx, chatReq)
@@ -0,0 +1,60 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	"github.com/F0urward/proftwist-backend/services/bot/dto"
+	"github.com/F0urward/proftwist-backend/services/bot/repository"
+)
+
+type chatClientStub struct {
+	chatclient.ChatServiceClient
+
+	sent []*chatclient.SendGroupChatMessageRequest
+}
+
+func (c *chatClientStub) SendGroupChatMessage(_ context.Context, in *chatclient.SendGroupChatMessageRequest, _ ...grpc.CallOption) (*chatclient.SendGroupChatMessageResponse, error) {
+	c.sent = append(c.sent, in)
+	return &chatclient.SendGroupChatMessageResponse{}, nil
+}
+
+func TestHandleBotTriggerWithFakeProvider(t *testing.T) {
+	script := llmclient.DefaultFakeScript()
+	provider := llmclient.NewFakeProvider(script)
+	chat := &chatClientStub{}
+
+	cfg := &config.Config{}
+	cfg.Bot = config.BotConfig{BotUserID: "bot", BotTriggerPhrase: "@bot"}
+	uc := NewBotUsecase(repository.NewGigachatWebapi(provider), chat, cfg)
+
+	// The chat title mentions a roadmap, which must not pull in roadmap fixtures.
+	event := dto.MessageForBotEvent{
+		ChatID:    "chat-1",
+		ChatTitle: "Go roadmap: Основы",
+		Content:   "@bot с чего начать?",
+	}
+	if err := uc.HandleBotTrigger(context.Background(), event); err != nil {
+		t.Fatalf("HandleBotTrigger returned error: %v", err)
+	}
+
+	if len(chat.sent) != 1 {
+		t.Fatalf("got %d messages sent, want 1", len(chat.sent))
+	}
+	if got := chat.sent[0]; got.ChatId != "chat-1" || got.UserId != "bot" || got.Content != script.Default {
+		t.Fatalf("got message %+v, want the default fake answer from the bot", got)
+	}
+
+	calls := provider.Calls()
+	if len(calls) != 1 || calls[0].Feature != llmclient.FeatureBot {
+		t.Fatalf("got provider calls %+v, want one bot request", calls)
+	}
+	if query := calls[0].Messages[len(calls[0].Messages)-1].Content; query != "с чего начать?" {
+		t.Fatalf("got query %q, want the message without the trigger phrase", query)
+	}
+}
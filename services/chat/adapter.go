@@ -12,3 +12,7 @@ type NotificationPublisher interface {
 type BotPublisher interface {
 	PublishMessageForBot(ctx context.Context, chatID, chatTitle, content string) error
 }
+
+type GamificationPublisher interface {
+	GroupMessageSent(ctx context.Context, userID, chatID, messageID string) error
+}
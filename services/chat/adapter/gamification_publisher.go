@@ -0,0 +1,35 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	"github.com/F0urward/proftwist-backend/services/chat"
+	gamificationDTO "github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+type GamificationPublisher struct {
+	producer broker.Producer
+}
+
+func NewGamificationPublisher(producer broker.Producer) chat.GamificationPublisher {
+	return &GamificationPublisher{producer: producer}
+}
+
+func (k *GamificationPublisher) GroupMessageSent(ctx context.Context, userID, chatID, messageID string) error {
+	event := gamificationDTO.ActivityEvent{
+		Type:       gamificationDTO.GroupMessageSentType,
+		EventID:    messageID,
+		UserID:     userID,
+		SubjectID:  chatID,
+		OccurredAt: time.Now(),
+	}
+
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return k.producer.Publish(ctx, userID, data)
+}
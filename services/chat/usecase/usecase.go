@@ -27,16 +27,18 @@ type ChatUsecase struct {
 	repo                  chat.Repository
 	notificationPublisher chat.NotificationPublisher
 	botPublisher          chat.BotPublisher
+	gamificationPublisher chat.GamificationPublisher
 	authClient            authclient.AuthServiceClient
 	friendClient          friendclient.FriendServiceClient
 	botConfig             BotConfig
 }
 
-func NewChatUsecase(repo chat.Repository, notificationPublisher chat.NotificationPublisher, botPublisher chat.BotPublisher, authClient authclient.AuthServiceClient, friendClient friendclient.FriendServiceClient, cfg *config.Config) chat.Usecase {
+func NewChatUsecase(repo chat.Repository, notificationPublisher chat.NotificationPublisher, botPublisher chat.BotPublisher, gamificationPublisher chat.GamificationPublisher, authClient authclient.AuthServiceClient, friendClient friendclient.FriendServiceClient, cfg *config.Config) chat.Usecase {
 	return &ChatUsecase{
 		repo:                  repo,
 		notificationPublisher: notificationPublisher,
 		botPublisher:          botPublisher,
+		gamificationPublisher: gamificationPublisher,
 		authClient:            authClient,
 		friendClient:          friendClient,
 		botConfig: BotConfig{
@@ -251,6 +253,12 @@ func (uc *ChatUsecase) SendGroupChatMessage(ctx context.Context, req *dto.SendMe
 		}
 	}
 
+	if !isBotUser {
+		if err := uc.gamificationPublisher.GroupMessageSent(ctx, req.UserID.String(), req.ChatID.String(), message.ID.String()); err != nil {
+			logger.WithError(err).Warn("failed to publish group message to gamification")
+		}
+	}
+
 	isBotTrigger := uc.isBotTrigger(req.Content)
 
 	if isBotTrigger {
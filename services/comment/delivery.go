@@ -0,0 +1,12 @@
+package comment
+
+import "net/http"
+
+type Handlers interface {
+	GetComments(w http.ResponseWriter, r *http.Request)
+	GetReplies(w http.ResponseWriter, r *http.Request)
+	CreateComment(w http.ResponseWriter, r *http.Request)
+	UpdateComment(w http.ResponseWriter, r *http.Request)
+	DeleteComment(w http.ResponseWriter, r *http.Request)
+	VoteComment(w http.ResponseWriter, r *http.Request)
+}
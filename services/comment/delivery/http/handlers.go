@@ -0,0 +1,332 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/utils"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/comment"
+	"github.com/F0urward/proftwist-backend/services/comment/dto"
+)
+
+const (
+	defaultCommentsLimit = 20
+	maxCommentsLimit     = 100
+)
+
+type CommentHandlers struct {
+	uc comment.Usecase
+}
+
+func NewCommentHandlers(uc comment.Usecase) comment.Handlers {
+	return &CommentHandlers{
+		uc: uc,
+	}
+}
+
+func (h *CommentHandlers) GetComments(w http.ResponseWriter, r *http.Request) {
+	const op = "CommentHandlers.GetComments"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapID, nodeID, err := parseNodePath(r)
+	if err != nil {
+		logger.WithError(err).Warn("invalid path parameters")
+		utils.JSONError(ctx, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sort := entities.CommentSort(r.URL.Query().Get("sort"))
+	if sort == "" {
+		sort = entities.CommentSortTop
+	}
+
+	limit, offset := parsePagination(r)
+
+	res, err := h.uc.GetComments(ctx, optionalUserID(r), roadmapID, nodeID, sort, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get comments")
+		h.writeError(w, r, err, "failed to get comments")
+		return
+	}
+
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *CommentHandlers) GetReplies(w http.ResponseWriter, r *http.Request) {
+	const op = "CommentHandlers.GetReplies"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapID, nodeID, err := parseNodePath(r)
+	if err != nil {
+		logger.WithError(err).Warn("invalid path parameters")
+		utils.JSONError(ctx, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commentID, err := uuid.Parse(mux.Vars(r)["comment_id"])
+	if err != nil {
+		logger.WithError(err).Warn("invalid comment ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid comment ID")
+		return
+	}
+
+	limit, offset := parsePagination(r)
+
+	res, err := h.uc.GetReplies(ctx, optionalUserID(r), roadmapID, nodeID, commentID, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get replies")
+		h.writeError(w, r, err, "failed to get replies")
+		return
+	}
+
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *CommentHandlers) CreateComment(w http.ResponseWriter, r *http.Request) {
+	const op = "CommentHandlers.CreateComment"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapID, nodeID, err := parseNodePath(r)
+	if err != nil {
+		logger.WithError(err).Warn("invalid path parameters")
+		utils.JSONError(ctx, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateCommentRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.CreateComment(ctx, userID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to create comment")
+		h.writeError(w, r, err, "failed to create comment")
+		return
+	}
+
+	logger.WithField("comment_id", res.ID).Info("successfully created comment")
+	utils.JSONResponse(ctx, w, http.StatusCreated, res)
+}
+
+func (h *CommentHandlers) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	const op = "CommentHandlers.UpdateComment"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapID, nodeID, err := parseNodePath(r)
+	if err != nil {
+		logger.WithError(err).Warn("invalid path parameters")
+		utils.JSONError(ctx, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commentID, err := uuid.Parse(mux.Vars(r)["comment_id"])
+	if err != nil {
+		logger.WithError(err).Warn("invalid comment ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid comment ID")
+		return
+	}
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCommentRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.UpdateComment(ctx, userID, roadmapID, nodeID, commentID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to update comment")
+		h.writeError(w, r, err, "failed to update comment")
+		return
+	}
+
+	logger.WithField("comment_id", commentID).Info("successfully updated comment")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *CommentHandlers) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	const op = "CommentHandlers.DeleteComment"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapID, nodeID, err := parseNodePath(r)
+	if err != nil {
+		logger.WithError(err).Warn("invalid path parameters")
+		utils.JSONError(ctx, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commentID, err := uuid.Parse(mux.Vars(r)["comment_id"])
+	if err != nil {
+		logger.WithError(err).Warn("invalid comment ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid comment ID")
+		return
+	}
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.DeleteComment(ctx, userID, roadmapID, nodeID, commentID); err != nil {
+		logger.WithError(err).Error("failed to delete comment")
+		h.writeError(w, r, err, "failed to delete comment")
+		return
+	}
+
+	logger.WithField("comment_id", commentID).Info("successfully deleted comment")
+	utils.JSONResponse(ctx, w, http.StatusOK, dto.DeleteCommentResponseDTO{
+		Message: "comment successfully deleted",
+	})
+}
+
+func (h *CommentHandlers) VoteComment(w http.ResponseWriter, r *http.Request) {
+	const op = "CommentHandlers.VoteComment"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapID, nodeID, err := parseNodePath(r)
+	if err != nil {
+		logger.WithError(err).Warn("invalid path parameters")
+		utils.JSONError(ctx, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commentID, err := uuid.Parse(mux.Vars(r)["comment_id"])
+	if err != nil {
+		logger.WithError(err).Warn("invalid comment ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid comment ID")
+		return
+	}
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.VoteCommentRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.VoteComment(ctx, userID, roadmapID, nodeID, commentID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to vote for comment")
+		h.writeError(w, r, err, "failed to vote for comment")
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"comment_id": commentID,
+		"vote":       req.Vote,
+	}).Info("successfully voted for comment")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *CommentHandlers) writeError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	statusCode := http.StatusInternalServerError
+	errorMsg := fallback
+
+	if errs.IsBusinessLogicError(err) {
+		statusCode = http.StatusBadRequest
+		errorMsg = err.Error()
+	} else if errs.IsForbiddenError(err) {
+		statusCode = http.StatusForbidden
+		errorMsg = "access denied - you can only change your own comments"
+	} else if errs.IsNotFoundError(err) {
+		statusCode = http.StatusNotFound
+		errorMsg = "comment, roadmap or node not found"
+	}
+
+	utils.JSONError(r.Context(), w, statusCode, errorMsg)
+}
+
+func parseNodePath(r *http.Request) (primitive.ObjectID, uuid.UUID, error) {
+	vars := mux.Vars(r)
+
+	roadmapID, err := primitive.ObjectIDFromHex(vars["roadmap_id"])
+	if err != nil {
+		return primitive.NilObjectID, uuid.Nil, fmt.Errorf("invalid roadmap_id format")
+	}
+
+	nodeID, err := uuid.Parse(vars["node_id"])
+	if err != nil {
+		return primitive.NilObjectID, uuid.Nil, fmt.Errorf("invalid node ID format")
+	}
+
+	return roadmapID, nodeID, nil
+}
+
+func parsePagination(r *http.Request) (int, int) {
+	limit := defaultCommentsLimit
+	offset := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= maxCommentsLimit {
+			limit = l
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	return limit, offset
+}
+
+func optionalUserID(r *http.Request) uuid.UUID {
+	if userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string); ok && userIDStr != "" {
+		if parsedID, err := uuid.Parse(userIDStr); err == nil {
+			return parsedID
+		}
+	}
+	return uuid.Nil
+}
+
+func requiredUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ctx := r.Context()
+
+	userIDStr, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"net/http"
+
+	httpServer "github.com/F0urward/proftwist-backend/internal/server/http"
+	"github.com/F0urward/proftwist-backend/services/comment"
+)
+
+type CommentHttpRegistrar struct {
+	handlers comment.Handlers
+}
+
+func NewCommentHttpRegistrar(handlers comment.Handlers) httpServer.HttpRegistrar {
+	return &CommentHttpRegistrar{
+		handlers: handlers,
+	}
+}
+
+func (r *CommentHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/comments", s.AuthMiddleware.OptionalAuthMiddleware(http.HandlerFunc(r.handlers.GetComments))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/comments", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.CreateComment))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/comments/{comment_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpdateComment))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/comments/{comment_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteComment))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/comments/{comment_id}/replies", s.AuthMiddleware.OptionalAuthMiddleware(http.HandlerFunc(r.handlers.GetReplies))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/comments/{comment_id}/vote", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.VoteComment))).Methods("PUT")
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type CommentAuthorDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	AvatarURL string    `json:"avatar_url,omitempty"`
+}
+
+type CommentResponseDTO struct {
+	ID         uuid.UUID                 `json:"id"`
+	RoadmapID  string                    `json:"roadmap_id"`
+	NodeID     uuid.UUID                 `json:"node_id"`
+	ParentID   *uuid.UUID                `json:"parent_id,omitempty"`
+	Author     CommentAuthorDTO          `json:"author"`
+	Content    string                    `json:"content"`
+	Upvotes    int                       `json:"upvotes"`
+	Downvotes  int                       `json:"downvotes"`
+	Score      int                       `json:"score"`
+	ReplyCount int                       `json:"reply_count"`
+	MyVote     entities.CommentVoteValue `json:"my_vote,omitempty"`
+	IsEdited   bool                      `json:"is_edited"`
+	IsDeleted  bool                      `json:"is_deleted"`
+	CreatedAt  time.Time                 `json:"created_at"`
+	UpdatedAt  time.Time                 `json:"updated_at"`
+}
+
+type CommentListResponseDTO struct {
+	Comments []CommentResponseDTO `json:"comments"`
+	Total    int                  `json:"total"`
+	Limit    int                  `json:"limit"`
+	Offset   int                  `json:"offset"`
+}
+
+type CreateCommentRequestDTO struct {
+	Content  string     `json:"content"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+}
+
+type UpdateCommentRequestDTO struct {
+	Content string `json:"content"`
+}
+
+type VoteCommentRequestDTO struct {
+	Vote entities.CommentVoteValue `json:"vote"`
+}
+
+type DeleteCommentResponseDTO struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+func CommentToDTO(c *entities.NodeComment, author CommentAuthorDTO, viewerID uuid.UUID) CommentResponseDTO {
+	res := CommentResponseDTO{
+		ID:         c.ID,
+		RoadmapID:  c.RoadmapID.Hex(),
+		NodeID:     c.NodeID,
+		ParentID:   c.ParentID,
+		Author:     author,
+		Content:    c.Content,
+		Upvotes:    len(c.Upvoters),
+		Downvotes:  len(c.Downvoters),
+		Score:      c.Score,
+		ReplyCount: c.ReplyCount,
+		IsEdited:   c.IsEdited,
+		IsDeleted:  c.IsDeleted,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+
+	if viewerID != uuid.Nil {
+		res.MyVote = c.VoteOf(viewerID)
+	}
+
+	// Deleted placeholders keep their place in the thread but not their author.
+	if c.IsDeleted {
+		res.Author = CommentAuthorDTO{}
+	}
+
+	return res
+}
+
+func CommentListToDTO(comments []*entities.NodeComment, authors map[uuid.UUID]CommentAuthorDTO, viewerID uuid.UUID, total, limit, offset int) CommentListResponseDTO {
+	commentDTOs := make([]CommentResponseDTO, 0, len(comments))
+
+	for _, c := range comments {
+		if c == nil {
+			continue
+		}
+
+		author, ok := authors[c.AuthorID]
+		if !ok {
+			author = CommentAuthorDTO{ID: c.AuthorID, Username: "Unknown User"}
+		}
+
+		commentDTOs = append(commentDTOs, CommentToDTO(c, author, viewerID))
+	}
+
+	return CommentListResponseDTO{
+		Comments: commentDTOs,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}
+}
+
+func CreateCommentRequestToEntity(req *CreateCommentRequestDTO, authorID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) *entities.NodeComment {
+	now := time.Now()
+	return &entities.NodeComment{
+		ID:         uuid.New(),
+		RoadmapID:  roadmapID,
+		NodeID:     nodeID,
+		ParentID:   req.ParentID,
+		AuthorID:   authorID,
+		Content:    req.Content,
+		Upvoters:   []uuid.UUID{},
+		Downvoters: []uuid.UUID{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
@@ -0,0 +1,24 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type MongoRepository interface {
+	Create(ctx context.Context, comment *entities.NodeComment) error
+	GetByID(ctx context.Context, commentID uuid.UUID) (*entities.NodeComment, error)
+	GetThreads(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, sort entities.CommentSort, limit, offset int) ([]*entities.NodeComment, error)
+	CountThreads(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (int, error)
+	GetReplies(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]*entities.NodeComment, error)
+	UpdateContent(ctx context.Context, commentID uuid.UUID, content string, updatedAt time.Time) error
+	SoftDelete(ctx context.Context, commentID uuid.UUID) error
+	Delete(ctx context.Context, commentID uuid.UUID) error
+	IncReplyCount(ctx context.Context, commentID uuid.UUID, delta int) error
+	SetVote(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, vote entities.CommentVoteValue) (*entities.NodeComment, error)
+}
@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/comment"
+)
+
+const commentsCollectionName = "node_comments"
+
+type CommentMongoRepository struct {
+	commentsCollection *mongo.Collection
+}
+
+func NewCommentMongoRepository(db *mongo.Database) comment.MongoRepository {
+	return &CommentMongoRepository{
+		commentsCollection: db.Collection(commentsCollectionName),
+	}
+}
+
+func (r *CommentMongoRepository) Create(ctx context.Context, c *entities.NodeComment) error {
+	const op = "CommentRepository.Create"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": c.ID,
+		"roadmap_id": c.RoadmapID.Hex(),
+		"node_id":    c.NodeID,
+	})
+
+	if _, err := r.commentsCollection.InsertOne(ctx, c); err != nil {
+		logger.WithError(err).Error("failed to insert comment")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Debug("comment created")
+	return nil
+}
+
+func (r *CommentMongoRepository) GetByID(ctx context.Context, commentID uuid.UUID) (*entities.NodeComment, error) {
+	const op = "CommentRepository.GetByID"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+	})
+
+	var c entities.NodeComment
+	err := r.commentsCollection.FindOne(ctx, bson.M{"id": commentID}).Decode(&c)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get comment by ID")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &c, nil
+}
+
+func (r *CommentMongoRepository) GetThreads(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, sort entities.CommentSort, limit, offset int) ([]*entities.NodeComment, error) {
+	const op = "CommentRepository.GetThreads"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+		"sort":       sort,
+	})
+
+	order := bson.D{{Key: "created_at", Value: -1}}
+	if sort == entities.CommentSortTop {
+		order = bson.D{{Key: "score", Value: -1}, {Key: "created_at", Value: -1}}
+	}
+
+	opts := options.Find().
+		SetSort(order).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	comments, err := r.find(ctx, threadsFilter(roadmapID, nodeID), opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to get comment threads")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return comments, nil
+}
+
+func (r *CommentMongoRepository) CountThreads(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (int, error) {
+	const op = "CommentRepository.CountThreads"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	count, err := r.commentsCollection.CountDocuments(ctx, threadsFilter(roadmapID, nodeID))
+	if err != nil {
+		logger.WithError(err).Error("failed to count comment threads")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(count), nil
+}
+
+func (r *CommentMongoRepository) GetReplies(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]*entities.NodeComment, error) {
+	const op = "CommentRepository.GetReplies"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":        op,
+		"parent_id": parentID,
+	})
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	comments, err := r.find(ctx, bson.M{"parent_id": parentID}, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to get comment replies")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return comments, nil
+}
+
+func (r *CommentMongoRepository) UpdateContent(ctx context.Context, commentID uuid.UUID, content string, updatedAt time.Time) error {
+	const op = "CommentRepository.UpdateContent"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+	})
+
+	update := bson.M{
+		"$set": bson.M{
+			"content":    content,
+			"is_edited":  true,
+			"updated_at": updatedAt,
+		},
+	}
+
+	result, err := r.commentsCollection.UpdateOne(ctx, bson.M{"id": commentID, "is_deleted": false}, update)
+	if err != nil {
+		logger.WithError(err).Error("failed to update comment")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s: comment not found", op)
+	}
+
+	return nil
+}
+
+// SoftDelete keeps the comment as a placeholder so its replies stay reachable.
+func (r *CommentMongoRepository) SoftDelete(ctx context.Context, commentID uuid.UUID) error {
+	const op = "CommentRepository.SoftDelete"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+	})
+
+	update := bson.M{
+		"$set": bson.M{
+			"content":    "",
+			"is_deleted": true,
+			"updated_at": time.Now(),
+		},
+	}
+
+	if _, err := r.commentsCollection.UpdateOne(ctx, bson.M{"id": commentID}, update); err != nil {
+		logger.WithError(err).Error("failed to soft delete comment")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CommentMongoRepository) Delete(ctx context.Context, commentID uuid.UUID) error {
+	const op = "CommentRepository.Delete"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+	})
+
+	if _, err := r.commentsCollection.DeleteOne(ctx, bson.M{"id": commentID}); err != nil {
+		logger.WithError(err).Error("failed to delete comment")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CommentMongoRepository) IncReplyCount(ctx context.Context, commentID uuid.UUID, delta int) error {
+	const op = "CommentRepository.IncReplyCount"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+		"delta":      delta,
+	})
+
+	update := bson.M{"$inc": bson.M{"reply_count": delta}}
+	if _, err := r.commentsCollection.UpdateOne(ctx, bson.M{"id": commentID}, update); err != nil {
+		logger.WithError(err).Error("failed to update reply count")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetVote moves the user between the voter sets and recomputes the score in a
+// single pipeline update, so concurrent votes cannot double count.
+func (r *CommentMongoRepository) SetVote(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, vote entities.CommentVoteValue) (*entities.NodeComment, error) {
+	const op = "CommentRepository.SetVote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+		"user_id":    userID,
+		"vote":       vote,
+	})
+
+	without := func(field string) bson.M {
+		return bson.M{"$setDifference": bson.A{
+			bson.M{"$ifNull": bson.A{"$" + field, bson.A{}}},
+			bson.A{userID},
+		}}
+	}
+	with := func(field string) bson.M {
+		return bson.M{"$setUnion": bson.A{without(field), bson.A{userID}}}
+	}
+
+	upvoters, downvoters := without("upvoters"), without("downvoters")
+	switch vote {
+	case entities.CommentVoteUp:
+		upvoters = with("upvoters")
+	case entities.CommentVoteDown:
+		downvoters = with("downvoters")
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"upvoters": upvoters, "downvoters": downvoters}}},
+		{{Key: "$set", Value: bson.M{"score": bson.M{"$subtract": bson.A{
+			bson.M{"$size": "$upvoters"},
+			bson.M{"$size": "$downvoters"},
+		}}}}},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated entities.NodeComment
+	err := r.commentsCollection.FindOneAndUpdate(ctx, bson.M{"id": commentID, "is_deleted": false}, update, opts).Decode(&updated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to set comment vote")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &updated, nil
+}
+
+func (r *CommentMongoRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entities.NodeComment, error) {
+	cursor, err := r.commentsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			ctxutil.GetLogger(ctx).WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var comments []*entities.NodeComment
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// threadsFilter selects top level comments, hiding deleted ones that have no replies left.
+func threadsFilter(roadmapID primitive.ObjectID, nodeID uuid.UUID) bson.M {
+	return bson.M{
+		"roadmap_id": roadmapID,
+		"node_id":    nodeID,
+		"parent_id":  nil,
+		"$or": bson.A{
+			bson.M{"is_deleted": false},
+			bson.M{"reply_count": bson.M{"$gt": 0}},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/services/comment/dto"
+)
+
+type Usecase interface {
+	GetComments(ctx context.Context, viewerID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, sort entities.CommentSort, limit, offset int) (*dto.CommentListResponseDTO, error)
+	GetReplies(ctx context.Context, viewerID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID, limit, offset int) (*dto.CommentListResponseDTO, error)
+	CreateComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.CreateCommentRequestDTO) (*dto.CommentResponseDTO, error)
+	UpdateComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID, req *dto.UpdateCommentRequestDTO) (*dto.CommentResponseDTO, error)
+	DeleteComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID) error
+	VoteComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID, req *dto.VoteCommentRequestDTO) (*dto.CommentResponseDTO, error)
+}
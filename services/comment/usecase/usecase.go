@@ -0,0 +1,435 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/comment"
+	"github.com/F0urward/proftwist-backend/services/comment/dto"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+const maxCommentLength = 5000
+
+var errModerationRejected = errors.New("content violates moderation rules")
+
+type CommentUsecase struct {
+	repo             comment.MongoRepository
+	roadmapRepo      roadmap.MongoRepository
+	authClient       authclient.AuthServiceClient
+	moderationClient moderationclient.ModerationServiceClient
+}
+
+func NewCommentUsecase(
+	repo comment.MongoRepository,
+	roadmapRepo roadmap.MongoRepository,
+	authClient authclient.AuthServiceClient,
+	moderationClient moderationclient.ModerationServiceClient,
+) comment.Usecase {
+	return &CommentUsecase{
+		repo:             repo,
+		roadmapRepo:      roadmapRepo,
+		authClient:       authClient,
+		moderationClient: moderationClient,
+	}
+}
+
+func (uc *CommentUsecase) GetComments(ctx context.Context, viewerID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, sort entities.CommentSort, limit, offset int) (*dto.CommentListResponseDTO, error) {
+	const op = "CommentUsecase.GetComments"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+		"sort":       sort,
+	})
+
+	if !sort.IsValid() {
+		logger.Warn("invalid comment sort provided")
+		return nil, fmt.Errorf("invalid comment sort: %s", sort)
+	}
+
+	if err := uc.ensureNodeExists(ctx, roadmapID, nodeID); err != nil {
+		return nil, err
+	}
+
+	comments, err := uc.repo.GetThreads(ctx, roadmapID, nodeID, sort, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get comment threads")
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	total, err := uc.repo.CountThreads(ctx, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Error("failed to count comment threads")
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	response := dto.CommentListToDTO(comments, uc.fetchAuthors(ctx, comments), viewerID, total, limit, offset)
+
+	logger.WithField("count", len(response.Comments)).Info("successfully retrieved comments")
+	return &response, nil
+}
+
+func (uc *CommentUsecase) GetReplies(ctx context.Context, viewerID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID, limit, offset int) (*dto.CommentListResponseDTO, error) {
+	const op = "CommentUsecase.GetReplies"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"comment_id": commentID,
+	})
+
+	parent, err := uc.getNodeComment(ctx, roadmapID, nodeID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := uc.repo.GetReplies(ctx, parent.ID, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get replies")
+		return nil, fmt.Errorf("failed to get replies: %w", err)
+	}
+
+	response := dto.CommentListToDTO(replies, uc.fetchAuthors(ctx, replies), viewerID, parent.ReplyCount, limit, offset)
+
+	logger.WithField("count", len(response.Comments)).Info("successfully retrieved replies")
+	return &response, nil
+}
+
+func (uc *CommentUsecase) CreateComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.CreateCommentRequestDTO) (*dto.CommentResponseDTO, error) {
+	const op = "CommentUsecase.CreateComment"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	})
+
+	req.Content = strings.TrimSpace(req.Content)
+	if err := validateCommentContent(req.Content); err != nil {
+		logger.WithError(err).Warn("invalid comment content")
+		return nil, err
+	}
+
+	if err := uc.ensureNodeExists(ctx, roadmapID, nodeID); err != nil {
+		return nil, err
+	}
+
+	if req.ParentID != nil {
+		parent, err := uc.getNodeComment(ctx, roadmapID, nodeID, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if parent.IsDeleted {
+			logger.Warn("attempt to reply to deleted comment")
+			return nil, fmt.Errorf("invalid parent comment: comment was deleted")
+		}
+
+		// Replies to replies join the same thread.
+		if parent.ParentID != nil {
+			req.ParentID = parent.ParentID
+		}
+	}
+
+	if err := uc.moderateContent(ctx, req.Content); err != nil {
+		logger.WithError(err).Warn("comment rejected by moderation")
+		return nil, fmt.Errorf("moderation check failed: %w", err)
+	}
+
+	commentEntity := dto.CreateCommentRequestToEntity(req, userID, roadmapID, nodeID)
+	if err := uc.repo.Create(ctx, commentEntity); err != nil {
+		logger.WithError(err).Error("failed to create comment")
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	if commentEntity.ParentID != nil {
+		if err := uc.repo.IncReplyCount(ctx, *commentEntity.ParentID, 1); err != nil {
+			logger.WithError(err).Warn("failed to increment reply count")
+		}
+	}
+
+	response := dto.CommentToDTO(commentEntity, uc.fetchAuthor(ctx, userID), userID)
+
+	logger.WithField("comment_id", commentEntity.ID).Info("successfully created comment")
+	return &response, nil
+}
+
+func (uc *CommentUsecase) UpdateComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID, req *dto.UpdateCommentRequestDTO) (*dto.CommentResponseDTO, error) {
+	const op = "CommentUsecase.UpdateComment"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"comment_id": commentID,
+	})
+
+	req.Content = strings.TrimSpace(req.Content)
+	if err := validateCommentContent(req.Content); err != nil {
+		logger.WithError(err).Warn("invalid comment content")
+		return nil, err
+	}
+
+	existing, err := uc.getNodeComment(ctx, roadmapID, nodeID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.IsDeleted {
+		logger.Warn("attempt to edit deleted comment")
+		return nil, errs.ErrNotFound
+	}
+
+	if existing.AuthorID != userID {
+		logger.WithField("author_id", existing.AuthorID).Warn("user tried to edit comment they don't own")
+		return nil, errs.ErrForbidden
+	}
+
+	if err := uc.moderateContent(ctx, req.Content); err != nil {
+		logger.WithError(err).Warn("comment edit rejected by moderation")
+		return nil, fmt.Errorf("moderation check failed: %w", err)
+	}
+
+	now := time.Now()
+	if err := uc.repo.UpdateContent(ctx, commentID, req.Content, now); err != nil {
+		logger.WithError(err).Error("failed to update comment")
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	existing.Content = req.Content
+	existing.IsEdited = true
+	existing.UpdatedAt = now
+
+	response := dto.CommentToDTO(existing, uc.fetchAuthor(ctx, userID), userID)
+
+	logger.Info("successfully updated comment")
+	return &response, nil
+}
+
+func (uc *CommentUsecase) DeleteComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID) error {
+	const op = "CommentUsecase.DeleteComment"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"comment_id": commentID,
+	})
+
+	existing, err := uc.getNodeComment(ctx, roadmapID, nodeID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if existing.IsDeleted {
+		return errs.ErrNotFound
+	}
+
+	if existing.AuthorID != userID {
+		logger.WithField("author_id", existing.AuthorID).Warn("user tried to delete comment they don't own")
+		return errs.ErrForbidden
+	}
+
+	if existing.ParentID == nil && existing.ReplyCount > 0 {
+		if err := uc.repo.SoftDelete(ctx, commentID); err != nil {
+			logger.WithError(err).Error("failed to soft delete comment")
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		logger.Info("successfully soft deleted comment with replies")
+		return nil
+	}
+
+	if err := uc.repo.Delete(ctx, commentID); err != nil {
+		logger.WithError(err).Error("failed to delete comment")
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	if existing.ParentID != nil {
+		if err := uc.repo.IncReplyCount(ctx, *existing.ParentID, -1); err != nil {
+			logger.WithError(err).Warn("failed to decrement reply count")
+		}
+	}
+
+	logger.Info("successfully deleted comment")
+	return nil
+}
+
+func (uc *CommentUsecase) VoteComment(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID, req *dto.VoteCommentRequestDTO) (*dto.CommentResponseDTO, error) {
+	const op = "CommentUsecase.VoteComment"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"comment_id": commentID,
+		"vote":       req.Vote,
+	})
+
+	if !req.Vote.IsValid() {
+		logger.Warn("invalid vote value provided")
+		return nil, fmt.Errorf("invalid vote value: %s", req.Vote)
+	}
+
+	existing, err := uc.getNodeComment(ctx, roadmapID, nodeID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.AuthorID == userID {
+		logger.Warn("user tried to vote for their own comment")
+		return nil, fmt.Errorf("invalid vote: cannot vote for own comment")
+	}
+
+	updated, err := uc.repo.SetVote(ctx, commentID, userID, req.Vote)
+	if err != nil {
+		logger.WithError(err).Error("failed to set comment vote")
+		return nil, fmt.Errorf("failed to vote for comment: %w", err)
+	}
+
+	if updated == nil {
+		return nil, errs.ErrNotFound
+	}
+
+	response := dto.CommentToDTO(updated, uc.fetchAuthor(ctx, updated.AuthorID), userID)
+
+	logger.WithField("score", updated.Score).Info("successfully voted for comment")
+	return &response, nil
+}
+
+func (uc *CommentUsecase) ensureNodeExists(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	logger := ctxutil.GetLogger(ctx)
+
+	roadmapEntity, err := uc.roadmapRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmapEntity == nil {
+		logger.Warn("roadmap not found")
+		return errs.ErrNotFound
+	}
+
+	for _, node := range roadmapEntity.Nodes {
+		if node.ID == nodeID {
+			return nil
+		}
+	}
+
+	logger.WithField("node_id", nodeID).Warn("node not found in roadmap")
+	return errs.ErrNotFound
+}
+
+// getNodeComment loads a comment and checks it belongs to the node from the path.
+func (uc *CommentUsecase) getNodeComment(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, commentID uuid.UUID) (*entities.NodeComment, error) {
+	logger := ctxutil.GetLogger(ctx).WithField("comment_id", commentID)
+
+	existing, err := uc.repo.GetByID(ctx, commentID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get comment")
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	if existing == nil || existing.RoadmapID != roadmapID || existing.NodeID != nodeID {
+		logger.Warn("comment not found")
+		return nil, errs.ErrNotFound
+	}
+
+	return existing, nil
+}
+
+func (uc *CommentUsecase) moderateContent(ctx context.Context, content string) error {
+	resp, err := uc.moderationClient.ModerateContent(ctx, &moderationclient.ModerateContentRequest{
+		Content: content,
+	})
+	if err != nil {
+		return fmt.Errorf("moderation service unavailable: %w", err)
+	}
+
+	if resp.Error != "" {
+		return fmt.Errorf("moderation error: %s", resp.Error)
+	}
+
+	if resp.Result == nil {
+		return fmt.Errorf("invalid moderation response")
+	}
+
+	if !resp.Result.Allowed {
+		return fmt.Errorf("%w: %s", errModerationRejected, strings.Join(resp.Result.Categories, ", "))
+	}
+
+	return nil
+}
+
+func (uc *CommentUsecase) fetchAuthor(ctx context.Context, userID uuid.UUID) dto.CommentAuthorDTO {
+	resp, err := uc.authClient.GetUserByID(ctx, &authclient.GetUserByIDRequest{UserId: userID.String()})
+	if err != nil || resp == nil || resp.User == nil {
+		ctxutil.GetLogger(ctx).WithError(err).Warn("failed to fetch comment author, using fallback")
+		return dto.CommentAuthorDTO{ID: userID, Username: "Unknown User"}
+	}
+
+	return dto.CommentAuthorDTO{
+		ID:        userID,
+		Username:  resp.User.Username,
+		AvatarURL: resp.User.AvatarUrl,
+	}
+}
+
+func (uc *CommentUsecase) fetchAuthors(ctx context.Context, comments []*entities.NodeComment) map[uuid.UUID]dto.CommentAuthorDTO {
+	authors := make(map[uuid.UUID]dto.CommentAuthorDTO)
+
+	seen := make(map[uuid.UUID]bool)
+	authorIDs := make([]string, 0, len(comments))
+	for _, c := range comments {
+		if c == nil || c.IsDeleted || seen[c.AuthorID] {
+			continue
+		}
+		seen[c.AuthorID] = true
+		authorIDs = append(authorIDs, c.AuthorID.String())
+	}
+
+	if len(authorIDs) == 0 {
+		return authors
+	}
+
+	resp, err := uc.authClient.GetUsersByIDs(ctx, &authclient.GetUsersByIDsRequest{UserIds: authorIDs})
+	if err != nil || resp == nil {
+		ctxutil.GetLogger(ctx).WithError(err).Warn("failed to fetch comment authors, using fallback")
+		return authors
+	}
+
+	for _, user := range resp.Users {
+		if user == nil {
+			continue
+		}
+		userID, err := uuid.Parse(user.Id)
+		if err != nil {
+			continue
+		}
+		authors[userID] = dto.CommentAuthorDTO{
+			ID:        userID,
+			Username:  user.Username,
+			AvatarURL: user.AvatarUrl,
+		}
+	}
+
+	return authors
+}
+
+func validateCommentContent(content string) error {
+	if content == "" {
+		return fmt.Errorf("invalid comment: content is required")
+	}
+
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return fmt.Errorf("invalid comment: content must be at most %d characters", maxCommentLength)
+	}
+
+	return nil
+}
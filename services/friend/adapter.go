@@ -0,0 +1,7 @@
+package friend
+
+import "context"
+
+type GamificationPublisher interface {
+	FriendAdded(ctx context.Context, userID, friendID string) error
+}
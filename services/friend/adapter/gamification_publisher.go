@@ -0,0 +1,36 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	"github.com/F0urward/proftwist-backend/services/friend"
+	gamificationDTO "github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+type GamificationPublisher struct {
+	producer broker.Producer
+}
+
+func NewGamificationPublisher(producer broker.Producer) friend.GamificationPublisher {
+	return &GamificationPublisher{producer: producer}
+}
+
+func (k *GamificationPublisher) FriendAdded(ctx context.Context, userID, friendID string) error {
+	event := gamificationDTO.ActivityEvent{
+		Type:       gamificationDTO.FriendAddedType,
+		EventID:    fmt.Sprintf("%s:%s:%s", gamificationDTO.FriendAddedType, userID, friendID),
+		UserID:     userID,
+		SubjectID:  friendID,
+		OccurredAt: time.Now(),
+	}
+
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return k.producer.Publish(ctx, userID, data)
+}
@@ -46,6 +46,31 @@ func (s *FriendServer) GetFriendshipStatus(ctx context.Context, req *friendclien
 	return protoResponse, nil
 }
 
+func (s *FriendServer) GetFriendIDs(ctx context.Context, req *friendclient.GetFriendIDsRequest) (*friendclient.GetFriendIDsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &friendclient.GetFriendIDsResponse{
+			Error: "invalid user id format",
+		}, nil
+	}
+
+	friendIDs, err := s.uc.GetFriendIDs(ctx, userID)
+	if err != nil {
+		return &friendclient.GetFriendIDsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	response := &friendclient.GetFriendIDsResponse{
+		FriendIds: make([]string, 0, len(friendIDs)),
+	}
+	for _, friendID := range friendIDs {
+		response.FriendIds = append(response.FriendIds, friendID.String())
+	}
+
+	return response, nil
+}
+
 func (s *FriendServer) convertFriendshipStatusToProto(dto *dto.FriendshipStatusResponseDTO) *friendclient.GetFriendshipStatusResponse {
 	response := &friendclient.GetFriendshipStatusResponse{
 		Status:   dto.Status,
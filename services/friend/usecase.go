@@ -10,6 +10,7 @@ import (
 
 type Usecase interface {
 	GetFriends(ctx context.Context, userID uuid.UUID) (*dto.GetFriendsResponseDTO, error)
+	GetFriendIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 	DeleteFriend(ctx context.Context, userID, friendID uuid.UUID) error
 	GetFriendRequests(ctx context.Context, userID uuid.UUID) (*dto.GetFriendRequestsResponseDTO, error)
 	AcceptFriendRequest(ctx context.Context, userID, requestID uuid.UUID) (*dto.FriendResponseDTO, error)
@@ -16,16 +16,18 @@ import (
 )
 
 type FriendUsecase struct {
-	repo       friend.Repository
-	authClient authclient.AuthServiceClient
-	chatClient chatclient.ChatServiceClient
+	repo                  friend.Repository
+	authClient            authclient.AuthServiceClient
+	chatClient            chatclient.ChatServiceClient
+	gamificationPublisher friend.GamificationPublisher
 }
 
-func NewFriendUsecase(repo friend.Repository, authClient authclient.AuthServiceClient, chatClient chatclient.ChatServiceClient) friend.Usecase {
+func NewFriendUsecase(repo friend.Repository, authClient authclient.AuthServiceClient, chatClient chatclient.ChatServiceClient, gamificationPublisher friend.GamificationPublisher) friend.Usecase {
 	return &FriendUsecase{
-		repo:       repo,
-		authClient: authClient,
-		chatClient: chatClient,
+		repo:                  repo,
+		authClient:            authClient,
+		chatClient:            chatClient,
+		gamificationPublisher: gamificationPublisher,
 	}
 }
 
@@ -76,6 +78,24 @@ func (uc *FriendUsecase) GetFriends(ctx context.Context, userID uuid.UUID) (*dto
 	return &response, nil
 }
 
+func (uc *FriendUsecase) GetFriendIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	const op = "FriendUsecase.GetFriendIDs"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	friendIDs, err := uc.repo.GetFriendIDs(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get friend IDs")
+		return nil, fmt.Errorf("failed to get friend IDs: %w", err)
+	}
+
+	logger.WithField("count", len(friendIDs)).Info("successfully retrieved friend IDs")
+
+	return friendIDs, nil
+}
+
 func (uc *FriendUsecase) DeleteFriend(ctx context.Context, userID, friendID uuid.UUID) error {
 	const op = "FriendUsecase.DeleteFriend"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -228,6 +248,9 @@ func (uc *FriendUsecase) AcceptFriendRequest(ctx context.Context, userID, reques
 		return nil, fmt.Errorf("failed to create reverse friendship: %w", err)
 	}
 
+	uc.publishFriendAdded(ctx, request.FromUserID, request.ToUserID)
+	uc.publishFriendAdded(ctx, request.ToUserID, request.FromUserID)
+
 	friendData, err := uc.fetchSingleUserData(ctx, request.FromUserID)
 	if err != nil {
 		logger.WithError(err).Error("failed to fetch friend data")
@@ -419,6 +442,17 @@ func (uc *FriendUsecase) GetFriendshipStatus(ctx context.Context, userID, target
 	}, nil
 }
 
+// publishFriendAdded reports the new friendship to gamification. A failure
+// does not undo the friendship, so it is only logged.
+func (uc *FriendUsecase) publishFriendAdded(ctx context.Context, userID, friendID uuid.UUID) {
+	if err := uc.gamificationPublisher.FriendAdded(ctx, userID.String(), friendID.String()); err != nil {
+		ctxutil.GetLogger(ctx).WithError(err).WithFields(map[string]interface{}{
+			"user_id":   userID,
+			"friend_id": friendID,
+		}).Warn("failed to publish friend added event")
+	}
+}
+
 func (uc *FriendUsecase) createDirectChat(ctx context.Context, user1ID, user2ID uuid.UUID) (uuid.UUID, error) {
 	const op = "FriendUsecase.createDirectChat"
 	logger := ctxutil.GetLogger(ctx).WithField("op", op)
@@ -0,0 +1,18 @@
+package gamification
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+)
+
+type Handlers interface {
+	GetMyStats(w http.ResponseWriter, r *http.Request)
+	UpdateSettings(w http.ResponseWriter, r *http.Request)
+	GetLeaderboard(w http.ResponseWriter, r *http.Request)
+}
+
+type BrokerHandlers interface {
+	HandleMessage(ctx context.Context, msg broker.Message) error
+}
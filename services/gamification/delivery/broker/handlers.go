@@ -0,0 +1,64 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+	"github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+type GamificationBrokerHandlers struct {
+	gamificationUC gamification.Usecase
+}
+
+func NewGamificationBrokerHandlers(gamificationUC gamification.Usecase) gamification.BrokerHandlers {
+	return &GamificationBrokerHandlers{gamificationUC: gamificationUC}
+}
+
+func (h *GamificationBrokerHandlers) HandleMessage(ctx context.Context, msg broker.Message) error {
+	const op = "GamificationBrokerHandlers.HandleMessage"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op).WithField("message_key", msg.Key)
+
+	logger.Info("received new message")
+
+	var baseEvent dto.BaseEvent
+	if err := baseEvent.UnmarshalJSON(msg.Value); err != nil {
+		logger.WithError(err).Error("failed to unmarshal base event")
+		return err
+	}
+
+	logger = logger.WithField("event_type", baseEvent.Type)
+
+	switch baseEvent.Type {
+	case dto.NodeCompletedType,
+		dto.QuizPassedType,
+		dto.RoadmapCompletedType,
+		dto.MaterialAddedType,
+		dto.GroupMessageSentType,
+		dto.FriendAddedType:
+		var event dto.ActivityEvent
+		if err := event.UnmarshalJSON(msg.Value); err != nil {
+			logger.WithError(err).Error("failed to unmarshal ActivityEvent")
+			return err
+		}
+
+		logger.WithFields(map[string]interface{}{
+			"event_id": event.EventID,
+			"user_id":  event.UserID,
+		}).Info("handling ActivityEvent")
+
+		if err := h.gamificationUC.HandleActivity(ctx, event); err != nil {
+			logger.WithError(err).Error("failed to handle ActivityEvent")
+			return err
+		}
+
+		logger.Info("successfully handled ActivityEvent")
+		return nil
+
+	default:
+		logger.Warn("received message with unknown event type, ignoring")
+		return nil
+	}
+}
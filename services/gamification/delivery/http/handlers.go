@@ -0,0 +1,121 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/utils"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+	"github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+type GamificationHandlers struct {
+	uc gamification.Usecase
+}
+
+func NewGamificationHandlers(uc gamification.Usecase) gamification.Handlers {
+	return &GamificationHandlers{
+		uc: uc,
+	}
+}
+
+func (h *GamificationHandlers) GetMyStats(w http.ResponseWriter, r *http.Request) {
+	const op = "GamificationHandlers.GetMyStats"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.uc.GetMyStats(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get gamification stats")
+		h.writeError(w, r, err, "failed to get gamification stats")
+		return
+	}
+
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *GamificationHandlers) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	const op = "GamificationHandlers.UpdateSettings"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateGamificationSettingsRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.UpdateSettings(ctx, userID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to update gamification settings")
+		h.writeError(w, r, err, "failed to update gamification settings")
+		return
+	}
+
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *GamificationHandlers) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+	const op = "GamificationHandlers.GetLeaderboard"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := requiredUserID(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.uc.GetLeaderboard(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get leaderboard")
+		h.writeError(w, r, err, "failed to get leaderboard")
+		return
+	}
+
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *GamificationHandlers) writeError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	statusCode := http.StatusInternalServerError
+	errorMsg := fallback
+
+	if errs.IsBusinessLogicError(err) {
+		statusCode = http.StatusBadRequest
+		errorMsg = err.Error()
+	}
+
+	utils.JSONError(r.Context(), w, statusCode, errorMsg)
+}
+
+func requiredUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ctx := r.Context()
+
+	userIDStr, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"net/http"
+
+	httpServer "github.com/F0urward/proftwist-backend/internal/server/http"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+)
+
+type GamificationHttpRegistrar struct {
+	handlers gamification.Handlers
+}
+
+func NewGamificationHttpRegistrar(handlers gamification.Handlers) httpServer.HttpRegistrar {
+	return &GamificationHttpRegistrar{
+		handlers: handlers,
+	}
+}
+
+func (r *GamificationHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
+	s.MUX.Handle("/api/v1/gamification/me", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetMyStats))).Methods("GET")
+	s.MUX.Handle("/api/v1/gamification/me/settings", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpdateSettings))).Methods("PUT")
+	s.MUX.Handle("/api/v1/gamification/leaderboard", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetLeaderboard))).Methods("GET")
+}
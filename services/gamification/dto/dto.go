@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	NodeCompletedType    EventType = "roadmap.node_completed"
+	QuizPassedType       EventType = "roadmap.quiz_passed"
+	RoadmapCompletedType EventType = "roadmap.roadmap_completed"
+	MaterialAddedType    EventType = "roadmap.material_added"
+	GroupMessageSentType EventType = "chat.group_message_sent"
+	FriendAddedType      EventType = "friend.friend_added"
+)
+
+type BaseEvent struct {
+	Type EventType `json:"type"`
+}
+
+// ActivityEvent reports something a user did that may earn XP. EventID must be
+// stable for the same activity, so that it is only rewarded once.
+type ActivityEvent struct {
+	Type       EventType `json:"type"`
+	EventID    string    `json:"event_id"`
+	UserID     string    `json:"user_id"`
+	SubjectID  string    `json:"subject_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type BadgeDTO struct {
+	Code        string     `json:"code"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Metric      string     `json:"metric"`
+	Threshold   int        `json:"threshold"`
+	Progress    int        `json:"progress"`
+	Earned      bool       `json:"earned"`
+	AwardedAt   *time.Time `json:"awarded_at,omitempty"`
+}
+
+type GamificationStatsDTO struct {
+	UserID         uuid.UUID      `json:"user_id"`
+	XP             int            `json:"xp"`
+	Level          int            `json:"level"`
+	LevelXP        int            `json:"level_xp"`
+	NextLevelXP    int            `json:"next_level_xp"`
+	CurrentStreak  int            `json:"current_streak"`
+	LongestStreak  int            `json:"longest_streak"`
+	LastActiveDate string         `json:"last_active_date,omitempty"`
+	Timezone       string         `json:"timezone"`
+	Counters       map[string]int `json:"counters"`
+	Badges         []BadgeDTO     `json:"badges"`
+}
+
+type UpdateGamificationSettingsRequestDTO struct {
+	Timezone string `json:"timezone"`
+}
+
+type LeaderboardUserDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	AvatarURL string    `json:"avatar_url,omitempty"`
+}
+
+type LeaderboardEntryDTO struct {
+	Rank          int                `json:"rank"`
+	User          LeaderboardUserDTO `json:"user"`
+	XP            int                `json:"xp"`
+	Level         int                `json:"level"`
+	CurrentStreak int                `json:"current_streak"`
+	BadgesCount   int                `json:"badges_count"`
+	IsMe          bool               `json:"is_me"`
+}
+
+type LeaderboardResponseDTO struct {
+	Entries []LeaderboardEntryDTO `json:"entries"`
+}
@@ -0,0 +1,969 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto(in *jlexer.Lexer, out *UpdateGamificationSettingsRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "timezone":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timezone = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto(out *jwriter.Writer, in UpdateGamificationSettingsRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Timezone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateGamificationSettingsRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateGamificationSettingsRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateGamificationSettingsRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateGamificationSettingsRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto1(in *jlexer.Lexer, out *LeaderboardUserDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "username":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Username = string(in.String())
+			}
+		case "avatar_url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvatarURL = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto1(out *jwriter.Writer, in LeaderboardUserDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	if in.AvatarURL != "" {
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LeaderboardUserDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LeaderboardUserDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LeaderboardUserDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LeaderboardUserDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto1(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto2(in *jlexer.Lexer, out *LeaderboardResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]LeaderboardEntryDTO, 0, 0)
+					} else {
+						out.Entries = []LeaderboardEntryDTO{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 LeaderboardEntryDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v1).UnmarshalEasyJSON(in)
+					}
+					out.Entries = append(out.Entries, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto2(out *jwriter.Writer, in LeaderboardResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix[1:])
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Entries {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LeaderboardResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LeaderboardResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LeaderboardResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LeaderboardResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto2(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto3(in *jlexer.Lexer, out *LeaderboardEntryDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "rank":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Rank = int(in.Int())
+			}
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.User).UnmarshalEasyJSON(in)
+			}
+		case "xp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.XP = int(in.Int())
+			}
+		case "level":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Level = int(in.Int())
+			}
+		case "current_streak":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CurrentStreak = int(in.Int())
+			}
+		case "badges_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BadgesCount = int(in.Int())
+			}
+		case "is_me":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsMe = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto3(out *jwriter.Writer, in LeaderboardEntryDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rank\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Rank))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"xp\":"
+		out.RawString(prefix)
+		out.Int(int(in.XP))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.Int(int(in.Level))
+	}
+	{
+		const prefix string = ",\"current_streak\":"
+		out.RawString(prefix)
+		out.Int(int(in.CurrentStreak))
+	}
+	{
+		const prefix string = ",\"badges_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.BadgesCount))
+	}
+	{
+		const prefix string = ",\"is_me\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMe))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LeaderboardEntryDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LeaderboardEntryDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LeaderboardEntryDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LeaderboardEntryDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto3(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto4(in *jlexer.Lexer, out *GamificationStatsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.UserID).UnmarshalText(data))
+				}
+			}
+		case "xp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.XP = int(in.Int())
+			}
+		case "level":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Level = int(in.Int())
+			}
+		case "level_xp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LevelXP = int(in.Int())
+			}
+		case "next_level_xp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NextLevelXP = int(in.Int())
+			}
+		case "current_streak":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CurrentStreak = int(in.Int())
+			}
+		case "longest_streak":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LongestStreak = int(in.Int())
+			}
+		case "last_active_date":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastActiveDate = string(in.String())
+			}
+		case "timezone":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timezone = string(in.String())
+			}
+		case "counters":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Counters = make(map[string]int)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v4 int
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v4 = int(in.Int())
+					}
+					(out.Counters)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "badges":
+			if in.IsNull() {
+				in.Skip()
+				out.Badges = nil
+			} else {
+				in.Delim('[')
+				if out.Badges == nil {
+					if !in.IsDelim(']') {
+						out.Badges = make([]BadgeDTO, 0, 0)
+					} else {
+						out.Badges = []BadgeDTO{}
+					}
+				} else {
+					out.Badges = (out.Badges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 BadgeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v5).UnmarshalEasyJSON(in)
+					}
+					out.Badges = append(out.Badges, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto4(out *jwriter.Writer, in GamificationStatsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.UserID).MarshalText())
+	}
+	{
+		const prefix string = ",\"xp\":"
+		out.RawString(prefix)
+		out.Int(int(in.XP))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.Int(int(in.Level))
+	}
+	{
+		const prefix string = ",\"level_xp\":"
+		out.RawString(prefix)
+		out.Int(int(in.LevelXP))
+	}
+	{
+		const prefix string = ",\"next_level_xp\":"
+		out.RawString(prefix)
+		out.Int(int(in.NextLevelXP))
+	}
+	{
+		const prefix string = ",\"current_streak\":"
+		out.RawString(prefix)
+		out.Int(int(in.CurrentStreak))
+	}
+	{
+		const prefix string = ",\"longest_streak\":"
+		out.RawString(prefix)
+		out.Int(int(in.LongestStreak))
+	}
+	if in.LastActiveDate != "" {
+		const prefix string = ",\"last_active_date\":"
+		out.RawString(prefix)
+		out.String(string(in.LastActiveDate))
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"counters\":"
+		out.RawString(prefix)
+		if in.Counters == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Counters {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.Int(int(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"badges\":"
+		out.RawString(prefix)
+		if in.Badges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Badges {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GamificationStatsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GamificationStatsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GamificationStatsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GamificationStatsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto4(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto5(in *jlexer.Lexer, out *BaseEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = EventType(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto5(out *jwriter.Writer, in BaseEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BaseEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BaseEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BaseEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BaseEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto5(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto6(in *jlexer.Lexer, out *BadgeDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "code":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Code = string(in.String())
+			}
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Title = string(in.String())
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "metric":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Metric = string(in.String())
+			}
+		case "threshold":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Threshold = int(in.Int())
+			}
+		case "progress":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Progress = int(in.Int())
+			}
+		case "earned":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Earned = bool(in.Bool())
+			}
+		case "awarded_at":
+			if in.IsNull() {
+				in.Skip()
+				out.AwardedAt = nil
+			} else {
+				if out.AwardedAt == nil {
+					out.AwardedAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.AwardedAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto6(out *jwriter.Writer, in BadgeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"metric\":"
+		out.RawString(prefix)
+		out.String(string(in.Metric))
+	}
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix)
+		out.Int(int(in.Threshold))
+	}
+	{
+		const prefix string = ",\"progress\":"
+		out.RawString(prefix)
+		out.Int(int(in.Progress))
+	}
+	{
+		const prefix string = ",\"earned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Earned))
+	}
+	if in.AwardedAt != nil {
+		const prefix string = ",\"awarded_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.AwardedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BadgeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BadgeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BadgeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BadgeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto6(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto7(in *jlexer.Lexer, out *ActivityEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = EventType(in.String())
+			}
+		case "event_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EventID = string(in.String())
+			}
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserID = string(in.String())
+			}
+		case "subject_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SubjectID = string(in.String())
+			}
+		case "occurred_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OccurredAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto7(out *jwriter.Writer, in ActivityEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EventID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"subject_id\":"
+		out.RawString(prefix)
+		out.String(string(in.SubjectID))
+	}
+	{
+		const prefix string = ",\"occurred_at\":"
+		out.RawString(prefix)
+		out.Raw((in.OccurredAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActivityEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActivityEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesGamificationDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActivityEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActivityEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesGamificationDto7(l, v)
+}
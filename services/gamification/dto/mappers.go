@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+func BadgeToDTO(rule config.BadgeRuleConfig, progress int, earned *entities.EarnedBadge) BadgeDTO {
+	res := BadgeDTO{
+		Code:        rule.Code,
+		Title:       rule.Title,
+		Description: rule.Description,
+		Metric:      rule.Metric,
+		Threshold:   rule.Threshold,
+		Progress:    min(progress, rule.Threshold),
+	}
+
+	if earned != nil {
+		awardedAt := earned.AwardedAt
+		res.Earned = true
+		res.AwardedAt = &awardedAt
+		res.Progress = rule.Threshold
+	}
+
+	return res
+}
+
+func GamificationStatsToDTO(profile *entities.GamificationProfile, currentStreak, levelXP, nextLevelXP int, badges []BadgeDTO) GamificationStatsDTO {
+	counters := profile.Counters
+	if counters == nil {
+		counters = map[string]int{}
+	}
+
+	if badges == nil {
+		badges = []BadgeDTO{}
+	}
+
+	return GamificationStatsDTO{
+		UserID:         profile.UserID,
+		XP:             profile.XP,
+		Level:          profile.Level,
+		LevelXP:        levelXP,
+		NextLevelXP:    nextLevelXP,
+		CurrentStreak:  currentStreak,
+		LongestStreak:  profile.LongestStreak,
+		LastActiveDate: profile.LastActiveDate,
+		Timezone:       profile.Timezone,
+		Counters:       counters,
+		Badges:         badges,
+	}
+}
+
+func LeaderboardEntryToDTO(profile *entities.GamificationProfile, user LeaderboardUserDTO, currentStreak int, viewerID uuid.UUID) LeaderboardEntryDTO {
+	return LeaderboardEntryDTO{
+		User:          user,
+		XP:            profile.XP,
+		Level:         profile.Level,
+		CurrentStreak: currentStreak,
+		BadgesCount:   len(profile.Badges),
+		IsMe:          profile.UserID == viewerID,
+	}
+}
@@ -0,0 +1,18 @@
+package gamification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type MongoRepository interface {
+	GetProfile(ctx context.Context, userID uuid.UUID) (*entities.GamificationProfile, error)
+	GetProfiles(ctx context.Context, userIDs []uuid.UUID) ([]*entities.GamificationProfile, error)
+	SaveProfile(ctx context.Context, profile *entities.GamificationProfile, expectedVersion int64) (bool, error)
+	CreateEvent(ctx context.Context, event *entities.XPEvent) (bool, error)
+	GetEvent(ctx context.Context, eventID string) (*entities.XPEvent, error)
+	MarkEventApplied(ctx context.Context, eventID string, xp int) error
+}
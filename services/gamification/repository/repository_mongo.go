@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+)
+
+const (
+	profilesCollectionName = "gamification_profiles"
+	eventsCollectionName   = "gamification_events"
+)
+
+type GamificationMongoRepository struct {
+	profilesCollection *mongo.Collection
+	eventsCollection   *mongo.Collection
+}
+
+func NewGamificationMongoRepository(db *mongo.Database) gamification.MongoRepository {
+	return &GamificationMongoRepository{
+		profilesCollection: db.Collection(profilesCollectionName),
+		eventsCollection:   db.Collection(eventsCollectionName),
+	}
+}
+
+func (r *GamificationMongoRepository) GetProfile(ctx context.Context, userID uuid.UUID) (*entities.GamificationProfile, error) {
+	const op = "GamificationRepository.GetProfile"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	var profile entities.GamificationProfile
+	err := r.profilesCollection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&profile)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get gamification profile")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &profile, nil
+}
+
+func (r *GamificationMongoRepository) GetProfiles(ctx context.Context, userIDs []uuid.UUID) ([]*entities.GamificationProfile, error) {
+	const op = "GamificationRepository.GetProfiles"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":    op,
+		"count": len(userIDs),
+	})
+
+	if len(userIDs) == 0 {
+		return []*entities.GamificationProfile{}, nil
+	}
+
+	cursor, err := r.profilesCollection.Find(ctx, bson.M{"user_id": bson.M{"$in": userIDs}})
+	if err != nil {
+		logger.WithError(err).Error("failed to find gamification profiles")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var profiles []*entities.GamificationProfile
+	if err := cursor.All(ctx, &profiles); err != nil {
+		logger.WithError(err).Error("failed to decode gamification profiles")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return profiles, nil
+}
+
+// SaveProfile stores the profile if it still has expectedVersion and bumps
+// the version. It reports false when another update got there first; a zero
+// expectedVersion means the profile is new.
+func (r *GamificationMongoRepository) SaveProfile(ctx context.Context, profile *entities.GamificationProfile, expectedVersion int64) (bool, error) {
+	const op = "GamificationRepository.SaveProfile"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": profile.UserID,
+		"version": expectedVersion,
+	})
+
+	profile.Version = expectedVersion + 1
+
+	if expectedVersion == 0 {
+		profile.ID = primitive.NilObjectID
+
+		result, err := r.profilesCollection.UpdateOne(
+			ctx,
+			bson.M{"user_id": profile.UserID},
+			bson.M{"$setOnInsert": profile},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			logger.WithError(err).Error("failed to create gamification profile")
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return result.UpsertedCount == 1, nil
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"xp":               profile.XP,
+			"level":            profile.Level,
+			"counters":         profile.Counters,
+			"current_streak":   profile.CurrentStreak,
+			"longest_streak":   profile.LongestStreak,
+			"last_active_date": profile.LastActiveDate,
+			"timezone":         profile.Timezone,
+			"badges":           profile.Badges,
+			"daily_counters":   profile.DailyCounters,
+			"recent_events":    profile.RecentEvents,
+			"version":          profile.Version,
+			"updated_at":       profile.UpdatedAt,
+		},
+	}
+
+	result, err := r.profilesCollection.UpdateOne(ctx, bson.M{"user_id": profile.UserID, "version": expectedVersion}, update)
+	if err != nil {
+		logger.WithError(err).Error("failed to update gamification profile")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+// CreateEvent records an event and reports false when an event with the same
+// EventID has already been recorded.
+func (r *GamificationMongoRepository) CreateEvent(ctx context.Context, event *entities.XPEvent) (bool, error) {
+	const op = "GamificationRepository.CreateEvent"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":       op,
+		"event_id": event.EventID,
+		"user_id":  event.UserID,
+	})
+
+	result, err := r.eventsCollection.UpdateOne(
+		ctx,
+		bson.M{"event_id": event.EventID},
+		bson.M{"$setOnInsert": event},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		logger.WithError(err).Error("failed to record gamification event")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result.UpsertedCount == 1, nil
+}
+
+func (r *GamificationMongoRepository) GetEvent(ctx context.Context, eventID string) (*entities.XPEvent, error) {
+	const op = "GamificationRepository.GetEvent"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":       op,
+		"event_id": eventID,
+	})
+
+	var event entities.XPEvent
+	err := r.eventsCollection.FindOne(ctx, bson.M{"event_id": eventID}).Decode(&event)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get gamification event")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &event, nil
+}
+
+// MarkEventApplied stores the XP the event earned and clears its pending flag
+// once the event has been applied to the profile.
+func (r *GamificationMongoRepository) MarkEventApplied(ctx context.Context, eventID string, xp int) error {
+	const op = "GamificationRepository.MarkEventApplied"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":       op,
+		"event_id": eventID,
+	})
+
+	update := bson.M{
+		"$set":   bson.M{"xp": xp},
+		"$unset": bson.M{"pending": ""},
+	}
+
+	if _, err := r.eventsCollection.UpdateOne(ctx, bson.M{"event_id": eventID}, update); err != nil {
+		logger.WithError(err).Error("failed to mark gamification event as applied")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package gamification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+type Usecase interface {
+	HandleActivity(ctx context.Context, event dto.ActivityEvent) error
+	GetMyStats(ctx context.Context, userID uuid.UUID) (*dto.GamificationStatsDTO, error)
+	UpdateSettings(ctx context.Context, userID uuid.UUID, req *dto.UpdateGamificationSettingsRequestDTO) (*dto.GamificationStatsDTO, error)
+	GetLeaderboard(ctx context.Context, userID uuid.UUID) (*dto.LeaderboardResponseDTO, error)
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	// dailyCounterDays is how many days of daily counters a profile keeps;
+	// older events rarely arrive and are counted against a fresh counter.
+	dailyCounterDays = 7
+
+	metricXP     = "xp"
+	metricLevel  = "level"
+	metricStreak = "streak"
+)
+
+// levelThreshold returns the XP needed to reach level. Every level costs
+// levelXP more than the previous one: 0, 100, 300, 600 and so on.
+func levelThreshold(level, levelXP int) int {
+	return levelXP * level * (level - 1) / 2
+}
+
+func levelForXP(xp, levelXP int) int {
+	if levelXP <= 0 {
+		return 1
+	}
+
+	level := 1
+	for levelThreshold(level+1, levelXP) <= xp {
+		level++
+	}
+	return level
+}
+
+// localDate returns the calendar day t falls on in loc.
+func localDate(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(dateLayout)
+}
+
+func previousDate(date string) string {
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return ""
+	}
+	return day.AddDate(0, 0, -1).Format(dateLayout)
+}
+
+func dailyCounterKey(date, eventType string) string {
+	return date + "/" + eventType
+}
+
+// pruneDailyCounters drops counters of days long before date, so the profile
+// does not grow with every active day.
+func pruneDailyCounters(profile *entities.GamificationProfile, date string) {
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return
+	}
+	oldest := day.AddDate(0, 0, -dailyCounterDays).Format(dateLayout)
+
+	for key := range profile.DailyCounters {
+		if keyDate, _, _ := strings.Cut(key, "/"); keyDate < oldest {
+			delete(profile.DailyCounters, key)
+		}
+	}
+}
+
+// applyStreak counts date as a learning day. Days that are not later than
+// the last active day do not change the streak, so late events are harmless.
+func applyStreak(profile *entities.GamificationProfile, date string) {
+	switch {
+	case profile.LastActiveDate == "":
+		profile.CurrentStreak = 1
+	case date <= profile.LastActiveDate:
+		return
+	case previousDate(date) == profile.LastActiveDate:
+		profile.CurrentStreak++
+	default:
+		profile.CurrentStreak = 1
+	}
+
+	profile.LastActiveDate = date
+	if profile.CurrentStreak > profile.LongestStreak {
+		profile.LongestStreak = profile.CurrentStreak
+	}
+}
+
+// currentStreak is the streak as seen on today: it is still alive when the
+// user learned today or yesterday, and broken otherwise.
+func currentStreak(profile *entities.GamificationProfile, today string) int {
+	if profile.LastActiveDate == today || profile.LastActiveDate == previousDate(today) {
+		return profile.CurrentStreak
+	}
+	return 0
+}
+
+func badgeProgress(profile *entities.GamificationProfile, metric string) int {
+	switch metric {
+	case metricXP:
+		return profile.XP
+	case metricLevel:
+		return profile.Level
+	case metricStreak:
+		return profile.LongestStreak
+	default:
+		return profile.Counters[metric]
+	}
+}
+
+// awardBadges grants every badge whose threshold the profile has reached and
+// returns the codes of the newly awarded ones.
+func awardBadges(profile *entities.GamificationProfile, badges []config.BadgeRuleConfig, now time.Time) []string {
+	var awarded []string
+	for _, badge := range badges {
+		if badge.Threshold <= 0 || profile.Badge(badge.Code) != nil {
+			continue
+		}
+		if badgeProgress(profile, badge.Metric) < badge.Threshold {
+			continue
+		}
+
+		profile.Badges = append(profile.Badges, entities.EarnedBadge{
+			Code:      badge.Code,
+			AwardedAt: now,
+		})
+		awarded = append(awarded, badge.Code)
+	}
+	return awarded
+}
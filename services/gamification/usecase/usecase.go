@@ -0,0 +1,458 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+	"github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+const (
+	fallbackTimezone      = "UTC"
+	maxProfileSaveRetries = 5
+	maxRecentEvents       = 500
+	maxTimezoneNameLength = 64
+)
+
+type GamificationUsecase struct {
+	cfg          *config.Config
+	repo         gamification.MongoRepository
+	authClient   authclient.AuthServiceClient
+	friendClient friendclient.FriendServiceClient
+}
+
+func NewGamificationUsecase(
+	cfg *config.Config,
+	repo gamification.MongoRepository,
+	authClient authclient.AuthServiceClient,
+	friendClient friendclient.FriendServiceClient,
+) gamification.Usecase {
+	return &GamificationUsecase{
+		cfg:          cfg,
+		repo:         repo,
+		authClient:   authClient,
+		friendClient: friendClient,
+	}
+}
+
+func (uc *GamificationUsecase) HandleActivity(ctx context.Context, event dto.ActivityEvent) error {
+	const op = "GamificationUsecase.HandleActivity"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"event_type": event.Type,
+		"event_id":   event.EventID,
+		"user_id":    event.UserID,
+	})
+
+	rule, ok := uc.rule(event.Type)
+	if !ok {
+		logger.Debug("no XP rule for event type, skipping")
+		return nil
+	}
+
+	userID, err := uuid.Parse(event.UserID)
+	if err != nil || event.EventID == "" {
+		logger.Warn("event has no valid user or event ID, skipping")
+		return nil
+	}
+
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	profile, err := uc.loadProfile(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to load gamification profile")
+		return fmt.Errorf("failed to load gamification profile: %w", err)
+	}
+
+	// The event stays pending until it is applied to the profile. A pending
+	// event is a redelivery of one whose processing failed, so it is resumed
+	// instead of skipped.
+	created, err := uc.repo.CreateEvent(ctx, &entities.XPEvent{
+		EventID:     event.EventID,
+		UserID:      userID,
+		Type:        rule.Event,
+		SubjectID:   event.SubjectID,
+		LocalDate:   localDate(event.OccurredAt, uc.location(profile.Timezone)),
+		OccurredAt:  event.OccurredAt,
+		ProcessedAt: time.Now(),
+		Pending:     true,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to record event")
+		return fmt.Errorf("failed to record event: %w", err)
+	}
+
+	if !created {
+		stored, err := uc.repo.GetEvent(ctx, event.EventID)
+		if err != nil {
+			logger.WithError(err).Error("failed to get recorded event")
+			return fmt.Errorf("failed to get recorded event: %w", err)
+		}
+		if stored != nil && !stored.Pending {
+			logger.Info("event has already been processed, skipping")
+			return nil
+		}
+		logger.Info("resuming event that has not been applied yet")
+	}
+
+	// Events of one user may be handled by several workers at once, so the
+	// profile is saved with a version check and recomputed on conflicts. The
+	// daily counters and applied event IDs live in the profile, so the daily
+	// limit and redeliveries are checked against the version being saved.
+	for i := 0; i < maxProfileSaveRetries; i++ {
+		if i > 0 {
+			if profile, err = uc.loadProfile(ctx, userID); err != nil {
+				logger.WithError(err).Error("failed to reload gamification profile")
+				return fmt.Errorf("failed to load gamification profile: %w", err)
+			}
+		}
+
+		if applied := profile.AppliedEvent(event.EventID); applied != nil {
+			logger.Info("event has already been applied to the profile")
+			return uc.markEventApplied(ctx, event.EventID, applied.XP)
+		}
+
+		expectedVersion := profile.Version
+		xp, awarded := uc.applyActivity(profile, rule, event.EventID, event.OccurredAt)
+		if xp == 0 && rule.XP > 0 {
+			logger.WithField("daily_limit", rule.DailyLimit).Info("daily XP limit reached for event type")
+		}
+
+		saved, err := uc.repo.SaveProfile(ctx, profile, expectedVersion)
+		if err != nil {
+			logger.WithError(err).Error("failed to save gamification profile")
+			return fmt.Errorf("failed to save gamification profile: %w", err)
+		}
+
+		if saved {
+			logger.WithFields(map[string]interface{}{
+				"xp":     xp,
+				"level":  profile.Level,
+				"streak": profile.CurrentStreak,
+				"badges": awarded,
+			}).Info("successfully applied activity")
+			return uc.markEventApplied(ctx, event.EventID, xp)
+		}
+
+		logger.Debug("gamification profile changed concurrently, retrying")
+	}
+
+	logger.Error("gave up saving gamification profile after concurrent updates")
+	return fmt.Errorf("failed to save gamification profile: too many concurrent updates")
+}
+
+func (uc *GamificationUsecase) markEventApplied(ctx context.Context, eventID string, xp int) error {
+	if err := uc.repo.MarkEventApplied(ctx, eventID, xp); err != nil {
+		ctxutil.GetLogger(ctx).WithError(err).Error("failed to mark event as applied")
+		return fmt.Errorf("failed to mark event as applied: %w", err)
+	}
+	return nil
+}
+
+func (uc *GamificationUsecase) GetMyStats(ctx context.Context, userID uuid.UUID) (*dto.GamificationStatsDTO, error) {
+	const op = "GamificationUsecase.GetMyStats"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	profile, err := uc.loadProfile(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to load gamification profile")
+		return nil, fmt.Errorf("failed to load gamification profile: %w", err)
+	}
+
+	response := uc.statsToDTO(profile)
+
+	logger.WithFields(map[string]interface{}{
+		"xp":    response.XP,
+		"level": response.Level,
+	}).Info("successfully retrieved gamification stats")
+	return &response, nil
+}
+
+func (uc *GamificationUsecase) UpdateSettings(ctx context.Context, userID uuid.UUID, req *dto.UpdateGamificationSettingsRequestDTO) (*dto.GamificationStatsDTO, error) {
+	const op = "GamificationUsecase.UpdateSettings"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":       op,
+		"user_id":  userID,
+		"timezone": req.Timezone,
+	})
+
+	timezone := strings.TrimSpace(req.Timezone)
+	if timezone == "" || len(timezone) > maxTimezoneNameLength {
+		logger.Warn("invalid timezone provided")
+		return nil, fmt.Errorf("invalid timezone: %q", req.Timezone)
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		logger.WithError(err).Warn("unknown timezone provided")
+		return nil, fmt.Errorf("invalid timezone: %q", req.Timezone)
+	}
+
+	for i := 0; i < maxProfileSaveRetries; i++ {
+		profile, err := uc.loadProfile(ctx, userID)
+		if err != nil {
+			logger.WithError(err).Error("failed to load gamification profile")
+			return nil, fmt.Errorf("failed to load gamification profile: %w", err)
+		}
+
+		expectedVersion := profile.Version
+		profile.Timezone = timezone
+		profile.UpdatedAt = time.Now()
+
+		saved, err := uc.repo.SaveProfile(ctx, profile, expectedVersion)
+		if err != nil {
+			logger.WithError(err).Error("failed to save gamification profile")
+			return nil, fmt.Errorf("failed to save gamification profile: %w", err)
+		}
+
+		if saved {
+			response := uc.statsToDTO(profile)
+			logger.Info("successfully updated gamification settings")
+			return &response, nil
+		}
+	}
+
+	logger.Error("gave up saving gamification settings after concurrent updates")
+	return nil, fmt.Errorf("failed to save gamification profile: too many concurrent updates")
+}
+
+func (uc *GamificationUsecase) GetLeaderboard(ctx context.Context, userID uuid.UUID) (*dto.LeaderboardResponseDTO, error) {
+	const op = "GamificationUsecase.GetLeaderboard"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	friendsResp, err := uc.friendClient.GetFriendIDs(ctx, &friendclient.GetFriendIDsRequest{UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Error("failed to get friend IDs")
+		return nil, fmt.Errorf("failed to get friends: %w", err)
+	}
+	if friendsResp.Error != "" {
+		logger.WithField("error", friendsResp.Error).Error("friend service returned error")
+		return nil, fmt.Errorf("failed to get friends: %s", friendsResp.Error)
+	}
+
+	userIDs := []uuid.UUID{userID}
+	for _, id := range friendsResp.FriendIds {
+		friendID, err := uuid.Parse(id)
+		if err != nil || friendID == userID {
+			continue
+		}
+		userIDs = append(userIDs, friendID)
+	}
+
+	profiles, err := uc.repo.GetProfiles(ctx, userIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get gamification profiles")
+		return nil, fmt.Errorf("failed to get gamification profiles: %w", err)
+	}
+
+	// Friends who have not earned anything yet still show up with zero XP.
+	byUser := make(map[uuid.UUID]*entities.GamificationProfile, len(profiles))
+	for _, profile := range profiles {
+		byUser[profile.UserID] = profile
+	}
+	for _, id := range userIDs {
+		if byUser[id] == nil {
+			byUser[id] = uc.newProfile(id)
+		}
+	}
+
+	users := uc.fetchUsers(ctx, userIDs)
+
+	entries := make([]dto.LeaderboardEntryDTO, 0, len(userIDs))
+	for _, id := range userIDs {
+		profile := byUser[id]
+		today := localDate(time.Now(), uc.location(profile.Timezone))
+		entries = append(entries, dto.LeaderboardEntryToDTO(profile, users[id], currentStreak(profile, today), userID))
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].XP != entries[j].XP {
+			return entries[i].XP > entries[j].XP
+		}
+		if entries[i].CurrentStreak != entries[j].CurrentStreak {
+			return entries[i].CurrentStreak > entries[j].CurrentStreak
+		}
+		return entries[i].User.Username < entries[j].User.Username
+	})
+
+	for i := range entries {
+		entries[i].Rank = i + 1
+		if i > 0 && entries[i].XP == entries[i-1].XP {
+			entries[i].Rank = entries[i-1].Rank
+		}
+	}
+
+	logger.WithField("count", len(entries)).Info("successfully built leaderboard")
+	return &dto.LeaderboardResponseDTO{Entries: entries}, nil
+}
+
+// applyActivity applies the event to the profile and returns the XP it earned
+// and the newly awarded badges. Events past the daily limit earn no XP but
+// still count for the streak.
+func (uc *GamificationUsecase) applyActivity(profile *entities.GamificationProfile, rule config.XPRuleConfig, eventID string, occurredAt time.Time) (int, []string) {
+	now := time.Now()
+	date := localDate(occurredAt, uc.location(profile.Timezone))
+
+	xp := rule.XP
+	if rule.DailyLimit > 0 {
+		pruneDailyCounters(profile, date)
+
+		key := dailyCounterKey(date, rule.Event)
+		if profile.DailyCounters[key] >= rule.DailyLimit {
+			xp = 0
+		} else if xp > 0 {
+			profile.DailyCounters[key]++
+		}
+	}
+
+	if xp > 0 {
+		profile.XP += xp
+		profile.Counters[rule.Event]++
+	}
+	profile.Level = levelForXP(profile.XP, uc.cfg.Gamification.LevelXP)
+
+	if rule.Streak {
+		applyStreak(profile, date)
+	}
+
+	profile.RecentEvents = append(profile.RecentEvents, entities.AppliedXPEvent{EventID: eventID, XP: xp})
+	if len(profile.RecentEvents) > maxRecentEvents {
+		profile.RecentEvents = profile.RecentEvents[len(profile.RecentEvents)-maxRecentEvents:]
+	}
+
+	profile.UpdatedAt = now
+	return xp, awardBadges(profile, uc.cfg.Gamification.Badges, now)
+}
+
+func (uc *GamificationUsecase) statsToDTO(profile *entities.GamificationProfile) dto.GamificationStatsDTO {
+	levelXP := uc.cfg.Gamification.LevelXP
+	today := localDate(time.Now(), uc.location(profile.Timezone))
+
+	badges := make([]dto.BadgeDTO, 0, len(uc.cfg.Gamification.Badges))
+	for _, rule := range uc.cfg.Gamification.Badges {
+		badges = append(badges, dto.BadgeToDTO(rule, badgeProgress(profile, rule.Metric), profile.Badge(rule.Code)))
+	}
+
+	return dto.GamificationStatsToDTO(
+		profile,
+		currentStreak(profile, today),
+		levelThreshold(profile.Level, levelXP),
+		levelThreshold(profile.Level+1, levelXP),
+		badges,
+	)
+}
+
+func (uc *GamificationUsecase) rule(eventType dto.EventType) (config.XPRuleConfig, bool) {
+	for _, rule := range uc.cfg.Gamification.Rules {
+		if rule.Event == string(eventType) {
+			return rule, true
+		}
+	}
+	return config.XPRuleConfig{}, false
+}
+
+// loadProfile returns the stored profile or a fresh one for users who have
+// not earned anything yet.
+func (uc *GamificationUsecase) loadProfile(ctx context.Context, userID uuid.UUID) (*entities.GamificationProfile, error) {
+	profile, err := uc.repo.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == nil {
+		return uc.newProfile(userID), nil
+	}
+
+	if profile.Counters == nil {
+		profile.Counters = map[string]int{}
+	}
+	if profile.DailyCounters == nil {
+		profile.DailyCounters = map[string]int{}
+	}
+	return profile, nil
+}
+
+func (uc *GamificationUsecase) newProfile(userID uuid.UUID) *entities.GamificationProfile {
+	return &entities.GamificationProfile{
+		UserID:        userID,
+		Level:         1,
+		Counters:      map[string]int{},
+		Timezone:      uc.defaultTimezone(),
+		Badges:        []entities.EarnedBadge{},
+		DailyCounters: map[string]int{},
+		RecentEvents:  []entities.AppliedXPEvent{},
+	}
+}
+
+func (uc *GamificationUsecase) defaultTimezone() string {
+	if uc.cfg.Gamification.DefaultTimezone != "" {
+		return uc.cfg.Gamification.DefaultTimezone
+	}
+	return fallbackTimezone
+}
+
+func (uc *GamificationUsecase) location(timezone string) *time.Location {
+	if timezone == "" {
+		timezone = uc.defaultTimezone()
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (uc *GamificationUsecase) fetchUsers(ctx context.Context, userIDs []uuid.UUID) map[uuid.UUID]dto.LeaderboardUserDTO {
+	users := make(map[uuid.UUID]dto.LeaderboardUserDTO, len(userIDs))
+	for _, id := range userIDs {
+		users[id] = dto.LeaderboardUserDTO{ID: id}
+	}
+
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.String())
+	}
+
+	resp, err := uc.authClient.GetUsersByIDs(ctx, &authclient.GetUsersByIDsRequest{UserIds: ids})
+	if err != nil || resp == nil {
+		ctxutil.GetLogger(ctx).WithError(err).Warn("failed to fetch leaderboard users, using fallback")
+		return users
+	}
+
+	for _, user := range resp.Users {
+		if user == nil {
+			continue
+		}
+		userID, err := uuid.Parse(user.Id)
+		if err != nil {
+			continue
+		}
+		users[userID] = dto.LeaderboardUserDTO{
+			ID:        userID,
+			Username:  user.Username,
+			AvatarURL: user.AvatarUrl,
+		}
+	}
+
+	return users
+}
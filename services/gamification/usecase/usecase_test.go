@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/services/gamification"
+	"github.com/F0urward/proftwist-backend/services/gamification/dto"
+)
+
+type memoryRepo struct {
+	gamification.MongoRepository
+
+	profile   *entities.GamificationProfile
+	events    map[string]entities.XPEvent
+	failSaves int
+}
+
+func newMemoryRepo() *memoryRepo {
+	return &memoryRepo{events: map[string]entities.XPEvent{}}
+}
+
+func (r *memoryRepo) GetProfile(context.Context, uuid.UUID) (*entities.GamificationProfile, error) {
+	if r.profile == nil {
+		return nil, nil
+	}
+
+	stored := *r.profile
+	stored.Counters = copyCounters(r.profile.Counters)
+	stored.DailyCounters = copyCounters(r.profile.DailyCounters)
+	stored.RecentEvents = append([]entities.AppliedXPEvent(nil), r.profile.RecentEvents...)
+	return &stored, nil
+}
+
+func (r *memoryRepo) SaveProfile(_ context.Context, profile *entities.GamificationProfile, expectedVersion int64) (bool, error) {
+	if r.failSaves > 0 {
+		r.failSaves--
+		return false, errors.New("connection reset")
+	}
+
+	var version int64
+	if r.profile != nil {
+		version = r.profile.Version
+	}
+	if version != expectedVersion {
+		return false, nil
+	}
+
+	profile.Version = expectedVersion + 1
+	r.profile = profile
+	return true, nil
+}
+
+func (r *memoryRepo) CreateEvent(_ context.Context, event *entities.XPEvent) (bool, error) {
+	if _, ok := r.events[event.EventID]; ok {
+		return false, nil
+	}
+	r.events[event.EventID] = *event
+	return true, nil
+}
+
+func (r *memoryRepo) GetEvent(_ context.Context, eventID string) (*entities.XPEvent, error) {
+	event, ok := r.events[eventID]
+	if !ok {
+		return nil, nil
+	}
+	return &event, nil
+}
+
+func (r *memoryRepo) MarkEventApplied(_ context.Context, eventID string, xp int) error {
+	event := r.events[eventID]
+	event.XP = xp
+	event.Pending = false
+	r.events[eventID] = event
+	return nil
+}
+
+func copyCounters(counters map[string]int) map[string]int {
+	copied := make(map[string]int, len(counters))
+	for key, value := range counters {
+		copied[key] = value
+	}
+	return copied
+}
+
+func newTestUsecase(repo *memoryRepo, rule config.XPRuleConfig) *GamificationUsecase {
+	cfg := &config.Config{}
+	cfg.Gamification = config.GamificationConfig{
+		LevelXP: 100,
+		Rules:   []config.XPRuleConfig{rule},
+	}
+	return NewGamificationUsecase(cfg, repo, nil, nil).(*GamificationUsecase)
+}
+
+func TestHandleActivityRetriesEventAfterFailedSave(t *testing.T) {
+	repo := newMemoryRepo()
+	repo.failSaves = 1
+	uc := newTestUsecase(repo, config.XPRuleConfig{Event: "node_completed", XP: 10, Streak: true})
+
+	event := dto.ActivityEvent{
+		EventID:    "event-1",
+		Type:       "node_completed",
+		UserID:     uuid.NewString(),
+		OccurredAt: time.Now(),
+	}
+
+	if err := uc.HandleActivity(context.Background(), event); err == nil {
+		t.Fatal("HandleActivity succeeded although the profile was not saved")
+	}
+	if !repo.events["event-1"].Pending {
+		t.Fatal("event was not left pending after the failed save")
+	}
+
+	// The redelivered event must still be applied, and only once.
+	for i := 0; i < 2; i++ {
+		if err := uc.HandleActivity(context.Background(), event); err != nil {
+			t.Fatalf("HandleActivity returned error: %v", err)
+		}
+	}
+
+	if repo.profile.XP != 10 || repo.profile.Counters["node_completed"] != 1 {
+		t.Fatalf("got %d XP and %d events, want the event applied once", repo.profile.XP, repo.profile.Counters["node_completed"])
+	}
+	if stored := repo.events["event-1"]; stored.Pending || stored.XP != 10 {
+		t.Fatalf("got stored event %+v, want it applied with 10 XP", stored)
+	}
+}
+
+func TestHandleActivityAppliesDailyLimit(t *testing.T) {
+	repo := newMemoryRepo()
+	uc := newTestUsecase(repo, config.XPRuleConfig{Event: "material_added", XP: 5, DailyLimit: 2})
+
+	userID := uuid.NewString()
+	for _, id := range []string{"event-1", "event-2", "event-3"} {
+		event := dto.ActivityEvent{EventID: id, Type: "material_added", UserID: userID, OccurredAt: time.Now()}
+		if err := uc.HandleActivity(context.Background(), event); err != nil {
+			t.Fatalf("HandleActivity returned error: %v", err)
+		}
+	}
+
+	if repo.profile.XP != 10 {
+		t.Fatalf("got %d XP, want the daily limit of two events", repo.profile.XP)
+	}
+	if stored := repo.events["event-3"]; stored.Pending || stored.XP != 0 {
+		t.Fatalf("got stored event %+v, want it applied without XP", stored)
+	}
+}
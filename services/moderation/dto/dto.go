@@ -1,8 +1,9 @@
 package dto
 
 type ModerationResult struct {
-	Allowed    bool     `json:"allowed"`
-	Categories []string `json:"categories"`
+	Allowed       bool     `json:"allowed"`
+	Categories    []string `json:"categories"`
+	PromptVersion int      `json:"prompt_version"`
 }
 
 type ModerateContentRequest struct {
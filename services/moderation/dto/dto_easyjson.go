@@ -64,6 +64,12 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesModerationDt
 				}
 				in.Delim(']')
 			}
+		case "prompt_version":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PromptVersion = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -99,6 +105,11 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesModerationDt
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"prompt_version\":"
+		out.RawString(prefix)
+		out.Int(int(in.PromptVersion))
+	}
 	out.RawByte('}')
 }
 
@@ -7,5 +7,5 @@ import (
 )
 
 type GigachatWebapi interface {
-	GetModerationResult(ctx context.Context, content string) (*entities.ModerationResult, error)
+	GetModerationResult(ctx context.Context, content string, prompt *entities.PromptTemplate) (*entities.ModerationResult, error)
 }
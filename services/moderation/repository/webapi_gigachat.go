@@ -11,8 +11,8 @@ import (
 	"github.com/tidwall/gjson"
 
 	"github.com/F0urward/proftwist-backend/internal/entities"
-	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
-	gigachatClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient/dto"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	llmClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
 	"github.com/F0urward/proftwist-backend/services/moderation"
 )
@@ -20,41 +20,43 @@ import (
 //go:embed prompts/*
 var moderationPrompts embed.FS
 
+const defaultModerationSystemPrompt = "Ты - система модерации контента. Ты должен возвращать ТОЛЬКО валидный JSON без каких-либо дополнительных комментариев, текста или разметки."
+
 type ModerationGigaChatWebapi struct {
-	client *gigachatclient.Client
+	client llmclient.Provider
 }
 
-func NewModerationGigaChatWebapi(client *gigachatclient.Client) moderation.GigachatWebapi {
+func NewModerationGigaChatWebapi(client llmclient.Provider) moderation.GigachatWebapi {
 	return &ModerationGigaChatWebapi{client: client}
 }
 
-func (r *ModerationGigaChatWebapi) GetModerationResult(ctx context.Context, content string) (*entities.ModerationResult, error) {
+func (r *ModerationGigaChatWebapi) GetModerationResult(ctx context.Context, content string, prompt *entities.PromptTemplate) (*entities.ModerationResult, error) {
 	const op = "GigaChatWebapi.GetModerationResult"
 	logger := ctxutil.GetLogger(ctx).WithField("op", op)
 
 	logger.Info("analyzing content moderation")
 
-	prompt, err := r.buildModerationPrompt(content)
+	userPrompt, err := r.buildModerationPrompt(content, prompt)
 	if err != nil {
 		logger.WithError(err).Error("failed to build moderation prompt")
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	chatReq := &gigachatClientDTO.ChatRequest{
-		Model: "GigaChat",
-		Messages: []gigachatClientDTO.Message{
+	chatReq := &llmClientDTO.ChatRequest{
+		Messages: []llmClientDTO.Message{
 			{
 				Role:    "system",
-				Content: "Ты - система модерации контента. Ты должен возвращать ТОЛЬКО валидный JSON без каких-либо дополнительных комментариев, текста или разметки.",
+				Content: moderationSystemPrompt(prompt),
 			},
 			{
 				Role:    "user",
-				Content: prompt,
+				Content: userPrompt,
 			},
 		},
 		Temperature:       float64Ptr(0.1),
 		MaxTokens:         int64Ptr(500),
 		RepetitionPenalty: float64Ptr(1.0),
+		Feature:           llmclient.FeatureModeration,
 	}
 
 	logger.Info("sending moderation request to GigaChat")
@@ -79,13 +81,19 @@ func (r *ModerationGigaChatWebapi) GetModerationResult(ctx context.Context, cont
 	return result, nil
 }
 
-func (r *ModerationGigaChatWebapi) buildModerationPrompt(content string) (string, error) {
-	promptTmpl, err := moderationPrompts.ReadFile("prompts/moderation_prompt.tmpl")
-	if err != nil {
-		return "", fmt.Errorf("failed to read moderation prompt template: %w", err)
+func (r *ModerationGigaChatWebapi) buildModerationPrompt(content string, prompt *entities.PromptTemplate) (string, error) {
+	var promptTmpl string
+	if prompt != nil {
+		promptTmpl = prompt.Body
+	} else {
+		embedded, err := moderationPrompts.ReadFile("prompts/moderation_prompt.tmpl")
+		if err != nil {
+			return "", fmt.Errorf("failed to read moderation prompt template: %w", err)
+		}
+		promptTmpl = string(embedded)
 	}
 
-	tmpl, err := template.New("moderationPrompt").Parse(string(promptTmpl))
+	tmpl, err := template.New("moderationPrompt").Parse(promptTmpl)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse moderation prompt template: %w", err)
 	}
@@ -131,6 +139,13 @@ func (r *ModerationGigaChatWebapi) extractJSON(text string) (string, error) {
 	return "", fmt.Errorf("valid JSON not found in response")
 }
 
+func moderationSystemPrompt(prompt *entities.PromptTemplate) string {
+	if prompt != nil && prompt.SystemPrompt != "" {
+		return prompt.SystemPrompt
+	}
+	return defaultModerationSystemPrompt
+}
+
 func float64Ptr(f float64) *float64 {
 	return &f
 }
@@ -4,20 +4,25 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
 	"github.com/F0urward/proftwist-backend/services/moderation"
 	"github.com/F0urward/proftwist-backend/services/moderation/dto"
+	"github.com/F0urward/proftwist-backend/services/prompt"
 )
 
 type ModerationUsecase struct {
 	gigachatWebapi moderation.GigachatWebapi
+	promptUsecase  prompt.Usecase
 }
 
 func NewModerationUsecase(
 	gigichatWebapi moderation.GigachatWebapi,
+	promptUsecase prompt.Usecase,
 ) moderation.Usecase {
 	return &ModerationUsecase{
 		gigachatWebapi: gigichatWebapi,
+		promptUsecase:  promptUsecase,
 	}
 }
 
@@ -31,17 +36,33 @@ func (uc *ModerationUsecase) ModerateContent(ctx context.Context, content string
 		return &emptyResult, nil
 	}
 
-	moderationResult, err := uc.gigachatWebapi.GetModerationResult(ctx, content)
+	promptTemplate, err := uc.promptUsecase.Resolve(ctx, entities.PromptModeration, content)
+	if err != nil {
+		logger.WithError(err).Warn("failed to resolve moderation prompt, using built-in one")
+		promptTemplate = nil
+	}
+	promptUsage := entities.NewPromptUsage(entities.PromptModeration, promptTemplate)
+
+	moderationResult, err := uc.gigachatWebapi.GetModerationResult(ctx, content, promptTemplate)
 	if err != nil {
 		logger.WithError(err).Error("failed to get moderation result")
+		uc.promptUsecase.RecordUsage(ctx, promptUsage, "", entities.PromptOutcomeFailed)
 		return nil, fmt.Errorf("failed to moderate content: %w", err)
 	}
 
 	result := dto.ModerationResultToDTO(moderationResult.Allowed, moderationResult.Categories)
+	result.PromptVersion = promptUsage.Version
+
+	outcome := entities.PromptOutcomeAllowed
+	if !result.IsModeratedSuccessfully() {
+		outcome = entities.PromptOutcomeRejected
+	}
+	uc.promptUsecase.RecordUsage(ctx, promptUsage, "", outcome)
 
 	logger.WithFields(map[string]interface{}{
-		"allowed":    result.Allowed,
-		"categories": result.Categories,
+		"allowed":        result.Allowed,
+		"categories":     result.Categories,
+		"prompt_version": result.PromptVersion,
 	}).Debug("moderation completed")
 
 	return &result, nil
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	"github.com/F0urward/proftwist-backend/services/moderation/repository"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+)
+
+type promptUsecaseStub struct {
+	prompt.Usecase
+
+	outcomes []string
+}
+
+func (p *promptUsecaseStub) Resolve(context.Context, string, string) (*entities.PromptTemplate, error) {
+	return nil, nil
+}
+
+func (p *promptUsecaseStub) RecordUsage(_ context.Context, _ entities.PromptUsage, _ string, outcome string) {
+	p.outcomes = append(p.outcomes, outcome)
+}
+
+func TestModerateContentWithFakeProvider(t *testing.T) {
+	script := llmclient.DefaultFakeScript()
+	script.Rules = append([]llmclient.FakeRule{{
+		Feature:  llmclient.FeatureModeration,
+		Role:     "user",
+		Contains: "казино",
+		Response: `{"allowed": false, "categories": ["spam"]}`,
+	}}, script.Rules...)
+
+	provider := llmclient.NewFakeProvider(script)
+	prompts := &promptUsecaseStub{}
+	uc := NewModerationUsecase(repository.NewModerationGigaChatWebapi(provider), prompts)
+
+	allowed, err := uc.ModerateContent(context.Background(), "Основы Go: синтаксис и инструменты")
+	if err != nil {
+		t.Fatalf("ModerateContent returned error: %v", err)
+	}
+	if !allowed.Allowed {
+		t.Fatalf("regular content rejected with %v", allowed.Categories)
+	}
+
+	rejected, err := uc.ModerateContent(context.Background(), "Лучшее онлайн казино")
+	if err != nil {
+		t.Fatalf("ModerateContent returned error: %v", err)
+	}
+	if rejected.Allowed || len(rejected.Categories) != 1 || rejected.Categories[0] != "spam" {
+		t.Fatalf("got allowed=%v categories=%v, want rejected as spam", rejected.Allowed, rejected.Categories)
+	}
+
+	if got := prompts.outcomes; len(got) != 2 || got[0] != entities.PromptOutcomeAllowed || got[1] != entities.PromptOutcomeRejected {
+		t.Fatalf("got prompt outcomes %v", got)
+	}
+	for _, call := range provider.Calls() {
+		if call.Feature != llmclient.FeatureModeration {
+			t.Fatalf("got request for feature %q, want %q", call.Feature, llmclient.FeatureModeration)
+		}
+	}
+}
@@ -117,6 +117,48 @@ func (h *NotificationHandlers) HandleMessage(ctx context.Context, msg broker.Mes
 		logger.Info("successfully handled UserLeftEvent")
 		return nil
 
+	case dto.GoalReminderType:
+		var event dto.GoalReminderEvent
+		if err := event.UnmarshalJSON(msg.Value); err != nil {
+			logger.WithError(err).Error("failed to unmarshal GoalReminderEvent")
+			return err
+		}
+
+		logger.WithFields(map[string]interface{}{
+			"roadmap_id":  event.RoadmapID,
+			"status":      event.Status,
+			"users_count": len(event.UserIDs),
+		}).Info("handling GoalReminderEvent")
+
+		if err := h.notificationUC.HandleGoalReminder(ctx, event); err != nil {
+			logger.WithError(err).Error("failed to handle GoalReminderEvent")
+			return err
+		}
+
+		logger.Info("successfully handled GoalReminderEvent")
+		return nil
+
+	case dto.CollaboratorInviteType:
+		var event dto.CollaboratorInviteEvent
+		if err := event.UnmarshalJSON(msg.Value); err != nil {
+			logger.WithError(err).Error("failed to unmarshal CollaboratorInviteEvent")
+			return err
+		}
+
+		logger.WithFields(map[string]interface{}{
+			"roadmap_info_id": event.RoadmapInfoID,
+			"role":            event.Role,
+			"users_count":     len(event.UserIDs),
+		}).Info("handling CollaboratorInviteEvent")
+
+		if err := h.notificationUC.HandleCollaboratorInvite(ctx, event); err != nil {
+			logger.WithError(err).Error("failed to handle CollaboratorInviteEvent")
+			return err
+		}
+
+		logger.Info("successfully handled CollaboratorInviteEvent")
+		return nil
+
 	default:
 		logger.Warn("received message with unknown event type, ignoring")
 		return nil
@@ -7,10 +7,12 @@ import (
 type EventType string
 
 const (
-	MessagePublishedType EventType = "chat.message_published"
-	UserTypingType       EventType = "chat.user_typing"
-	UserJoinedType       EventType = "chat.user_joined"
-	UserLeftType         EventType = "chat.user_left"
+	MessagePublishedType   EventType = "chat.message_published"
+	UserTypingType         EventType = "chat.user_typing"
+	UserJoinedType         EventType = "chat.user_joined"
+	UserLeftType           EventType = "chat.user_left"
+	GoalReminderType       EventType = "roadmap.goal_reminder"
+	CollaboratorInviteType EventType = "roadmapinfo.collaborator_invite"
 )
 
 type BaseEvent struct {
@@ -55,3 +57,28 @@ type UserLeftEvent struct {
 	Username string    `json:"username"`
 	LeftAt   time.Time `json:"timestamp"`
 }
+
+type GoalReminderEvent struct {
+	Type                  EventType  `json:"type"`
+	UserIDs               []string   `json:"user_ids"`
+	RoadmapID             string     `json:"roadmap_id"`
+	RoadmapName           string     `json:"roadmap_name"`
+	Status                string     `json:"status"`
+	Deadline              time.Time  `json:"deadline"`
+	RemainingNodes        int        `json:"remaining_nodes"`
+	ActualPace            float64    `json:"actual_pace"`
+	RequiredPace          float64    `json:"required_pace"`
+	ProjectedCompletionAt *time.Time `json:"projected_completion_at,omitempty"`
+	SentAt                time.Time  `json:"timestamp"`
+}
+
+type CollaboratorInviteEvent struct {
+	Type          EventType `json:"type"`
+	UserIDs       []string  `json:"user_ids"`
+	RoadmapInfoID string    `json:"roadmap_info_id"`
+	RoadmapName   string    `json:"roadmap_name"`
+	Role          string    `json:"role"`
+	InvitedBy     string    `json:"invited_by"`
+	InviterName   string    `json:"inviter_name"`
+	SentAt        time.Time `json:"timestamp"`
+}
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -664,7 +665,7 @@ func (v *MessageSentEvent) UnmarshalJSON(data []byte) error {
 func (v *MessageSentEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto3(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto4(in *jlexer.Lexer, out *BaseEvent) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto4(in *jlexer.Lexer, out *GoalReminderEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -684,6 +685,101 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotification
 			} else {
 				out.Type = EventType(in.String())
 			}
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v13 = string(in.String())
+					}
+					out.UserIDs = append(out.UserIDs, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "roadmap_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapName = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
+		case "deadline":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Deadline).UnmarshalJSON(data))
+				}
+			}
+		case "remaining_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RemainingNodes = int(in.Int())
+			}
+		case "actual_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualPace = float64(in.Float64())
+			}
+		case "required_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RequiredPace = float64(in.Float64())
+			}
+		case "projected_completion_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ProjectedCompletionAt = nil
+			} else {
+				if out.ProjectedCompletionAt == nil {
+					out.ProjectedCompletionAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.ProjectedCompletionAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		case "timestamp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.SentAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -694,7 +790,7 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotification
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto4(out *jwriter.Writer, in BaseEvent) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto4(out *jwriter.Writer, in GoalReminderEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -703,29 +799,331 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotification
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix)
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.UserIDs {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"roadmap_name\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapName))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"deadline\":"
+		out.RawString(prefix)
+		out.Raw((in.Deadline).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"remaining_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.RemainingNodes))
+	}
+	{
+		const prefix string = ",\"actual_pace\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ActualPace))
+	}
+	{
+		const prefix string = ",\"required_pace\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RequiredPace))
+	}
+	if in.ProjectedCompletionAt != nil {
+		const prefix string = ",\"projected_completion_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ProjectedCompletionAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Raw((in.SentAt).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BaseEvent) MarshalJSON() ([]byte, error) {
+func (v GoalReminderEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BaseEvent) MarshalEasyJSON(w *jwriter.Writer) {
+func (v GoalReminderEvent) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BaseEvent) UnmarshalJSON(data []byte) error {
+func (v *GoalReminderEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BaseEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *GoalReminderEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto4(l, v)
 }
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto5(in *jlexer.Lexer, out *CollaboratorInviteEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = EventType(in.String())
+			}
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v16 = string(in.String())
+					}
+					out.UserIDs = append(out.UserIDs, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "roadmap_info_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapInfoID = string(in.String())
+			}
+		case "roadmap_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapName = string(in.String())
+			}
+		case "role":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Role = string(in.String())
+			}
+		case "invited_by":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InvitedBy = string(in.String())
+			}
+		case "inviter_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InviterName = string(in.String())
+			}
+		case "timestamp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.SentAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto5(out *jwriter.Writer, in CollaboratorInviteEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix)
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.UserIDs {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"roadmap_info_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapInfoID))
+	}
+	{
+		const prefix string = ",\"roadmap_name\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapName))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"invited_by\":"
+		out.RawString(prefix)
+		out.String(string(in.InvitedBy))
+	}
+	{
+		const prefix string = ",\"inviter_name\":"
+		out.RawString(prefix)
+		out.String(string(in.InviterName))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Raw((in.SentAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollaboratorInviteEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollaboratorInviteEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollaboratorInviteEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollaboratorInviteEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto5(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto6(in *jlexer.Lexer, out *BaseEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = EventType(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto6(out *jwriter.Writer, in BaseEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BaseEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BaseEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesNotificationDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BaseEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BaseEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesNotificationDto6(l, v)
+}
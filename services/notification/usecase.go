@@ -11,4 +11,6 @@ type Usecase interface {
 	HandleTyping(ctx context.Context, event dto.TypingEvent) error
 	HandleUserJoined(ctx context.Context, event dto.UserJoinedEvent) error
 	HandleUserLeft(ctx context.Context, event dto.UserLeftEvent) error
+	HandleGoalReminder(ctx context.Context, event dto.GoalReminderEvent) error
+	HandleCollaboratorInvite(ctx context.Context, event dto.CollaboratorInviteEvent) error
 }
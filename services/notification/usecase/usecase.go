@@ -157,3 +157,76 @@ func (uc *NotificationUsecase) HandleUserLeft(ctx context.Context, event dto.Use
 	logger.Info("successfully sent UserLeftEvent")
 	return nil
 }
+
+func (uc *NotificationUsecase) HandleGoalReminder(ctx context.Context, event dto.GoalReminderEvent) error {
+	const op = "NotificationUsecase.HandleGoalReminder"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op).WithField("roadmap_id", event.RoadmapID)
+
+	logger.Infof("sending GoalReminderEvent to users: %v", event.UserIDs)
+
+	reminderData := wsDTO.GoalReminderNotificationData{
+		RoadmapID:             event.RoadmapID,
+		RoadmapName:           event.RoadmapName,
+		Status:                event.Status,
+		Deadline:              event.Deadline,
+		RemainingNodes:        event.RemainingNodes,
+		ActualPace:            event.ActualPace,
+		RequiredPace:          event.RequiredPace,
+		ProjectedCompletionAt: event.ProjectedCompletionAt,
+	}
+
+	data, err := reminderData.MarshalJSON()
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal GoalReminderNotificationData")
+		return err
+	}
+
+	wsMessage := wsDTO.WebSocketMessage{
+		Type:      wsDTO.WebSocketMessageTypeGoalReminder,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+
+	if err := uc.wsServer.SendToUsers(event.UserIDs, wsMessage); err != nil {
+		logger.WithError(err).Error("failed to send GoalReminderEvent to users")
+		return err
+	}
+
+	logger.Info("successfully sent GoalReminderEvent")
+	return nil
+}
+
+func (uc *NotificationUsecase) HandleCollaboratorInvite(ctx context.Context, event dto.CollaboratorInviteEvent) error {
+	const op = "NotificationUsecase.HandleCollaboratorInvite"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op).WithField("roadmap_info_id", event.RoadmapInfoID)
+
+	logger.Infof("sending CollaboratorInviteEvent to users: %v", event.UserIDs)
+
+	inviteData := wsDTO.CollaboratorInviteNotificationData{
+		RoadmapInfoID: event.RoadmapInfoID,
+		RoadmapName:   event.RoadmapName,
+		Role:          event.Role,
+		InvitedBy:     event.InvitedBy,
+		InviterName:   event.InviterName,
+	}
+
+	data, err := inviteData.MarshalJSON()
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal CollaboratorInviteNotificationData")
+		return err
+	}
+
+	wsMessage := wsDTO.WebSocketMessage{
+		Type:      wsDTO.WebSocketMessageTypeCollaboratorInvite,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+
+	if err := uc.wsServer.SendToUsers(event.UserIDs, wsMessage); err != nil {
+		logger.WithError(err).Error("failed to send CollaboratorInviteEvent to users")
+		return err
+	}
+
+	logger.Info("successfully sent CollaboratorInviteEvent")
+	return nil
+}
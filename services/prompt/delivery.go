@@ -0,0 +1,11 @@
+package prompt
+
+import "net/http"
+
+type Handlers interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetVersions(w http.ResponseWriter, r *http.Request)
+	CreateVersion(w http.ResponseWriter, r *http.Request)
+	SetRollout(w http.ResponseWriter, r *http.Request)
+	Preview(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/utils"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+	"github.com/F0urward/proftwist-backend/services/prompt/dto"
+)
+
+type PromptHandlers struct {
+	uc prompt.Usecase
+}
+
+func NewPromptHandlers(uc prompt.Usecase) prompt.Handlers {
+	return &PromptHandlers{
+		uc: uc,
+	}
+}
+
+func (h *PromptHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
+	const op = "PromptHandlers.GetAll"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	res, err := h.uc.GetAll(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("failed to get prompt templates")
+		utils.JSONError(r.Context(), w, http.StatusInternalServerError, "failed to get prompt templates")
+		return
+	}
+
+	logger.WithField("count", len(res.Templates)).Info("successfully retrieved prompt templates")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *PromptHandlers) GetVersions(w http.ResponseWriter, r *http.Request) {
+	const op = "PromptHandlers.GetVersions"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	name := mux.Vars(r)["name"]
+	logger = logger.WithField("name", name)
+
+	res, err := h.uc.GetVersions(r.Context(), name)
+	if err != nil {
+		logger.WithError(err).Error("failed to get prompt template versions")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get prompt template versions"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "prompt template not found"
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("count", len(res.Versions)).Info("successfully retrieved prompt template versions")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *PromptHandlers) CreateVersion(w http.ResponseWriter, r *http.Request) {
+	const op = "PromptHandlers.CreateVersion"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	name := mux.Vars(r)["name"]
+	logger = logger.WithField("name", name)
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	var req dto.CreatePromptVersionRequestDTO
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.CreateVersion(r.Context(), userID, name, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to create prompt template version")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to create prompt template version"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "prompt template not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("version", res.Version).Info("successfully created prompt template version")
+	utils.JSONResponse(r.Context(), w, http.StatusCreated, res)
+}
+
+func (h *PromptHandlers) SetRollout(w http.ResponseWriter, r *http.Request) {
+	const op = "PromptHandlers.SetRollout"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	name := mux.Vars(r)["name"]
+	logger = logger.WithField("name", name)
+
+	var req dto.SetPromptRolloutRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.uc.SetRollout(r.Context(), name, &req); err != nil {
+		logger.WithError(err).Error("failed to set prompt template rollout")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to set prompt template rollout"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = err.Error()
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully updated prompt template rollout")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *PromptHandlers) Preview(w http.ResponseWriter, r *http.Request) {
+	const op = "PromptHandlers.Preview"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	name := mux.Vars(r)["name"]
+	logger = logger.WithField("name", name)
+
+	var req dto.PreviewPromptRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.Preview(r.Context(), name, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to preview prompt template")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to preview prompt template"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "prompt template not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully rendered prompt template preview")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
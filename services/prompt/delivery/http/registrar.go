@@ -0,0 +1,26 @@
+package http
+
+import (
+	"net/http"
+
+	httpServer "github.com/F0urward/proftwist-backend/internal/server/http"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+)
+
+type PromptHttpRegistrar struct {
+	handlers prompt.Handlers
+}
+
+func NewPromptHttpRegistrar(handlers prompt.Handlers) httpServer.HttpRegistrar {
+	return &PromptHttpRegistrar{
+		handlers: handlers,
+	}
+}
+
+func (r *PromptHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
+	s.MUX.Handle("/api/v1/admin/prompts", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.GetAll))).Methods("GET")
+	s.MUX.Handle("/api/v1/admin/prompts/{name}", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.GetVersions))).Methods("GET")
+	s.MUX.Handle("/api/v1/admin/prompts/{name}", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.CreateVersion))).Methods("POST")
+	s.MUX.Handle("/api/v1/admin/prompts/{name}/rollout", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.SetRollout))).Methods("PUT")
+	s.MUX.Handle("/api/v1/admin/prompts/{name}/preview", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.Preview))).Methods("POST")
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+)
+
+type PromptTemplateDTO struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Version      int       `json:"version"`
+	SystemPrompt string    `json:"system_prompt"`
+	Body         string    `json:"body"`
+	Weight       int       `json:"weight"`
+	AuthorID     string    `json:"author_id,omitempty"`
+	UsageCount   int64     `json:"usage_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type PromptTemplateSummaryDTO struct {
+	Name     string                 `json:"name"`
+	Versions int                    `json:"versions"`
+	Rollout  []PromptRolloutItemDTO `json:"rollout"`
+}
+
+type PromptTemplateListResponseDTO struct {
+	Templates []PromptTemplateSummaryDTO `json:"templates"`
+}
+
+type PromptTemplateVersionsResponseDTO struct {
+	Name     string              `json:"name"`
+	Versions []PromptTemplateDTO `json:"versions"`
+}
+
+type CreatePromptVersionRequestDTO struct {
+	SystemPrompt string `json:"system_prompt"`
+	Body         string `json:"body"`
+}
+
+type PromptRolloutItemDTO struct {
+	Version int `json:"version"`
+	Weight  int `json:"weight"`
+}
+
+type SetPromptRolloutRequestDTO struct {
+	Variants []PromptRolloutItemDTO `json:"variants"`
+}
+
+type PreviewPromptRequestDTO struct {
+	Version *int                   `json:"version,omitempty"`
+	Body    string                 `json:"body,omitempty"`
+	Sample  map[string]interface{} `json:"sample"`
+}
+
+type PreviewPromptResponseDTO struct {
+	Name         string `json:"name"`
+	Version      *int   `json:"version,omitempty"`
+	SystemPrompt string `json:"system_prompt"`
+	Prompt       string `json:"prompt"`
+}
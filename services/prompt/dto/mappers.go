@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+func PromptTemplateToDTO(tmpl *entities.PromptTemplate) PromptTemplateDTO {
+	dto := PromptTemplateDTO{
+		ID:           tmpl.ID.String(),
+		Name:         tmpl.Name,
+		Version:      tmpl.Version,
+		SystemPrompt: tmpl.SystemPrompt,
+		Body:         tmpl.Body,
+		Weight:       tmpl.Weight,
+		UsageCount:   tmpl.UsageCount,
+		CreatedAt:    tmpl.CreatedAt,
+	}
+
+	if tmpl.AuthorID != nil {
+		dto.AuthorID = tmpl.AuthorID.String()
+	}
+
+	return dto
+}
+
+func PromptTemplateListToDTO(templates []*entities.PromptTemplate) []PromptTemplateDTO {
+	result := make([]PromptTemplateDTO, 0, len(templates))
+	for _, tmpl := range templates {
+		result = append(result, PromptTemplateToDTO(tmpl))
+	}
+	return result
+}
+
+func PromptRolloutToDTO(templates []*entities.PromptTemplate) []PromptRolloutItemDTO {
+	result := []PromptRolloutItemDTO{}
+	for _, tmpl := range templates {
+		if tmpl.Weight > 0 {
+			result = append(result, PromptRolloutItemDTO{Version: tmpl.Version, Weight: tmpl.Weight})
+		}
+	}
+	return result
+}
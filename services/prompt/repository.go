@@ -0,0 +1,16 @@
+package prompt
+
+import (
+	"context"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type Repository interface {
+	GetVersions(ctx context.Context, name string) ([]*entities.PromptTemplate, error)
+	GetVersion(ctx context.Context, name string, version int) (*entities.PromptTemplate, error)
+	GetActive(ctx context.Context, name string) ([]*entities.PromptTemplate, error)
+	CreateVersion(ctx context.Context, tmpl *entities.PromptTemplate) (*entities.PromptTemplate, error)
+	SetWeights(ctx context.Context, name string, weights map[int]int) error
+	RecordUsage(ctx context.Context, usage entities.PromptUsage, subjectID string, outcome string) error
+}
@@ -0,0 +1,38 @@
+package repository
+
+const (
+	queryGetVersions = `
+        SELECT t.id, t.name, t.version, t.system_prompt, t.body, t.weight, t.author_id, t.created_at,
+               (SELECT COUNT(*) FROM prompt_usage u WHERE u.template_name = t.name AND u.version = t.version)
+        FROM prompt_template t
+        WHERE t.name = $1
+        ORDER BY t.version DESC`
+
+	queryGetVersion = `
+        SELECT t.id, t.name, t.version, t.system_prompt, t.body, t.weight, t.author_id, t.created_at,
+               (SELECT COUNT(*) FROM prompt_usage u WHERE u.template_name = t.name AND u.version = t.version)
+        FROM prompt_template t
+        WHERE t.name = $1 AND t.version = $2`
+
+	queryGetActive = `
+        SELECT t.id, t.name, t.version, t.system_prompt, t.body, t.weight, t.author_id, t.created_at, 0
+        FROM prompt_template t
+        WHERE t.name = $1 AND t.weight > 0
+        ORDER BY t.version`
+
+	queryCreateVersion = `
+        INSERT INTO prompt_template (name, version, system_prompt, body, author_id)
+        VALUES ($1, COALESCE((SELECT MAX(version) FROM prompt_template WHERE name = $1), 0) + 1, $2, $3, $4)
+        RETURNING id, name, version, system_prompt, body, weight, author_id, created_at, 0`
+
+	querySetWeights = `
+        UPDATE prompt_template t
+        SET weight = COALESCE(w.weight, 0)
+        FROM prompt_template p
+        LEFT JOIN unnest($2::int[], $3::int[]) AS w(version, weight) ON w.version = p.version
+        WHERE t.id = p.id AND p.name = $1`
+
+	queryRecordUsage = `
+        INSERT INTO prompt_usage (template_name, version, subject_id, outcome)
+        VALUES ($1, $2, $3, $4)`
+)
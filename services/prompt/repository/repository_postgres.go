@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/pkg/logger"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+)
+
+type PromptPostgresRepository struct {
+	db *sql.DB
+}
+
+func NewPromptPostgresRepository(db *sql.DB) prompt.Repository {
+	return &PromptPostgresRepository{db: db}
+}
+
+func (r *PromptPostgresRepository) GetVersions(ctx context.Context, name string) ([]*entities.PromptTemplate, error) {
+	const op = "PromptRepository.GetVersions"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": name,
+	})
+
+	templates, err := r.queryTemplates(ctx, logger, queryGetVersions, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("versions_count", len(templates)).Info("successfully retrieved prompt template versions")
+	return templates, nil
+}
+
+func (r *PromptPostgresRepository) GetActive(ctx context.Context, name string) ([]*entities.PromptTemplate, error) {
+	const op = "PromptRepository.GetActive"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": name,
+	})
+
+	templates, err := r.queryTemplates(ctx, logger, queryGetActive, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("versions_count", len(templates)).Debug("successfully retrieved active prompt templates")
+	return templates, nil
+}
+
+func (r *PromptPostgresRepository) GetVersion(ctx context.Context, name string, version int) (*entities.PromptTemplate, error) {
+	const op = "PromptRepository.GetVersion"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"name":    name,
+		"version": version,
+	})
+
+	tmpl, err := scanPromptTemplate(r.db.QueryRowContext(ctx, queryGetVersion, name, version))
+	if err == sql.ErrNoRows {
+		logger.Info("prompt template version not found")
+		return nil, nil
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to get prompt template version")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tmpl, nil
+}
+
+func (r *PromptPostgresRepository) CreateVersion(ctx context.Context, tmpl *entities.PromptTemplate) (*entities.PromptTemplate, error) {
+	const op = "PromptRepository.CreateVersion"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": tmpl.Name,
+	})
+
+	var authorID uuid.NullUUID
+	if tmpl.AuthorID != nil {
+		authorID = uuid.NullUUID{UUID: *tmpl.AuthorID, Valid: true}
+	}
+
+	created, err := scanPromptTemplate(r.db.QueryRowContext(ctx, queryCreateVersion,
+		tmpl.Name,
+		tmpl.SystemPrompt,
+		tmpl.Body,
+		authorID,
+	))
+	if err != nil {
+		logger.WithError(err).Error("failed to create prompt template version")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("version", created.Version).Info("successfully created prompt template version")
+	return created, nil
+}
+
+func (r *PromptPostgresRepository) SetWeights(ctx context.Context, name string, weights map[int]int) error {
+	const op = "PromptRepository.SetWeights"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"name":    name,
+		"weights": weights,
+	})
+
+	versions := make([]int64, 0, len(weights))
+	values := make([]int64, 0, len(weights))
+	for version, weight := range weights {
+		versions = append(versions, int64(version))
+		values = append(values, int64(weight))
+	}
+
+	result, err := r.db.ExecContext(ctx, querySetWeights, name, pq.Array(versions), pq.Array(values))
+	if err != nil {
+		logger.WithError(err).Error("failed to set prompt template weights")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("failed to get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("prompt template not found for rollout")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("prompt template not found"))
+	}
+
+	logger.Info("successfully updated prompt template rollout")
+	return nil
+}
+
+func (r *PromptPostgresRepository) RecordUsage(ctx context.Context, usage entities.PromptUsage, subjectID string, outcome string) error {
+	const op = "PromptRepository.RecordUsage"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"name":    usage.Name,
+		"version": usage.Version,
+	})
+
+	if _, err := r.db.ExecContext(ctx, queryRecordUsage, usage.Name, usage.Version, subjectID, outcome); err != nil {
+		logger.WithError(err).Error("failed to record prompt usage")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *PromptPostgresRepository) queryTemplates(ctx context.Context, logger logger.Logger, query string, args ...any) ([]*entities.PromptTemplate, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.WithError(err).Error("failed to query prompt templates")
+		return nil, err
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("failed to close rows")
+		}
+	}()
+
+	templates := []*entities.PromptTemplate{}
+
+	for rows.Next() {
+		tmpl, err := scanPromptTemplate(rows)
+		if err != nil {
+			logger.WithError(err).Error("failed to scan prompt template row")
+			return nil, err
+		}
+		templates = append(templates, tmpl)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("error iterating rows")
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPromptTemplate(row rowScanner) (*entities.PromptTemplate, error) {
+	tmpl := &entities.PromptTemplate{}
+	var authorID uuid.NullUUID
+
+	if err := row.Scan(
+		&tmpl.ID,
+		&tmpl.Name,
+		&tmpl.Version,
+		&tmpl.SystemPrompt,
+		&tmpl.Body,
+		&tmpl.Weight,
+		&authorID,
+		&tmpl.CreatedAt,
+		&tmpl.UsageCount,
+	); err != nil {
+		return nil, err
+	}
+
+	if authorID.Valid {
+		tmpl.AuthorID = &authorID.UUID
+	}
+
+	return tmpl, nil
+}
@@ -0,0 +1,20 @@
+package prompt
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/services/prompt/dto"
+)
+
+type Usecase interface {
+	GetAll(ctx context.Context) (*dto.PromptTemplateListResponseDTO, error)
+	GetVersions(ctx context.Context, name string) (*dto.PromptTemplateVersionsResponseDTO, error)
+	CreateVersion(ctx context.Context, authorID uuid.UUID, name string, req *dto.CreatePromptVersionRequestDTO) (*dto.PromptTemplateDTO, error)
+	SetRollout(ctx context.Context, name string, req *dto.SetPromptRolloutRequestDTO) error
+	Preview(ctx context.Context, name string, req *dto.PreviewPromptRequestDTO) (*dto.PreviewPromptResponseDTO, error)
+	Resolve(ctx context.Context, name string, subjectKey string) (*entities.PromptTemplate, error)
+	RecordUsage(ctx context.Context, usage entities.PromptUsage, subjectID string, outcome string)
+}
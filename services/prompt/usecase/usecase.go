@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+	"github.com/F0urward/proftwist-backend/services/prompt/dto"
+)
+
+const maxRolloutVariants = 2
+
+var knownPrompts = []string{
+	entities.PromptRoadmapGeneration,
+	entities.PromptRoadmapRegeneration,
+	entities.PromptNodeSuggestions,
+	entities.PromptNodeQuiz,
+	entities.PromptModeration,
+}
+
+type PromptUsecase struct {
+	repo prompt.Repository
+}
+
+func NewPromptUsecase(repo prompt.Repository) prompt.Usecase {
+	return &PromptUsecase{
+		repo: repo,
+	}
+}
+
+func (uc *PromptUsecase) GetAll(ctx context.Context) (*dto.PromptTemplateListResponseDTO, error) {
+	const op = "PromptUsecase.GetAll"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	response := &dto.PromptTemplateListResponseDTO{
+		Templates: make([]dto.PromptTemplateSummaryDTO, 0, len(knownPrompts)),
+	}
+
+	for _, name := range knownPrompts {
+		versions, err := uc.repo.GetVersions(ctx, name)
+		if err != nil {
+			logger.WithError(err).WithField("name", name).Error("failed to get prompt template versions")
+			return nil, fmt.Errorf("failed to get prompt template versions: %w", err)
+		}
+
+		response.Templates = append(response.Templates, dto.PromptTemplateSummaryDTO{
+			Name:     name,
+			Versions: len(versions),
+			Rollout:  dto.PromptRolloutToDTO(versions),
+		})
+	}
+
+	return response, nil
+}
+
+func (uc *PromptUsecase) GetVersions(ctx context.Context, name string) (*dto.PromptTemplateVersionsResponseDTO, error) {
+	const op = "PromptUsecase.GetVersions"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": name,
+	})
+
+	if !isKnownPrompt(name) {
+		logger.Warn("unknown prompt template name")
+		return nil, errs.ErrNotFound
+	}
+
+	versions, err := uc.repo.GetVersions(ctx, name)
+	if err != nil {
+		logger.WithError(err).Error("failed to get prompt template versions")
+		return nil, fmt.Errorf("failed to get prompt template versions: %w", err)
+	}
+
+	return &dto.PromptTemplateVersionsResponseDTO{
+		Name:     name,
+		Versions: dto.PromptTemplateListToDTO(versions),
+	}, nil
+}
+
+func (uc *PromptUsecase) CreateVersion(ctx context.Context, authorID uuid.UUID, name string, req *dto.CreatePromptVersionRequestDTO) (*dto.PromptTemplateDTO, error) {
+	const op = "PromptUsecase.CreateVersion"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":        op,
+		"name":      name,
+		"author_id": authorID.String(),
+	})
+
+	if !isKnownPrompt(name) {
+		logger.Warn("unknown prompt template name")
+		return nil, errs.ErrNotFound
+	}
+
+	if strings.TrimSpace(req.Body) == "" {
+		logger.Warn("empty prompt template body")
+		return nil, fmt.Errorf("invalid template: body is required")
+	}
+
+	if _, err := parseTemplate(name, req.Body); err != nil {
+		logger.WithError(err).Warn("failed to parse prompt template")
+		return nil, err
+	}
+
+	created, err := uc.repo.CreateVersion(ctx, &entities.PromptTemplate{
+		Name:         name,
+		SystemPrompt: req.SystemPrompt,
+		Body:         req.Body,
+		AuthorID:     &authorID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to create prompt template version")
+		return nil, fmt.Errorf("failed to create prompt template version: %w", err)
+	}
+
+	logger.WithField("version", created.Version).Info("prompt template version created")
+
+	result := dto.PromptTemplateToDTO(created)
+	return &result, nil
+}
+
+func (uc *PromptUsecase) SetRollout(ctx context.Context, name string, req *dto.SetPromptRolloutRequestDTO) error {
+	const op = "PromptUsecase.SetRollout"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": name,
+	})
+
+	if !isKnownPrompt(name) {
+		logger.Warn("unknown prompt template name")
+		return errs.ErrNotFound
+	}
+
+	if len(req.Variants) > maxRolloutVariants {
+		return fmt.Errorf("invalid rollout: at most %d versions can be active", maxRolloutVariants)
+	}
+
+	weights := make(map[int]int, len(req.Variants))
+	total := 0
+	for _, variant := range req.Variants {
+		if variant.Weight <= 0 || variant.Weight > 100 {
+			return fmt.Errorf("invalid rollout: weight of version %d must be between 1 and 100", variant.Version)
+		}
+		if _, duplicate := weights[variant.Version]; duplicate {
+			return fmt.Errorf("invalid rollout: version %d is listed twice", variant.Version)
+		}
+
+		tmpl, err := uc.repo.GetVersion(ctx, name, variant.Version)
+		if err != nil {
+			logger.WithError(err).Error("failed to get prompt template version")
+			return fmt.Errorf("failed to get prompt template version: %w", err)
+		}
+		if tmpl == nil {
+			logger.WithField("version", variant.Version).Warn("prompt template version not found")
+			return fmt.Errorf("prompt template version %d not found", variant.Version)
+		}
+
+		weights[variant.Version] = variant.Weight
+		total += variant.Weight
+	}
+
+	if len(weights) > 0 && total != 100 {
+		return fmt.Errorf("invalid rollout: weights must sum up to 100, got %d", total)
+	}
+
+	if err := uc.repo.SetWeights(ctx, name, weights); err != nil {
+		if errs.IsNotFoundError(err) && len(weights) == 0 {
+			return nil
+		}
+		logger.WithError(err).Error("failed to set prompt template rollout")
+		return fmt.Errorf("failed to set prompt template rollout: %w", err)
+	}
+
+	logger.WithField("weights", weights).Info("prompt template rollout updated")
+	return nil
+}
+
+func (uc *PromptUsecase) Preview(ctx context.Context, name string, req *dto.PreviewPromptRequestDTO) (*dto.PreviewPromptResponseDTO, error) {
+	const op = "PromptUsecase.Preview"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": name,
+	})
+
+	if !isKnownPrompt(name) {
+		logger.Warn("unknown prompt template name")
+		return nil, errs.ErrNotFound
+	}
+
+	response := &dto.PreviewPromptResponseDTO{Name: name}
+	body := req.Body
+
+	if body == "" {
+		if req.Version == nil {
+			return nil, fmt.Errorf("invalid preview: either body or version is required")
+		}
+
+		tmpl, err := uc.repo.GetVersion(ctx, name, *req.Version)
+		if err != nil {
+			logger.WithError(err).Error("failed to get prompt template version")
+			return nil, fmt.Errorf("failed to get prompt template version: %w", err)
+		}
+		if tmpl == nil {
+			logger.WithField("version", *req.Version).Warn("prompt template version not found")
+			return nil, errs.ErrNotFound
+		}
+
+		body = tmpl.Body
+		response.Version = &tmpl.Version
+		response.SystemPrompt = tmpl.SystemPrompt
+	}
+
+	tmpl, err := parseTemplate(name, body)
+	if err != nil {
+		logger.WithError(err).Warn("failed to parse prompt template")
+		return nil, err
+	}
+
+	buf := &strings.Builder{}
+	if err := tmpl.Option("missingkey=error").Execute(buf, req.Sample); err != nil {
+		logger.WithError(err).Warn("failed to execute prompt template against sample")
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+
+	response.Prompt = buf.String()
+	return response, nil
+}
+
+// Resolve picks the template version to use for a single request. When two
+// versions are rolled out, the subject key decides which one is used, so the
+// same roadmap keeps getting the same variant.
+func (uc *PromptUsecase) Resolve(ctx context.Context, name string, subjectKey string) (*entities.PromptTemplate, error) {
+	const op = "PromptUsecase.Resolve"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":   op,
+		"name": name,
+	})
+
+	active, err := uc.repo.GetActive(ctx, name)
+	if err != nil {
+		logger.WithError(err).Error("failed to get active prompt templates")
+		return nil, fmt.Errorf("failed to get active prompt templates: %w", err)
+	}
+
+	if len(active) == 0 {
+		return nil, nil
+	}
+
+	total := 0
+	for _, tmpl := range active {
+		total += tmpl.Weight
+	}
+
+	bucket := rand.Intn(total)
+	if subjectKey != "" {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(name + ":" + subjectKey))
+		bucket = int(h.Sum32() % uint32(total))
+	}
+
+	for _, tmpl := range active {
+		if bucket < tmpl.Weight {
+			return tmpl, nil
+		}
+		bucket -= tmpl.Weight
+	}
+
+	return active[len(active)-1], nil
+}
+
+func (uc *PromptUsecase) RecordUsage(ctx context.Context, usage entities.PromptUsage, subjectID string, outcome string) {
+	const op = "PromptUsecase.RecordUsage"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"name":    usage.Name,
+		"version": usage.Version,
+	})
+
+	if err := uc.repo.RecordUsage(ctx, usage, subjectID, outcome); err != nil {
+		logger.WithError(err).Warn("failed to record prompt usage")
+	}
+}
+
+func isKnownPrompt(name string) bool {
+	for _, known := range knownPrompts {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTemplate(name string, body string) (*template.Template, error) {
+	tmpl, err := template.New(name).Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,18 @@
+package roadmap
+
+import (
+	"context"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type GamificationPublisher interface {
+	NodeCompleted(ctx context.Context, userID, roadmapID, nodeID string) error
+	QuizPassed(ctx context.Context, userID, roadmapID, nodeID string) error
+	RoadmapCompleted(ctx context.Context, userID, roadmapID string) error
+	MaterialAdded(ctx context.Context, userID, roadmapID, materialID string) error
+}
+
+type NotificationPublisher interface {
+	NotifyGoalReminder(ctx context.Context, goal *entities.LearningGoal, roadmapName string, projection entities.LearningGoalProjection) error
+}
@@ -0,0 +1,69 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	gamificationDTO "github.com/F0urward/proftwist-backend/services/gamification/dto"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type GamificationPublisher struct {
+	producer broker.Producer
+}
+
+func NewGamificationPublisher(producer broker.Producer) roadmap.GamificationPublisher {
+	return &GamificationPublisher{producer: producer}
+}
+
+// Node, quiz and roadmap completions use event IDs derived from the user and
+// the subject, so that undoing and redoing progress is only rewarded once.
+
+func (k *GamificationPublisher) NodeCompleted(ctx context.Context, userID, roadmapID, nodeID string) error {
+	return k.publish(ctx, gamificationDTO.ActivityEvent{
+		Type:      gamificationDTO.NodeCompletedType,
+		EventID:   fmt.Sprintf("%s:%s:%s:%s", gamificationDTO.NodeCompletedType, userID, roadmapID, nodeID),
+		UserID:    userID,
+		SubjectID: nodeID,
+	})
+}
+
+func (k *GamificationPublisher) QuizPassed(ctx context.Context, userID, roadmapID, nodeID string) error {
+	return k.publish(ctx, gamificationDTO.ActivityEvent{
+		Type:      gamificationDTO.QuizPassedType,
+		EventID:   fmt.Sprintf("%s:%s:%s:%s", gamificationDTO.QuizPassedType, userID, roadmapID, nodeID),
+		UserID:    userID,
+		SubjectID: nodeID,
+	})
+}
+
+func (k *GamificationPublisher) RoadmapCompleted(ctx context.Context, userID, roadmapID string) error {
+	return k.publish(ctx, gamificationDTO.ActivityEvent{
+		Type:      gamificationDTO.RoadmapCompletedType,
+		EventID:   fmt.Sprintf("%s:%s:%s", gamificationDTO.RoadmapCompletedType, userID, roadmapID),
+		UserID:    userID,
+		SubjectID: roadmapID,
+	})
+}
+
+func (k *GamificationPublisher) MaterialAdded(ctx context.Context, userID, roadmapID, materialID string) error {
+	return k.publish(ctx, gamificationDTO.ActivityEvent{
+		Type:      gamificationDTO.MaterialAddedType,
+		EventID:   fmt.Sprintf("%s:%s", gamificationDTO.MaterialAddedType, materialID),
+		UserID:    userID,
+		SubjectID: roadmapID,
+	})
+}
+
+func (k *GamificationPublisher) publish(ctx context.Context, event gamificationDTO.ActivityEvent) error {
+	event.OccurredAt = time.Now()
+
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return k.producer.Publish(ctx, event.UserID, data)
+}
@@ -0,0 +1,44 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	notificationDTO "github.com/F0urward/proftwist-backend/services/notification/dto"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type NotificationPublisher struct {
+	producer broker.Producer
+}
+
+func NewNotificationPublisher(producer broker.Producer) roadmap.NotificationPublisher {
+	return &NotificationPublisher{producer: producer}
+}
+
+func (k *NotificationPublisher) NotifyGoalReminder(ctx context.Context, goal *entities.LearningGoal, roadmapName string, projection entities.LearningGoalProjection) error {
+	userID := goal.UserID.String()
+
+	event := notificationDTO.GoalReminderEvent{
+		Type:                  notificationDTO.GoalReminderType,
+		UserIDs:               []string{userID},
+		RoadmapID:             goal.RoadmapID.Hex(),
+		RoadmapName:           roadmapName,
+		Status:                string(projection.Status),
+		Deadline:              goal.Deadline,
+		RemainingNodes:        projection.RemainingNodes,
+		ActualPace:            projection.ActualPace,
+		RequiredPace:          projection.RequiredPace,
+		ProjectedCompletionAt: projection.ProjectedCompletionAt,
+		SentAt:                time.Now(),
+	}
+
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return k.producer.Publish(ctx, userID, data)
+}
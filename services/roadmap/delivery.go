@@ -1,13 +1,58 @@
 package roadmap
 
-import "net/http"
+import (
+	"net/http"
+
+	websocket "github.com/F0urward/proftwist-backend/internal/server/ws"
+	"github.com/F0urward/proftwist-backend/internal/server/ws/dto"
+)
 
 type Handlers interface {
 	GetByIDWithProgress(w http.ResponseWriter, r *http.Request)
 	Update(w http.ResponseWriter, r *http.Request)
 	Generate(w http.ResponseWriter, r *http.Request)
+	RegenerateNode(w http.ResponseWriter, r *http.Request)
+	SuggestNodeContent(w http.ResponseWriter, r *http.Request)
 	CreateMaterial(w http.ResponseWriter, r *http.Request)
+	UploadMaterial(w http.ResponseWriter, r *http.Request)
 	DeleteMaterial(w http.ResponseWriter, r *http.Request)
 	GetMaterialsByNode(w http.ResponseWriter, r *http.Request)
+	VoteMaterial(w http.ResponseWriter, r *http.Request)
+	DeleteMaterialVote(w http.ResponseWriter, r *http.Request)
 	UpdateNodeProgress(w http.ResponseWriter, r *http.Request)
+	GetProgressTimeline(w http.ResponseWriter, r *http.Request)
+	GetProgressSummary(w http.ResponseWriter, r *http.Request)
+	MigrateProgress(w http.ResponseWriter, r *http.Request)
+	GetMyLearning(w http.ResponseWriter, r *http.Request)
+	GetLearningOrder(w http.ResponseWriter, r *http.Request)
+	GetBrokenLinks(w http.ResponseWriter, r *http.Request)
+	UpsertNodeNote(w http.ResponseWriter, r *http.Request)
+	DeleteNodeNote(w http.ResponseWriter, r *http.Request)
+	SearchNodeNotes(w http.ResponseWriter, r *http.Request)
+	ExportRoadmap(w http.ResponseWriter, r *http.Request)
+	IssueCertificate(w http.ResponseWriter, r *http.Request)
+	GetMyCertificates(w http.ResponseWriter, r *http.Request)
+	VerifyCertificate(w http.ResponseWriter, r *http.Request)
+	GetNodeQuiz(w http.ResponseWriter, r *http.Request)
+	UpsertNodeQuiz(w http.ResponseWriter, r *http.Request)
+	DeleteNodeQuiz(w http.ResponseWriter, r *http.Request)
+	GenerateNodeQuiz(w http.ResponseWriter, r *http.Request)
+	SubmitQuizAttempt(w http.ResponseWriter, r *http.Request)
+	GetQuizAttempts(w http.ResponseWriter, r *http.Request)
+	UpsertLearningGoal(w http.ResponseWriter, r *http.Request)
+	GetLearningGoal(w http.ResponseWriter, r *http.Request)
+	DeleteLearningGoal(w http.ResponseWriter, r *http.Request)
+	GetMyLearningGoals(w http.ResponseWriter, r *http.Request)
+	GetCalendarFeed(w http.ResponseWriter, r *http.Request)
+	RotateCalendarFeedToken(w http.ResponseWriter, r *http.Request)
+	DeleteCalendarFeed(w http.ResponseWriter, r *http.Request)
+	ExportCalendarFeed(w http.ResponseWriter, r *http.Request)
+}
+
+type WSHandlers interface {
+	HandleJoin(client *websocket.WsClient, msg dto.WebSocketMessage) error
+	HandleLeave(client *websocket.WsClient, msg dto.WebSocketMessage) error
+	HandleCursor(client *websocket.WsClient, msg dto.WebSocketMessage) error
+	HandleOperation(client *websocket.WsClient, msg dto.WebSocketMessage) error
+	HandleDisconnect(client *websocket.WsClient)
 }
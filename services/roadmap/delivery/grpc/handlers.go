@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
 	"github.com/F0urward/proftwist-backend/services/roadmap"
 	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
@@ -97,7 +98,23 @@ func (s *RoadmapServer) RegenerateNodeIDs(ctx context.Context, req *roadmapclien
 		}, nil
 	}
 
-	regeneratedRoadmap := s.uc.RegenerateNodeIDs(roadmapDTO)
+	regeneratedRoadmap, nodeIDMap := s.uc.RegenerateNodeIDs(roadmapDTO)
+
+	if req.TargetRoadmapId != "" {
+		targetRoadmapID, err := primitive.ObjectIDFromHex(req.TargetRoadmapId)
+		if err != nil {
+			return &roadmapclient.RegenerateNodeIDsResponse{
+				Error: "invalid target roadmap id format",
+			}, nil
+		}
+
+		err = s.uc.RecordRoadmapLineage(ctx, roadmapDTO.ID, targetRoadmapID, entities.RoadmapLineageKind(req.LineageKind), nodeIDMap)
+		if err != nil {
+			return &roadmapclient.RegenerateNodeIDsResponse{
+				Error: err.Error(),
+			}, nil
+		}
+	}
 
 	protoRoadmap := s.convertRoadmapWithMaterialsToProto(regeneratedRoadmap)
 
@@ -106,6 +123,91 @@ func (s *RoadmapServer) RegenerateNodeIDs(ctx context.Context, req *roadmapclien
 	}, nil
 }
 
+func (s *RoadmapServer) MigrateProgress(ctx context.Context, req *roadmapclient.MigrateProgressRequest) (*roadmapclient.MigrateProgressResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &roadmapclient.MigrateProgressResponse{
+			Error: "invalid user id format",
+		}, nil
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(req.RoadmapId)
+	if err != nil {
+		return &roadmapclient.MigrateProgressResponse{
+			Error: "invalid roadmap id format",
+		}, nil
+	}
+
+	migration, err := s.uc.MigrateProgress(ctx, userID, roadmapID)
+	if err != nil {
+		return &roadmapclient.MigrateProgressResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &roadmapclient.MigrateProgressResponse{
+		MigratedNodes: int32(migration.MigratedNodes),
+	}, nil
+}
+
+func (s *RoadmapServer) ApplyDraft(ctx context.Context, req *roadmapclient.ApplyDraftRequest) (*roadmapclient.ApplyDraftResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &roadmapclient.ApplyDraftResponse{
+			Error: "invalid user id format",
+		}, nil
+	}
+
+	draftRoadmapID, err := primitive.ObjectIDFromHex(req.DraftRoadmapId)
+	if err != nil {
+		return &roadmapclient.ApplyDraftResponse{
+			Error: "invalid draft roadmap id format",
+		}, nil
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(req.RoadmapId)
+	if err != nil {
+		return &roadmapclient.ApplyDraftResponse{
+			Error: "invalid roadmap id format",
+		}, nil
+	}
+
+	addedNodes, removedNodes, err := s.uc.ApplyDraft(ctx, userID, draftRoadmapID, roadmapID)
+	if err != nil {
+		return &roadmapclient.ApplyDraftResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &roadmapclient.ApplyDraftResponse{
+		AddedNodes:   int32(addedNodes),
+		RemovedNodes: int32(removedNodes),
+	}, nil
+}
+
+func (s *RoadmapServer) ListRoadmapIDs(ctx context.Context, req *roadmapclient.ListRoadmapIDsRequest) (*roadmapclient.ListRoadmapIDsResponse, error) {
+	createdBefore := time.Now()
+	if req.CreatedBefore != nil {
+		createdBefore = req.CreatedBefore.AsTime()
+	}
+
+	ids, err := s.uc.ListIDs(ctx, createdBefore)
+	if err != nil {
+		return &roadmapclient.ListRoadmapIDsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	protoIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		protoIDs = append(protoIDs, id.Hex())
+	}
+
+	return &roadmapclient.ListRoadmapIDsResponse{
+		Ids: protoIDs,
+	}, nil
+}
+
 func (s *RoadmapServer) convertProtoRoadmapWithMaterialsToDTO(protoRoadmap *roadmapclient.RoadmapWithMaterials) (*dto.RoadmapWithMaterialsDTO, error) {
 	if protoRoadmap == nil {
 		return nil, fmt.Errorf("roadmap is nil")
@@ -134,9 +236,10 @@ func (s *RoadmapServer) convertProtoRoadmapWithMaterialsToDTO(protoRoadmap *road
 
 	for _, protoEdge := range protoRoadmap.Edges {
 		roadmapDTO.Edges = append(roadmapDTO.Edges, dto.EdgeDTO{
-			Source: protoEdge.Source,
-			Target: protoEdge.Target,
-			ID:     protoEdge.Id,
+			Source:       protoEdge.Source,
+			Target:       protoEdge.Target,
+			ID:           protoEdge.Id,
+			Prerequisite: protoEdge.Prerequisite,
 		})
 	}
 
@@ -149,6 +252,14 @@ func (s *RoadmapServer) convertCreateRequestToDTO(req *roadmapclient.CreateReque
 		UpdatedAt: time.Now(),
 	}
 
+	if req.Id != "" {
+		roadmapID, err := primitive.ObjectIDFromHex(req.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid roadmap id format")
+		}
+		roadmapDTO.ID = roadmapID
+	}
+
 	for _, protoNode := range req.Nodes {
 		node, err := s.convertProtoNodeWithMaterialsToDTO(protoNode)
 		if err != nil {
@@ -159,9 +270,10 @@ func (s *RoadmapServer) convertCreateRequestToDTO(req *roadmapclient.CreateReque
 
 	for _, protoEdge := range req.Edges {
 		roadmapDTO.Edges = append(roadmapDTO.Edges, dto.EdgeDTO{
-			Source: protoEdge.Source,
-			Target: protoEdge.Target,
-			ID:     protoEdge.Id,
+			Source:       protoEdge.Source,
+			Target:       protoEdge.Target,
+			ID:           protoEdge.Id,
+			Prerequisite: protoEdge.Prerequisite,
 		})
 	}
 
@@ -237,9 +349,10 @@ func (s *RoadmapServer) convertRoadmapWithMaterialsToProto(roadmap *dto.RoadmapW
 
 	for _, edge := range roadmap.Edges {
 		protoRoadmap.Edges = append(protoRoadmap.Edges, &roadmapclient.Edge{
-			Source: edge.Source,
-			Target: edge.Target,
-			Id:     edge.ID,
+			Source:       edge.Source,
+			Target:       edge.Target,
+			Id:           edge.ID,
+			Prerequisite: edge.Prerequisite,
 		})
 	}
 
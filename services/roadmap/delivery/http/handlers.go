@@ -1,27 +1,47 @@
 package http
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/internal/entities/errs"
 	"github.com/F0urward/proftwist-backend/internal/utils"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/pkg/document"
+	"github.com/F0urward/proftwist-backend/pkg/ical"
 	"github.com/F0urward/proftwist-backend/services/roadmap"
 	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
 	"github.com/google/uuid"
 )
 
 type RoadmapHandlers struct {
-	uc roadmap.Usecase
+	uc  roadmap.Usecase
+	cfg *config.Config
 }
 
-func NewRoadmapHandlers(roadmapUC roadmap.Usecase) roadmap.Handlers {
+// materialFormOverhead leaves room for the multipart boundaries and the
+// metadata fields sent along with the uploaded file.
+const materialFormOverhead = 1 << 20
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+func NewRoadmapHandlers(roadmapUC roadmap.Usecase, cfg *config.Config) roadmap.Handlers {
 	return &RoadmapHandlers{
-		uc: roadmapUC,
+		uc:  roadmapUC,
+		cfg: cfg,
 	}
 }
 
@@ -55,7 +75,7 @@ func (h *RoadmapHandlers) GetByIDWithProgress(w http.ResponseWriter, r *http.Req
 		}
 	}
 
-	res, err := h.uc.GetByIDWithProgress(r.Context(), roadmapID, userID)
+	res, err := h.uc.GetByIDWithProgress(r.Context(), roadmapID, userID, preferredLanguage(r))
 	if err != nil {
 		logger.WithError(err).Error("failed to get roadmap by ID")
 
@@ -221,6 +241,181 @@ func (h *RoadmapHandlers) Generate(w http.ResponseWriter, r *http.Request) {
 	utils.JSONResponse(r.Context(), w, http.StatusOK, response)
 }
 
+func (h *RoadmapHandlers) RegenerateNode(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.RegenerateNode"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	if roadmapIDStr == "" {
+		logger.Warn("roadmap_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_id parameter is required")
+		return
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	if nodeIDStr == "" {
+		logger.Warn("node_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "node_id parameter is required")
+		return
+	}
+
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	var req dto.RegenerateNodeRequestDTO
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.RegenerateNode(r.Context(), userID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to regenerate node")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to regenerate node"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap or node not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this roadmap"
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"added_nodes":   res.AddedNodes,
+		"removed_nodes": res.RemovedNodes,
+	}).Info("successfully regenerated node")
+
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) SuggestNodeContent(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.SuggestNodeContent"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	if roadmapIDStr == "" {
+		logger.Warn("roadmap_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_id parameter is required")
+		return
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	if nodeIDStr == "" {
+		logger.Warn("node_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "node_id parameter is required")
+		return
+	}
+
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	var req dto.SuggestNodeContentRequestDTO
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.SuggestNodeContent(r.Context(), userID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to suggest node content")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to suggest node content"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap or node not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this roadmap"
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("materials_count", len(res.Materials)).Info("successfully suggested node content")
+
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
 func (h *RoadmapHandlers) CreateMaterial(w http.ResponseWriter, r *http.Request) {
 	const op = "RoadmapHandlers.CreateMaterial"
 	ctx := r.Context()
@@ -306,6 +501,150 @@ func (h *RoadmapHandlers) CreateMaterial(w http.ResponseWriter, r *http.Request)
 	utils.JSONResponse(ctx, w, http.StatusCreated, material)
 }
 
+func (h *RoadmapHandlers) UploadMaterial(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.UploadMaterial"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	if roadmapIDStr == "" {
+		logger.Warn("roadmap_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "roadmap_id parameter is required")
+		return
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	if nodeIDStr == "" {
+		logger.Warn("node_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "node_id parameter is required")
+		return
+	}
+
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	maxSize := h.cfg.Upload.Material.MaxSize
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+materialFormOverhead)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		logger.WithError(err).Warn("failed to get material file from form")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "material file is required")
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.WithError(err).Warn("failed to close material file")
+		}
+	}()
+
+	if header.Size > maxSize {
+		logger.WithFields(map[string]interface{}{
+			"file_size": header.Size,
+			"max_size":  maxSize,
+		}).Warn("material file too large")
+		utils.JSONError(ctx, w, http.StatusBadRequest,
+			fmt.Sprintf("material file too large. Maximum size is %d MB", maxSize/(1024*1024)))
+		return
+	}
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		logger.WithError(err).Warn("failed to read file data")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	contentType, err := document.CheckDocumentFileContentType(fileData, header.Filename)
+	if err != nil {
+		logger.WithError(err).Warn("invalid material content type")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid file format, allowed formats: pdf, pptx, docx, odp, odt")
+		return
+	}
+
+	durationMinutes := 0
+	if durationStr := r.FormValue("duration_minutes"); durationStr != "" {
+		durationMinutes, err = strconv.Atoi(durationStr)
+		if err != nil {
+			logger.WithError(err).Warn("invalid duration_minutes format")
+			utils.JSONError(ctx, w, http.StatusBadRequest, "invalid duration_minutes format")
+			return
+		}
+	}
+
+	req := dto.UploadMaterialRequestDTO{
+		File:            bytes.NewReader(fileData),
+		FileName:        header.Filename,
+		Size:            header.Size,
+		ContentType:     contentType,
+		BucketName:      h.cfg.AWS.MaterialBucketName,
+		Name:            r.FormValue("name"),
+		DurationMinutes: durationMinutes,
+		Language:        r.FormValue("language"),
+		Difficulty:      entities.MaterialDifficulty(r.FormValue("difficulty")),
+		IsPaid:          r.FormValue("is_paid") == "true",
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_id":   roadmapID.Hex(),
+		"node_id":      nodeID,
+		"filename":     header.Filename,
+		"size":         header.Size,
+		"content_type": contentType,
+	})
+
+	material, err := h.uc.UploadMaterial(ctx, userUUID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to upload material")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to upload material"
+
+		if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		} else if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap or node not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("material_id", material.ID).Info("successfully uploaded material")
+	utils.JSONResponse(ctx, w, http.StatusCreated, material)
+}
+
 func (h *RoadmapHandlers) DeleteMaterial(w http.ResponseWriter, r *http.Request) {
 	const op = "RoadmapHandlers.DeleteMaterial"
 	ctx := r.Context()
@@ -427,7 +766,14 @@ func (h *RoadmapHandlers) GetMaterialsByNode(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	materials, err := h.uc.GetMaterialsByNode(ctx, roadmapID, nodeID)
+	userID := uuid.Nil
+	if userIDStr, ok := ctx.Value(utils.UserIDKey{}).(string); ok && userIDStr != "" {
+		if parsedID, err := uuid.Parse(userIDStr); err == nil {
+			userID = parsedID
+		}
+	}
+
+	materials, err := h.uc.GetMaterialsByNode(ctx, userID, roadmapID, nodeID)
 	if err != nil {
 		logger.WithError(err).Error("failed to get materials by node")
 		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to get materials")
@@ -442,22 +788,192 @@ func (h *RoadmapHandlers) GetMaterialsByNode(w http.ResponseWriter, r *http.Requ
 	utils.JSONResponse(ctx, w, http.StatusOK, materials)
 }
 
-func (h *RoadmapHandlers) UpdateNodeProgress(w http.ResponseWriter, r *http.Request) {
-	const op = "RoadmapHandlers.UpdateNodeProgress"
-	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+func (h *RoadmapHandlers) VoteMaterial(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.VoteMaterial"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
 
 	vars := mux.Vars(r)
 	roadmapIDStr := vars["roadmap_id"]
 	if roadmapIDStr == "" {
 		logger.Warn("roadmap_id parameter is required")
-		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "roadmap_id parameter is required")
 		return
 	}
 
 	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
 	if err != nil {
 		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
-		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	if nodeIDStr == "" {
+		logger.Warn("node_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "node_id parameter is required")
+		return
+	}
+
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	materialIDStr := vars["material_id"]
+	materialID, err := uuid.Parse(materialIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("material_id", materialIDStr).Warn("invalid material ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid material ID")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req dto.VoteMaterialRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.VoteMaterial(ctx, userUUID, roadmapID, nodeID, materialID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to vote for material")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to vote for material"
+
+		if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		} else if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "material not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"material_id": materialID,
+		"user_id":     userUUID,
+		"vote":        req.Vote,
+		"helpful":     req.Helpful,
+	}).Info("successfully voted for material")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) DeleteMaterialVote(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.DeleteMaterialVote"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	if roadmapIDStr == "" {
+		logger.Warn("roadmap_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "roadmap_id parameter is required")
+		return
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	if nodeIDStr == "" {
+		logger.Warn("node_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "node_id parameter is required")
+		return
+	}
+
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	materialIDStr := vars["material_id"]
+	materialID, err := uuid.Parse(materialIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("material_id", materialIDStr).Warn("invalid material ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid material ID")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.DeleteMaterialVote(ctx, userUUID, roadmapID, nodeID, materialID)
+	if err != nil {
+		logger.WithError(err).Error("failed to delete material vote")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to delete material vote"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "material not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"material_id": materialID,
+		"user_id":     userUUID,
+	}).Info("successfully deleted material vote")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) UpdateNodeProgress(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.UpdateNodeProgress"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	if roadmapIDStr == "" {
+		logger.Warn("roadmap_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_id parameter is required")
+		return
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_id format")
 		return
 	}
 
@@ -510,3 +1026,1370 @@ func (h *RoadmapHandlers) UpdateNodeProgress(w http.ResponseWriter, r *http.Requ
 	logger.WithField("status", req.Status).Info("successfully updated node progress")
 	w.WriteHeader(http.StatusOK)
 }
+
+func (h *RoadmapHandlers) GetBrokenLinks(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetBrokenLinks"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	if roadmapIDStr == "" {
+		logger.Warn("roadmap_id parameter is required")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "roadmap_id parameter is required")
+		return
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.GetBrokenLinks(ctx, userUUID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get broken links")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get broken links"
+
+		if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied - only the roadmap author can view broken links"
+		} else if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"count":      res.Total,
+	}).Info("successfully retrieved broken links")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) UpsertNodeNote(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.UpsertNodeNote"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req dto.UpsertNodeNoteRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.UpsertNodeNote(ctx, userUUID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to save node note")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to save node note"
+
+		if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		} else if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap or node not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	}).Info("successfully saved node note")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) DeleteNodeNote(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.DeleteNodeNote"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	if err := h.uc.DeleteNodeNote(ctx, userUUID, roadmapID, nodeID); err != nil {
+		logger.WithError(err).Error("failed to delete node note")
+		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to delete node note")
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	}).Info("successfully deleted node note")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapHandlers) SearchNodeNotes(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.SearchNodeNotes"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	limit, offset := parsePagination(r)
+
+	res, err := h.uc.SearchNodeNotes(ctx, userUUID, r.URL.Query().Get("q"), limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to search node notes")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to search notes"
+
+		if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("count", len(res.Notes)).Info("successfully searched node notes")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) ExportRoadmap(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.ExportRoadmap"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.ExportRoadmap(ctx, userUUID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to export roadmap")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to export roadmap"
+
+		if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied - roadmap is private"
+		} else if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(res.Content); err != nil {
+		logger.WithError(err).Warn("failed to write export response")
+		return
+	}
+
+	logger.WithField("roadmap_id", roadmapID.Hex()).Info("successfully exported roadmap")
+}
+
+func (h *RoadmapHandlers) GetProgressTimeline(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetProgressTimeline"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	limit, offset := parsePagination(r)
+
+	res, err := h.uc.GetProgressTimeline(ctx, userUUID, roadmapID, preferredLanguage(r), limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get progress timeline")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get progress timeline"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"count":      len(res.Events),
+	}).Info("successfully retrieved progress timeline")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetProgressSummary(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetProgressSummary"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.GetProgressSummary(ctx, userUUID, roadmapID, preferredLanguage(r))
+	if err != nil {
+		logger.WithError(err).Error("failed to get progress summary")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get progress summary"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"completion": res.CompletionPercent,
+	}).Info("successfully retrieved progress summary")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetMyLearning(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetMyLearning"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	limit, offset := parsePagination(r)
+
+	res, err := h.uc.GetMyLearning(ctx, userUUID, preferredLanguage(r), limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get my learning")
+		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to get learning roadmaps")
+		return
+	}
+
+	logger.WithField("count", len(res.Roadmaps)).Info("successfully retrieved my learning")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetLearningOrder(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetLearningOrder"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	var userID uuid.UUID
+	if userIDStr, ok := ctx.Value(utils.UserIDKey{}).(string); ok && userIDStr != "" {
+		if parsedID, err := uuid.Parse(userIDStr); err == nil {
+			userID = parsedID
+		}
+	}
+
+	res, err := h.uc.GetLearningOrder(ctx, userID, roadmapID, preferredLanguage(r))
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning order")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get learning order"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id":  roadmapID.Hex(),
+		"nodes_count": len(res.Nodes),
+	}).Info("successfully retrieved learning order")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) IssueCertificate(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.IssueCertificate"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.IssueCertificate(ctx, userUUID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to issue certificate")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to issue certificate"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("certificate_id", res.ID.String()).Info("successfully issued certificate")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetMyCertificates(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetMyCertificates"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.GetMyCertificates(ctx, userUUID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get certificates")
+		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to get certificates")
+		return
+	}
+
+	logger.WithField("count", len(res.Certificates)).Info("successfully retrieved certificates")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) VerifyCertificate(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.VerifyCertificate"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	certificateIDStr := mux.Vars(r)["certificate_id"]
+	certificateID, err := uuid.Parse(certificateIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("certificate_id", certificateIDStr).Warn("invalid certificate_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid certificate_id format")
+		return
+	}
+
+	res, err := h.uc.VerifyCertificate(ctx, certificateID, r.URL.Query().Get("signature"))
+	if err != nil {
+		logger.WithError(err).Error("failed to verify certificate")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to verify certificate"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "certificate not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"certificate_id": certificateID.String(),
+		"valid":          res.Valid,
+	}).Info("successfully verified certificate")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetNodeQuiz(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetNodeQuiz"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	var userUUID uuid.UUID
+	if userIDStr, ok := ctx.Value(utils.UserIDKey{}).(string); ok && userIDStr != "" {
+		if parsedID, err := uuid.Parse(userIDStr); err == nil {
+			userUUID = parsedID
+		}
+	}
+
+	res, err := h.uc.GetNodeQuiz(ctx, userUUID, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get node quiz")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get node quiz"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "quiz not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	}).Info("successfully retrieved node quiz")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) UpsertNodeQuiz(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.UpsertNodeQuiz"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req dto.UpsertNodeQuizRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.UpsertNodeQuiz(ctx, userUUID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to save node quiz")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to save node quiz"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap or node not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	}).Info("successfully saved node quiz")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) DeleteNodeQuiz(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.DeleteNodeQuiz"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	err = h.uc.DeleteNodeQuiz(ctx, userUUID, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Error("failed to delete node quiz")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to delete node quiz"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "quiz not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	}).Info("successfully deleted node quiz")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapHandlers) GenerateNodeQuiz(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GenerateNodeQuiz"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req dto.GenerateNodeQuizRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.GenerateNodeQuiz(ctx, userUUID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to generate node quiz")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to generate node quiz"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap or node not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	}).Info("successfully generated node quiz")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) SubmitQuizAttempt(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.SubmitQuizAttempt"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req dto.SubmitQuizAttemptRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.SubmitQuizAttempt(ctx, userUUID, roadmapID, nodeID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to submit quiz attempt")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to submit quiz attempt"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "quiz not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+		"score":      res.Attempt.Score,
+	}).Info("successfully submitted quiz attempt")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetQuizAttempts(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetQuizAttempts"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapIDStr := vars["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	nodeIDStr := vars["node_id"]
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("node_id", nodeIDStr).Warn("invalid node ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid node ID format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	limit, offset := parsePagination(r)
+
+	res, err := h.uc.GetQuizAttempts(ctx, userUUID, roadmapID, nodeID, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get quiz attempts")
+		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to get quiz attempts")
+		return
+	}
+
+	logger.WithField("count", len(res.Attempts)).Info("successfully retrieved quiz attempts")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func parsePagination(r *http.Request) (int, int) {
+	limit := defaultPageLimit
+	offset := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= maxPageLimit {
+			limit = l
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	return limit, offset
+}
+
+// preferredLanguage picks the label language from the lang query parameter or
+// the first supported Accept-Language entry.
+func preferredLanguage(r *http.Request) string {
+	candidates := []string{r.URL.Query().Get("lang")}
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		candidates = append(candidates, part)
+	}
+
+	for _, candidate := range candidates {
+		tag, _, _ := strings.Cut(strings.TrimSpace(candidate), ";")
+		lang, _, _ := strings.Cut(tag, "-")
+		lang = strings.ToLower(lang)
+		if entities.IsSupportedLanguage(lang) {
+			return lang
+		}
+	}
+
+	return entities.DefaultLanguage
+}
+
+func (h *RoadmapHandlers) UpsertLearningGoal(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.UpsertLearningGoal"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req dto.UpsertLearningGoalRequestDTO
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.UpsertLearningGoal(ctx, userUUID, roadmapID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to save learning goal")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to save learning goal"
+
+		if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		} else if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"status":     res.Status,
+	}).Info("successfully saved learning goal")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetLearningGoal(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetLearningGoal"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.GetLearningGoal(ctx, userUUID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goal")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get learning goal"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "learning goal not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"status":     res.Status,
+	}).Info("successfully retrieved learning goal")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) DeleteLearningGoal(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.DeleteLearningGoal"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	if err := h.uc.DeleteLearningGoal(ctx, userUUID, roadmapID); err != nil {
+		logger.WithError(err).Error("failed to delete learning goal")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to delete learning goal"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "learning goal not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("roadmap_id", roadmapID.Hex()).Info("successfully deleted learning goal")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapHandlers) GetMyLearningGoals(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetMyLearningGoals"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.GetMyLearningGoals(ctx, userUUID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goals")
+		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to get learning goals")
+		return
+	}
+
+	logger.WithField("count", len(res.Goals)).Info("successfully retrieved learning goals")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.GetCalendarFeed"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.GetCalendarFeed(ctx, userUUID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get calendar feed")
+		utils.JSONError(ctx, w, http.StatusInternalServerError, "failed to get calendar feed")
+		return
+	}
+
+	logger.WithField("events", res.EventCount).Info("successfully retrieved calendar feed")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) RotateCalendarFeedToken(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.RotateCalendarFeedToken"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.RotateCalendarFeedToken(ctx, userUUID)
+	if err != nil {
+		logger.WithError(err).Error("failed to rotate calendar token")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to rotate calendar token"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "calendar feed not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully rotated calendar token")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
+
+func (h *RoadmapHandlers) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.DeleteCalendarFeed"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	if err := h.uc.DeleteCalendarFeed(ctx, userUUID); err != nil {
+		logger.WithError(err).Error("failed to delete calendar feed")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to delete calendar feed"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "calendar feed not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully deleted calendar feed")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapHandlers) ExportCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.ExportCalendarFeed"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	res, err := h.uc.ExportCalendarFeed(ctx, mux.Vars(r)["token"])
+	if err != nil {
+		logger.WithError(err).Error("failed to export calendar feed")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to export calendar feed"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "calendar feed not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", res.FileName))
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("Last-Modified", res.ModifiedAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(res.Content); err != nil {
+		logger.WithError(err).Warn("failed to write calendar feed response")
+		return
+	}
+
+	logger.Info("successfully exported calendar feed")
+}
+
+func (h *RoadmapHandlers) MigrateProgress(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapHandlers.MigrateProgress"
+	ctx := r.Context()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapIDStr := mux.Vars(r)["roadmap_id"]
+	roadmapID, err := primitive.ObjectIDFromHex(roadmapIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_id", roadmapIDStr).Warn("invalid roadmap_id format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid roadmap_id format")
+		return
+	}
+
+	userID, ok := ctx.Value(utils.UserIDKey{}).(string)
+	if !ok {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(ctx, w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid user ID format")
+		utils.JSONError(ctx, w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	res, err := h.uc.MigrateProgress(ctx, userUUID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to migrate progress")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to migrate progress"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmap lineage not found"
+		}
+
+		utils.JSONError(ctx, w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id":     roadmapID.Hex(),
+		"migrated_nodes": res.MigratedNodes,
+	}).Info("successfully migrated progress")
+	utils.JSONResponse(ctx, w, http.StatusOK, res)
+}
@@ -20,11 +20,45 @@ func NewRoadmapHttpRegistrar(handlers roadmap.Handlers) httpServer.HttpRegistrar
 func (r *RoadmapHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
 	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}", s.AuthMiddleware.OptionalAuthMiddleware(http.HandlerFunc(r.handlers.GetByIDWithProgress))).Methods("GET")
 	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Update))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/export", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.ExportRoadmap))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/broken-links", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetBrokenLinks))).Methods("GET")
 	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/generate", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Generate))).Methods("PUT")
 
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/regenerate", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.RegenerateNode))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/suggestions", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.SuggestNodeContent))).Methods("POST")
+
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/note", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpsertNodeNote))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/note", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteNodeNote))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/quiz", s.AuthMiddleware.OptionalAuthMiddleware(http.HandlerFunc(r.handlers.GetNodeQuiz))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/quiz", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpsertNodeQuiz))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/quiz", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteNodeQuiz))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/quiz/generate", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GenerateNodeQuiz))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/quiz/attempts", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.SubmitQuizAttempt))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/quiz/attempts", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetQuizAttempts))).Methods("GET")
+	s.MUX.Handle("/api/v1/learning", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetMyLearning))).Methods("GET")
+	s.MUX.Handle("/api/v1/learning/goals", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetMyLearningGoals))).Methods("GET")
+	s.MUX.Handle("/api/v1/learning/calendar", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetCalendarFeed))).Methods("GET")
+	s.MUX.Handle("/api/v1/learning/calendar", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteCalendarFeed))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/learning/calendar/rotate", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.RotateCalendarFeedToken))).Methods("POST")
+	s.MUX.Handle("/api/v1/learning/calendar/{token}.ics", http.HandlerFunc(r.handlers.ExportCalendarFeed)).Methods("GET")
+	s.MUX.Handle("/api/v1/notes", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.SearchNodeNotes))).Methods("GET")
+
 	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/progress", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpdateNodeProgress))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/learning-order", s.AuthMiddleware.OptionalAuthMiddleware(http.HandlerFunc(r.handlers.GetLearningOrder))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/progress/summary", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetProgressSummary))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/progress/timeline", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetProgressTimeline))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/progress/migrate", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.MigrateProgress))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/goal", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetLearningGoal))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/goal", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpsertLearningGoal))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/goal", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteLearningGoal))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/certificate", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.IssueCertificate))).Methods("POST")
+	s.MUX.Handle("/api/v1/certificates", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetMyCertificates))).Methods("GET")
+	s.MUX.Handle("/api/v1/certificates/{certificate_id}/verify", http.HandlerFunc(r.handlers.VerifyCertificate)).Methods("GET")
 
 	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.CreateMaterial))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials/upload", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UploadMaterial))).Methods("POST")
 	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials/{material_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteMaterial))).Methods("DELETE")
-	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials", http.HandlerFunc(r.handlers.GetMaterialsByNode)).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials", s.AuthMiddleware.OptionalAuthMiddleware(http.HandlerFunc(r.handlers.GetMaterialsByNode))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials/{material_id}/vote", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.VoteMaterial))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmaps/{roadmap_id}/nodes/{node_id}/materials/{material_id}/vote", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeleteMaterialVote))).Methods("DELETE")
 }
@@ -0,0 +1,393 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	websocket "github.com/F0urward/proftwist-backend/internal/server/ws"
+	"github.com/F0urward/proftwist-backend/internal/server/ws/dto"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+	roadmapDTO "github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+// editingRoom holds the connections editing one roadmap. Operations are
+// applied under opsMu so that every participant receives them in version
+// order.
+type editingRoom struct {
+	opsMu   sync.Mutex
+	clients map[*websocket.WsClient]struct{}
+}
+
+type RoadmapWsHandlers struct {
+	roadmapUC roadmap.Usecase
+	mu        sync.Mutex
+	rooms     map[primitive.ObjectID]*editingRoom
+}
+
+func NewRoadmapWsHandlers(roadmapUC roadmap.Usecase) roadmap.WSHandlers {
+	return &RoadmapWsHandlers{
+		roadmapUC: roadmapUC,
+		rooms:     make(map[primitive.ObjectID]*editingRoom),
+	}
+}
+
+func (wsh *RoadmapWsHandlers) HandleJoin(client *websocket.WsClient, msg dto.WebSocketMessage) error {
+	const op = "RoadmapWsHandlers.HandleJoin"
+	ctx := context.Background()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	var joinData dto.RoadmapEditingData
+	if err := joinData.UnmarshalJSON(msg.Data); err != nil {
+		logger.WithError(err).Error("failed to unmarshal join data")
+		return fmt.Errorf("failed to unmarshal join data: %w", err)
+	}
+
+	roadmapID, userID, err := wsh.parseIDs(client, joinData.RoadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid join request")
+		wsh.sendError(client, joinData.RoadmapID, "", err)
+		return err
+	}
+
+	room, participants, firstConnection := wsh.join(roadmapID, client)
+
+	// The client is already in the room when the snapshot is loaded, and
+	// opsMu holds operations back until the snapshot is sent, so every
+	// operation missing from the snapshot reaches the client afterwards.
+	room.opsMu.Lock()
+	snapshot, err := wsh.roadmapUC.JoinEditing(ctx, userID, roadmapID)
+	if err != nil {
+		room.opsMu.Unlock()
+		wsh.remove(roadmapID, client)
+		logger.WithError(err).Warn("failed to join roadmap editing")
+		wsh.sendError(client, joinData.RoadmapID, "", err)
+		return err
+	}
+
+	snapshot.Participants = participants
+	err = wsh.send(client, dto.WebSocketMessageTypeRoadmapSnapshot, snapshot)
+	room.opsMu.Unlock()
+	if err != nil {
+		logger.WithError(err).Error("failed to send roadmap snapshot")
+		return err
+	}
+
+	if firstConnection {
+		wsh.broadcastPresence(roadmapID, client, dto.RoadmapPresenceJoined)
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"user_id":    client.UserID,
+	}).Info("client joined roadmap editing")
+	return nil
+}
+
+func (wsh *RoadmapWsHandlers) HandleLeave(client *websocket.WsClient, msg dto.WebSocketMessage) error {
+	const op = "RoadmapWsHandlers.HandleLeave"
+	logger := ctxutil.GetLogger(context.Background()).WithField("op", op)
+
+	var leaveData dto.RoadmapEditingData
+	if err := leaveData.UnmarshalJSON(msg.Data); err != nil {
+		logger.WithError(err).Error("failed to unmarshal leave data")
+		return fmt.Errorf("failed to unmarshal leave data: %w", err)
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(leaveData.RoadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid roadmap ID")
+		return fmt.Errorf("invalid roadmap ID: %w", err)
+	}
+
+	wsh.leave(roadmapID, client)
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"user_id":    client.UserID,
+	}).Info("client left roadmap editing")
+	return nil
+}
+
+func (wsh *RoadmapWsHandlers) HandleCursor(client *websocket.WsClient, msg dto.WebSocketMessage) error {
+	const op = "RoadmapWsHandlers.HandleCursor"
+	logger := ctxutil.GetLogger(context.Background()).WithField("op", op)
+
+	var cursorData dto.RoadmapCursorData
+	if err := cursorData.UnmarshalJSON(msg.Data); err != nil {
+		logger.WithError(err).Error("failed to unmarshal cursor data")
+		return fmt.Errorf("failed to unmarshal cursor data: %w", err)
+	}
+
+	roadmapID, err := primitive.ObjectIDFromHex(cursorData.RoadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid roadmap ID")
+		return fmt.Errorf("invalid roadmap ID: %w", err)
+	}
+
+	if !wsh.isMember(roadmapID, client) {
+		logger.WithField("roadmap_id", roadmapID.Hex()).Warn("cursor update from client outside the room")
+		return fmt.Errorf("client has not joined roadmap %s", roadmapID.Hex())
+	}
+
+	cursorData.UserID = client.UserID
+	return wsh.broadcast(roadmapID, client, dto.WebSocketMessageTypeRoadmapCursorUpdate, &cursorData)
+}
+
+func (wsh *RoadmapWsHandlers) HandleOperation(client *websocket.WsClient, msg dto.WebSocketMessage) error {
+	const op = "RoadmapWsHandlers.HandleOperation"
+	ctx := context.Background()
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	var request roadmapDTO.EditOperationRequestDTO
+	if err := request.UnmarshalJSON(msg.Data); err != nil {
+		logger.WithError(err).Error("failed to unmarshal edit operation")
+		return fmt.Errorf("failed to unmarshal edit operation: %w", err)
+	}
+
+	roadmapID, userID, err := wsh.parseIDs(client, request.RoadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("invalid edit operation request")
+		wsh.sendError(client, request.RoadmapID, request.Operation.ID, err)
+		return err
+	}
+
+	room := wsh.room(roadmapID, client)
+	if room == nil {
+		err := fmt.Errorf("attempt to edit roadmap %s without joining it", roadmapID.Hex())
+		logger.WithError(err).Warn("edit operation from client outside the room")
+		wsh.sendError(client, request.RoadmapID, request.Operation.ID, err)
+		return err
+	}
+
+	room.opsMu.Lock()
+	defer room.opsMu.Unlock()
+
+	applied, err := wsh.roadmapUC.ApplyEditOperation(ctx, userID, roadmapID, &request.Operation)
+	if err != nil {
+		logger.WithError(err).Warn("edit operation rejected")
+		wsh.sendError(client, request.RoadmapID, request.Operation.ID, err)
+		return err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"roadmap_id": roadmapID.Hex(),
+		"version":    applied.Version,
+	}).Info("edit operation applied")
+	return wsh.broadcast(roadmapID, nil, dto.WebSocketMessageTypeRoadmapOperationApplied, applied)
+}
+
+func (wsh *RoadmapWsHandlers) HandleDisconnect(client *websocket.WsClient) {
+	wsh.mu.Lock()
+	var roadmapIDs []primitive.ObjectID
+	for roadmapID, room := range wsh.rooms {
+		if _, ok := room.clients[client]; ok {
+			roadmapIDs = append(roadmapIDs, roadmapID)
+		}
+	}
+	wsh.mu.Unlock()
+
+	for _, roadmapID := range roadmapIDs {
+		wsh.leave(roadmapID, client)
+	}
+}
+
+func (wsh *RoadmapWsHandlers) parseIDs(client *websocket.WsClient, rawRoadmapID string) (primitive.ObjectID, uuid.UUID, error) {
+	roadmapID, err := primitive.ObjectIDFromHex(rawRoadmapID)
+	if err != nil {
+		return primitive.NilObjectID, uuid.Nil, fmt.Errorf("invalid roadmap ID: %w", err)
+	}
+
+	userID, err := uuid.Parse(client.UserID)
+	if err != nil {
+		return primitive.NilObjectID, uuid.Nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	return roadmapID, userID, nil
+}
+
+// join adds the client to the room and returns the room and the users present
+// afterwards, along with whether this is the user's first connection to it.
+func (wsh *RoadmapWsHandlers) join(roadmapID primitive.ObjectID, client *websocket.WsClient) (*editingRoom, []string, bool) {
+	wsh.mu.Lock()
+	defer wsh.mu.Unlock()
+
+	room, ok := wsh.rooms[roadmapID]
+	if !ok {
+		room = &editingRoom{clients: make(map[*websocket.WsClient]struct{})}
+		wsh.rooms[roadmapID] = room
+	}
+
+	firstConnection := !hasUser(room, client.UserID)
+	room.clients[client] = struct{}{}
+
+	seen := make(map[string]bool)
+	participants := make([]string, 0, len(room.clients))
+	for c := range room.clients {
+		if !seen[c.UserID] {
+			seen[c.UserID] = true
+			participants = append(participants, c.UserID)
+		}
+	}
+
+	return room, participants, firstConnection
+}
+
+func (wsh *RoadmapWsHandlers) leave(roadmapID primitive.ObjectID, client *websocket.WsClient) {
+	if wsh.remove(roadmapID, client) {
+		wsh.broadcastPresence(roadmapID, client, dto.RoadmapPresenceLeft)
+	}
+}
+
+// remove takes the client out of the room and releases the editing state of
+// a room left empty. It reports whether the remaining participants should be
+// told that the user has left.
+func (wsh *RoadmapWsHandlers) remove(roadmapID primitive.ObjectID, client *websocket.WsClient) bool {
+	wsh.mu.Lock()
+	room, ok := wsh.rooms[roadmapID]
+	if !ok {
+		wsh.mu.Unlock()
+		return false
+	}
+	if _, member := room.clients[client]; !member {
+		wsh.mu.Unlock()
+		return false
+	}
+
+	delete(room.clients, client)
+	lastConnection := !hasUser(room, client.UserID)
+	empty := len(room.clients) == 0
+	if empty {
+		delete(wsh.rooms, roadmapID)
+	}
+	wsh.mu.Unlock()
+
+	if empty {
+		wsh.roadmapUC.ReleaseEditing(roadmapID)
+		return false
+	}
+
+	return lastConnection
+}
+
+func (wsh *RoadmapWsHandlers) room(roadmapID primitive.ObjectID, client *websocket.WsClient) *editingRoom {
+	wsh.mu.Lock()
+	defer wsh.mu.Unlock()
+
+	room, ok := wsh.rooms[roadmapID]
+	if !ok {
+		return nil
+	}
+	if _, member := room.clients[client]; !member {
+		return nil
+	}
+	return room
+}
+
+func (wsh *RoadmapWsHandlers) isMember(roadmapID primitive.ObjectID, client *websocket.WsClient) bool {
+	return wsh.room(roadmapID, client) != nil
+}
+
+func (wsh *RoadmapWsHandlers) broadcastPresence(roadmapID primitive.ObjectID, client *websocket.WsClient, status dto.RoadmapPresenceStatus) {
+	presence := &dto.RoadmapPresenceData{
+		RoadmapID: roadmapID.Hex(),
+		UserID:    client.UserID,
+		Status:    status,
+		At:        time.Now(),
+	}
+
+	if err := wsh.broadcast(roadmapID, client, dto.WebSocketMessageTypeRoadmapPresence, presence); err != nil {
+		ctxutil.GetLogger(context.Background()).WithError(err).Warn("failed to broadcast roadmap presence")
+	}
+}
+
+// broadcast sends the message to every client in the room except the given
+// one, which may be nil to include everybody.
+func (wsh *RoadmapWsHandlers) broadcast(roadmapID primitive.ObjectID, except *websocket.WsClient, messageType dto.WebSocketMessageType, data json.Marshaler) error {
+	message, err := newMessage(messageType, data)
+	if err != nil {
+		return err
+	}
+
+	wsh.mu.Lock()
+	var recipients []*websocket.WsClient
+	if room, ok := wsh.rooms[roadmapID]; ok {
+		recipients = make([]*websocket.WsClient, 0, len(room.clients))
+		for c := range room.clients {
+			if c != except {
+				recipients = append(recipients, c)
+			}
+		}
+	}
+	wsh.mu.Unlock()
+
+	for _, c := range recipients {
+		if err := c.Server.SendToClient(c, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wsh *RoadmapWsHandlers) send(client *websocket.WsClient, messageType dto.WebSocketMessageType, data json.Marshaler) error {
+	message, err := newMessage(messageType, data)
+	if err != nil {
+		return err
+	}
+	return client.Server.SendToClient(client, message)
+}
+
+func (wsh *RoadmapWsHandlers) sendError(client *websocket.WsClient, roadmapID, operationID string, cause error) {
+	errorData := &dto.RoadmapEditErrorData{
+		RoadmapID:   roadmapID,
+		OperationID: operationID,
+		Reason:      errorReason(cause),
+	}
+
+	if err := wsh.send(client, dto.WebSocketMessageTypeRoadmapEditError, errorData); err != nil {
+		ctxutil.GetLogger(context.Background()).WithError(err).Warn("failed to send roadmap edit error")
+	}
+}
+
+func newMessage(messageType dto.WebSocketMessageType, data json.Marshaler) (dto.WebSocketMessage, error) {
+	payload, err := data.MarshalJSON()
+	if err != nil {
+		return dto.WebSocketMessage{}, fmt.Errorf("failed to marshal %s data: %w", messageType, err)
+	}
+
+	return dto.WebSocketMessage{
+		Type:      messageType,
+		Data:      payload,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func errorReason(err error) string {
+	switch {
+	case errors.Is(err, errs.ErrForbidden):
+		return errs.ErrForbidden.Error()
+	case errs.IsNotFoundError(err):
+		return errs.ErrNotFound.Error()
+	case errs.IsBusinessLogicError(err):
+		return err.Error()
+	default:
+		return errs.ErrInternal.Error()
+	}
+}
+
+func hasUser(room *editingRoom, userID string) bool {
+	for c := range room.clients {
+		if c.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package ws
+
+import (
+	wsServer "github.com/F0urward/proftwist-backend/internal/server/ws"
+	"github.com/F0urward/proftwist-backend/internal/server/ws/dto"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type RoadmapWsRegistrar struct {
+	handlers roadmap.WSHandlers
+}
+
+func NewRoadmapWsRegistrar(handlers roadmap.WSHandlers) wsServer.WsRegistrar {
+	return &RoadmapWsRegistrar{
+		handlers: handlers,
+	}
+}
+
+func (r *RoadmapWsRegistrar) RegisterHandlers(s *wsServer.WsServer) {
+	s.RegisterMessageHandler(dto.WebSocketMessageTypeRoadmapJoin, r.handlers.HandleJoin)
+	s.RegisterMessageHandler(dto.WebSocketMessageTypeRoadmapLeave, r.handlers.HandleLeave)
+	s.RegisterMessageHandler(dto.WebSocketMessageTypeRoadmapCursor, r.handlers.HandleCursor)
+	s.RegisterMessageHandler(dto.WebSocketMessageTypeRoadmapOperation, r.handlers.HandleOperation)
+	s.RegisterDisconnectHandler(r.handlers.HandleDisconnect)
+}
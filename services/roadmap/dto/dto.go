@@ -1,10 +1,13 @@
 package dto
 
 import (
+	"io"
 	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
 )
 
 type RoadmapDTO struct {
@@ -19,16 +22,19 @@ type RoadmapWithMaterialsDTO struct {
 	ID                 primitive.ObjectID     `json:"_id,omitempty"`
 	NodesWithMaterials []NodeWithMaterialsDTO `json:"nodes,omitempty"`
 	Edges              []EdgeDTO              `json:"edges,omitempty"`
+	UpdatedBy          *uuid.UUID             `json:"updated_by,omitempty"`
 	CreatedAt          time.Time              `json:"created_at"`
 	UpdatedAt          time.Time              `json:"updated_at"`
 }
 
 type RoadmapWithProgressDTO struct {
-	ID        primitive.ObjectID    `json:"_id,omitempty"`
-	Nodes     []NodeWithProgressDTO `json:"nodes,omitempty"`
-	Edges     []EdgeDTO             `json:"edges,omitempty"`
-	CreatedAt time.Time             `json:"created_at"`
-	UpdatedAt time.Time             `json:"updated_at"`
+	ID                   primitive.ObjectID    `json:"_id,omitempty"`
+	Nodes                []NodeWithProgressDTO `json:"nodes,omitempty"`
+	Edges                []EdgeDTO             `json:"edges,omitempty"`
+	EnforcePrerequisites bool                  `json:"enforce_prerequisites"`
+	UpdatedBy            *uuid.UUID            `json:"updated_by,omitempty"`
+	CreatedAt            time.Time             `json:"created_at"`
+	UpdatedAt            time.Time             `json:"updated_at"`
 }
 
 type NodeDTO struct {
@@ -52,6 +58,8 @@ type NodeWithMaterialsDTO struct {
 	Selected    bool       `json:"selected"`
 	Dragging    bool       `json:"dragging"`
 	Materials   []Material `json:"materials,omitempty"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	UpdatedBy   *uuid.UUID `json:"updated_by,omitempty"`
 }
 
 type NodeWithProgressDTO struct {
@@ -64,6 +72,11 @@ type NodeWithProgressDTO struct {
 	Dragging    bool          `json:"dragging"`
 	Description string        `json:"description,omitempty"`
 	Progress    *NodeProgress `json:"progress,omitempty"`
+	Note        *NodeNoteDTO  `json:"note,omitempty"`
+	Locked      bool          `json:"locked,omitempty"`
+	LockedBy    []uuid.UUID   `json:"locked_by,omitempty"`
+	CreatedBy   *uuid.UUID    `json:"created_by,omitempty"`
+	UpdatedBy   *uuid.UUID    `json:"updated_by,omitempty"`
 }
 
 type NodeData struct {
@@ -77,9 +90,10 @@ type Measured struct {
 }
 
 type EdgeDTO struct {
-	Source string `json:"source" bson:"source"`
-	Target string `json:"target" bson:"target"`
-	ID     string `json:"id" bson:"id"`
+	Source       string `json:"source" bson:"source"`
+	Target       string `json:"target" bson:"target"`
+	ID           string `json:"id" bson:"id"`
+	Prerequisite bool   `json:"prerequisite,omitempty" bson:"prerequisite,omitempty"`
 }
 
 type Position struct {
@@ -88,30 +102,275 @@ type Position struct {
 }
 
 type Material struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	URL       string    `json:"url"`
-	AuthorID  uuid.UUID `json:"author_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              uuid.UUID                   `json:"id"`
+	Name            string                      `json:"name"`
+	URL             string                      `json:"url"`
+	Type            entities.MaterialType       `json:"type"`
+	DurationMinutes int                         `json:"duration_minutes,omitempty"`
+	Language        string                      `json:"language,omitempty"`
+	Difficulty      entities.MaterialDifficulty `json:"difficulty,omitempty"`
+	IsPaid          bool                        `json:"is_paid"`
+	File            *MaterialFileDTO            `json:"file,omitempty"`
+	Preview         *MaterialPreviewDTO         `json:"preview,omitempty"`
+	AuthorID        uuid.UUID                   `json:"author_id"`
+	CreatedAt       time.Time                   `json:"created_at"`
+	UpdatedAt       time.Time                   `json:"updated_at"`
+}
+
+type MaterialFileDTO struct {
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
 }
 
 type NodeProgressStatus string
 
 const (
-	NodeProgressPending    NodeProgressStatus = "ожидает"
-	NodeProgressInProgress NodeProgressStatus = "в процессе"
-	NodeProgressDone       NodeProgressStatus = "завершено"
-	NodeProgressSkipped    NodeProgressStatus = "пропущено"
+	NodeProgressPending    NodeProgressStatus = "pending"
+	NodeProgressInProgress NodeProgressStatus = "in_progress"
+	NodeProgressDone       NodeProgressStatus = "done"
+	NodeProgressSkipped    NodeProgressStatus = "skipped"
 )
 
 type NodeProgress struct {
-	Status NodeProgressStatus `json:"status"`
+	Status           NodeProgressStatus `json:"status"`
+	StatusLabel      string             `json:"status_label"`
+	StartedAt        *time.Time         `json:"started_at,omitempty"`
+	CompletedAt      *time.Time         `json:"completed_at,omitempty"`
+	TimeSpentMinutes int                `json:"time_spent_minutes"`
+	Confidence       int                `json:"confidence,omitempty"`
 }
 
 type UpdateNodeProgressRequestDTO struct {
-	NodeID uuid.UUID          `json:"node_id"`
-	Status NodeProgressStatus `json:"status"`
+	NodeID           uuid.UUID          `json:"node_id"`
+	Status           NodeProgressStatus `json:"status,omitempty"`
+	TimeSpentMinutes int                `json:"time_spent_minutes,omitempty"`
+	Confidence       *int               `json:"confidence,omitempty"`
+}
+
+type ProgressEventDTO struct {
+	NodeID           uuid.UUID          `json:"node_id"`
+	NodeLabel        string             `json:"node_label,omitempty"`
+	Type             string             `json:"type"`
+	FromStatus       NodeProgressStatus `json:"from_status,omitempty"`
+	FromStatusLabel  string             `json:"from_status_label,omitempty"`
+	ToStatus         NodeProgressStatus `json:"to_status,omitempty"`
+	ToStatusLabel    string             `json:"to_status_label,omitempty"`
+	TimeSpentMinutes int                `json:"time_spent_minutes,omitempty"`
+	Confidence       int                `json:"confidence,omitempty"`
+	CreatedAt        time.Time          `json:"created_at"`
+}
+
+type ProgressTimelineResponseDTO struct {
+	RoadmapID string             `json:"roadmap_id"`
+	Events    []ProgressEventDTO `json:"events"`
+	Total     int                `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+type ProgressSummaryNodeDTO struct {
+	ID          uuid.UUID          `json:"id"`
+	Label       string             `json:"label"`
+	Status      NodeProgressStatus `json:"status"`
+	StatusLabel string             `json:"status_label"`
+}
+
+type ProgressSummaryDTO struct {
+	RoadmapID         string                   `json:"roadmap_id"`
+	TotalNodes        int                      `json:"total_nodes"`
+	DoneCount         int                      `json:"done_count"`
+	InProgressCount   int                      `json:"in_progress_count"`
+	SkippedCount      int                      `json:"skipped_count"`
+	PendingCount      int                      `json:"pending_count"`
+	CompletionPercent float64                  `json:"completion_percent"`
+	TimeSpentMinutes  int                      `json:"time_spent_minutes"`
+	NextNodes         []ProgressSummaryNodeDTO `json:"next_nodes"`
+	LastActivityAt    *time.Time               `json:"last_activity_at,omitempty"`
+}
+
+type MyLearningRoadmapDTO struct {
+	RoadmapID     string             `json:"roadmap_id"`
+	RoadmapInfoID string             `json:"roadmap_info_id,omitempty"`
+	Name          string             `json:"name,omitempty"`
+	Summary       ProgressSummaryDTO `json:"summary"`
+}
+
+type MyLearningResponseDTO struct {
+	Roadmaps []MyLearningRoadmapDTO `json:"roadmaps"`
+	Total    int                    `json:"total"`
+	Limit    int                    `json:"limit"`
+	Offset   int                    `json:"offset"`
+}
+
+type LearningOrderNodeDTO struct {
+	ID       uuid.UUID     `json:"id"`
+	Label    string        `json:"label"`
+	Type     string        `json:"type"`
+	Progress *NodeProgress `json:"progress,omitempty"`
+	Locked   bool          `json:"locked"`
+	LockedBy []uuid.UUID   `json:"locked_by,omitempty"`
+}
+
+type LearningOrderResponseDTO struct {
+	RoadmapID            string                 `json:"roadmap_id"`
+	EnforcePrerequisites bool                   `json:"enforce_prerequisites"`
+	Nodes                []LearningOrderNodeDTO `json:"nodes"`
+}
+
+type CertificateDTO struct {
+	ID             uuid.UUID `json:"id"`
+	UserID         uuid.UUID `json:"user_id"`
+	UserName       string    `json:"user_name"`
+	RoadmapID      string    `json:"roadmap_id"`
+	RoadmapName    string    `json:"roadmap_name"`
+	RoadmapVersion string    `json:"roadmap_version"`
+	IssuedAt       time.Time `json:"issued_at"`
+	Signature      string    `json:"signature"`
+	URL            string    `json:"url,omitempty"`
+}
+
+type CertificateListResponseDTO struct {
+	Certificates []CertificateDTO `json:"certificates"`
+}
+
+type CertificateVerificationResponseDTO struct {
+	Valid       bool            `json:"valid"`
+	Certificate *CertificateDTO `json:"certificate,omitempty"`
+}
+
+type UpsertLearningGoalRequestDTO struct {
+	Deadline         time.Time `json:"deadline"`
+	WeeklyPace       int       `json:"weekly_pace"`
+	RemindersEnabled *bool     `json:"reminders_enabled,omitempty"`
+}
+
+type LearningGoalDTO struct {
+	RoadmapID             string                      `json:"roadmap_id"`
+	RoadmapInfoID         string                      `json:"roadmap_info_id,omitempty"`
+	Name                  string                      `json:"name,omitempty"`
+	Deadline              time.Time                   `json:"deadline"`
+	WeeklyPace            int                         `json:"weekly_pace"`
+	RemindersEnabled      bool                        `json:"reminders_enabled"`
+	Status                entities.LearningGoalStatus `json:"status"`
+	TotalNodes            int                         `json:"total_nodes"`
+	FinishedNodes         int                         `json:"finished_nodes"`
+	RemainingNodes        int                         `json:"remaining_nodes"`
+	ActualPace            float64                     `json:"actual_pace"`
+	RequiredPace          float64                     `json:"required_pace"`
+	ProjectedCompletionAt *time.Time                  `json:"projected_completion_at,omitempty"`
+	LastRemindedAt        *time.Time                  `json:"last_reminded_at,omitempty"`
+	CreatedAt             time.Time                   `json:"created_at"`
+	UpdatedAt             time.Time                   `json:"updated_at"`
+}
+
+type LearningGoalListResponseDTO struct {
+	Goals []LearningGoalDTO `json:"goals"`
+}
+
+type MigrateProgressResponseDTO struct {
+	SourceRoadmapID string                      `json:"source_roadmap_id"`
+	Kind            entities.RoadmapLineageKind `json:"kind"`
+	MigratedNodes   int                         `json:"migrated_nodes"`
+}
+
+type CalendarFeedDTO struct {
+	URL         string     `json:"url"`
+	EventCount  int        `json:"event_count"`
+	GeneratedAt *time.Time `json:"generated_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type CalendarFeedFileDTO struct {
+	FileName   string    `json:"-"`
+	Content    []byte    `json:"-"`
+	ModifiedAt time.Time `json:"-"`
+}
+
+type QuizOptionDTO struct {
+	ID      uuid.UUID `json:"id"`
+	Text    string    `json:"text"`
+	Correct *bool     `json:"correct,omitempty"`
+}
+
+type QuizQuestionDTO struct {
+	ID              uuid.UUID                 `json:"id"`
+	Type            entities.QuizQuestionType `json:"type"`
+	Text            string                    `json:"text"`
+	Options         []QuizOptionDTO           `json:"options,omitempty"`
+	AcceptedAnswers []string                  `json:"accepted_answers,omitempty"`
+	Explanation     string                    `json:"explanation,omitempty"`
+}
+
+type NodeQuizDTO struct {
+	RoadmapID    string            `json:"roadmap_id"`
+	NodeID       uuid.UUID         `json:"node_id"`
+	Questions    []QuizQuestionDTO `json:"questions"`
+	PassingScore int               `json:"passing_score"`
+	AutoComplete bool              `json:"auto_complete"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	LastAttempt  *QuizAttemptDTO   `json:"last_attempt,omitempty"`
+}
+
+type UpsertNodeQuizRequestDTO struct {
+	Questions    []QuizQuestionDTO `json:"questions"`
+	PassingScore int               `json:"passing_score"`
+	AutoComplete bool              `json:"auto_complete"`
+}
+
+type GenerateNodeQuizRequestDTO struct {
+	Instructions   string `json:"instructions"`
+	Complexity     string `json:"complexity"`
+	QuestionsCount int    `json:"questions_count"`
+}
+
+type GenerateNodeQuizResponseDTO struct {
+	RoadmapID string            `json:"roadmap_id"`
+	NodeID    uuid.UUID         `json:"node_id"`
+	Questions []QuizQuestionDTO `json:"questions"`
+}
+
+type QuizAnswerDTO struct {
+	QuestionID uuid.UUID   `json:"question_id"`
+	OptionIDs  []uuid.UUID `json:"option_ids,omitempty"`
+	Text       string      `json:"text,omitempty"`
+}
+
+type SubmitQuizAttemptRequestDTO struct {
+	Answers []QuizAnswerDTO `json:"answers"`
+}
+
+type QuizAnswerResultDTO struct {
+	QuestionID       uuid.UUID   `json:"question_id"`
+	OptionIDs        []uuid.UUID `json:"option_ids,omitempty"`
+	Text             string      `json:"text,omitempty"`
+	Correct          bool        `json:"correct"`
+	CorrectOptionIDs []uuid.UUID `json:"correct_option_ids,omitempty"`
+	AcceptedAnswers  []string    `json:"accepted_answers,omitempty"`
+	Explanation      string      `json:"explanation,omitempty"`
+}
+
+type QuizAttemptDTO struct {
+	ID           string    `json:"id"`
+	CorrectCount int       `json:"correct_count"`
+	TotalCount   int       `json:"total_count"`
+	Score        int       `json:"score"`
+	Passed       bool      `json:"passed"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type QuizAttemptResultDTO struct {
+	Attempt       QuizAttemptDTO        `json:"attempt"`
+	Answers       []QuizAnswerResultDTO `json:"answers"`
+	NodeCompleted bool                  `json:"node_completed"`
+}
+
+type QuizAttemptListResponseDTO struct {
+	Attempts []QuizAttemptDTO `json:"attempts"`
+	Total    int              `json:"total"`
+	Limit    int              `json:"limit"`
+	Offset   int              `json:"offset"`
 }
 
 type GetAllRoadmapsResponseDTO struct {
@@ -140,9 +399,14 @@ type CreateRoadmapResponseDTO struct {
 	RoadmapWithMaterials RoadmapWithMaterialsDTO `json:"roadmap"`
 }
 
+// UpdateRoadmapRequestDTO replaces the roadmap's nodes and edges. NodeIDMap
+// optionally tells which new node replaces a removed one, so learners keep
+// their progress; without it nodes are matched by label.
 type UpdateRoadmapRequestDTO struct {
-	Nodes []NodeDTO `json:"nodes,omitempty"`
-	Edges []EdgeDTO `json:"edges,omitempty"`
+	Nodes                []NodeDTO         `json:"nodes,omitempty"`
+	Edges                []EdgeDTO         `json:"edges,omitempty"`
+	EnforcePrerequisites *bool             `json:"enforce_prerequisites,omitempty"`
+	NodeIDMap            map[string]string `json:"node_id_map,omitempty"`
 }
 
 type UpdateRoadmapResponseDTO struct {
@@ -163,20 +427,123 @@ type GenerateRoadmapDTO struct {
 	Description string
 	Content     string
 	Complexity  string
+	Prompt      *entities.PromptTemplate `json:"-"`
+}
+
+type RegenerationMode string
+
+const (
+	RegenerationModeReplace RegenerationMode = "replace"
+	RegenerationModeExpand  RegenerationMode = "expand"
+)
+
+type RegenerateNodeRequestDTO struct {
+	Instructions string           `json:"instructions"`
+	Mode         RegenerationMode `json:"mode"`
+	Complexity   string           `json:"complexity"`
+}
+
+type RegenerateNodeResponseDTO struct {
+	RoadmapID    primitive.ObjectID `json:"roadmapId"`
+	NodeID       uuid.UUID          `json:"nodeId"`
+	AddedNodes   int                `json:"added_nodes"`
+	RemovedNodes int                `json:"removed_nodes"`
+}
+
+type RegenerateSubtreeDTO struct {
+	Topic        string
+	Description  string
+	Complexity   string
+	Instructions string
+	Mode         RegenerationMode
+	Node         NodeDTO
+	Ancestors    []NodeDTO
+	Siblings     []NodeDTO
+	Subtree      []NodeDTO
+	Prompt       *entities.PromptTemplate `json:"-"`
+}
+
+type SuggestNodeContentRequestDTO struct {
+	Instructions string `json:"instructions"`
+	Complexity   string `json:"complexity"`
+}
+
+type SuggestedMaterialDTO struct {
+	Name string                `json:"name"`
+	Type entities.MaterialType `json:"type"`
+	URL  string                `json:"url"`
+}
+
+type SuggestNodeContentResponseDTO struct {
+	RoadmapID         primitive.ObjectID     `json:"roadmapId"`
+	NodeID            uuid.UUID              `json:"nodeId"`
+	Description       string                 `json:"description"`
+	Materials         []SuggestedMaterialDTO `json:"materials"`
+	RejectedMaterials int                    `json:"rejected_materials"`
+}
+
+type NodeSuggestionsDTO struct {
+	Topic        string
+	Description  string
+	Complexity   string
+	Instructions string
+	MaxMaterials int
+	Node         NodeDTO
+	Ancestors    []NodeDTO
+	Prompt       *entities.PromptTemplate `json:"-"`
+}
+
+type NodeQuizDraftDTO struct {
+	Topic          string
+	Description    string
+	Complexity     string
+	Instructions   string
+	QuestionsCount int
+	Node           NodeDTO
+	Ancestors      []NodeDTO
+	Prompt         *entities.PromptTemplate `json:"-"`
 }
 
 type CreateMaterialRequestDTO struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	Name            string                      `json:"name"`
+	URL             string                      `json:"url"`
+	Type            entities.MaterialType       `json:"type"`
+	DurationMinutes int                         `json:"duration_minutes"`
+	Language        string                      `json:"language"`
+	Difficulty      entities.MaterialDifficulty `json:"difficulty"`
+	IsPaid          bool                        `json:"is_paid"`
+}
+
+type UploadMaterialRequestDTO struct {
+	File            io.Reader                   `json:"-"`
+	FileName        string                      `json:"-"`
+	Size            int64                       `json:"-"`
+	ContentType     string                      `json:"-"`
+	BucketName      string                      `json:"-"`
+	Name            string                      `json:"-"`
+	DurationMinutes int                         `json:"-"`
+	Language        string                      `json:"-"`
+	Difficulty      entities.MaterialDifficulty `json:"-"`
+	IsPaid          bool                        `json:"-"`
 }
 
 type EnrichedMaterialResponseDTO struct {
-	ID        uuid.UUID         `json:"id"`
-	Name      string            `json:"name"`
-	URL       string            `json:"url"`
-	Author    MaterialAuthorDTO `json:"author"`
-	CreatedAt time.Time         `json:"created_at"`
-	UpdatedAt time.Time         `json:"updated_at"`
+	ID              uuid.UUID                   `json:"id"`
+	Name            string                      `json:"name"`
+	URL             string                      `json:"url"`
+	Type            entities.MaterialType       `json:"type"`
+	DurationMinutes int                         `json:"duration_minutes,omitempty"`
+	Language        string                      `json:"language,omitempty"`
+	Difficulty      entities.MaterialDifficulty `json:"difficulty,omitempty"`
+	IsPaid          bool                        `json:"is_paid"`
+	File            *MaterialFileDTO            `json:"file,omitempty"`
+	Author          MaterialAuthorDTO           `json:"author"`
+	Preview         *MaterialPreviewDTO         `json:"preview,omitempty"`
+	LinkCheck       *MaterialLinkCheckDTO       `json:"link_check,omitempty"`
+	Votes           MaterialVotesDTO            `json:"votes"`
+	MyVote          *MaterialMyVoteDTO          `json:"my_vote,omitempty"`
+	CreatedAt       time.Time                   `json:"created_at"`
+	UpdatedAt       time.Time                   `json:"updated_at"`
 }
 
 type MaterialAuthorDTO struct {
@@ -185,11 +552,151 @@ type MaterialAuthorDTO struct {
 	AvatarURL string    `json:"avatar_url,omitempty"`
 }
 
+type MaterialPreviewDTO struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+	FaviconURL  string `json:"favicon_url,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+}
+
+type MaterialLinkCheckDTO struct {
+	Status     entities.MaterialLinkStatus `json:"status"`
+	StatusCode int                         `json:"status_code,omitempty"`
+	FinalURL   string                      `json:"final_url,omitempty"`
+	Redirects  []string                    `json:"redirects,omitempty"`
+	Error      string                      `json:"error,omitempty"`
+	CheckedAt  time.Time                   `json:"checked_at"`
+}
+
+type MaterialVotesDTO struct {
+	Upvotes   int     `json:"upvotes"`
+	Downvotes int     `json:"downvotes"`
+	Helpful   int     `json:"helpful"`
+	Score     float64 `json:"score"`
+}
+
+type MaterialMyVoteDTO struct {
+	Vote    entities.MaterialVoteValue `json:"vote,omitempty"`
+	Helpful bool                       `json:"helpful"`
+}
+
 type MaterialListResponseDTO struct {
 	Materials []EnrichedMaterialResponseDTO `json:"materials"`
 	Total     int                           `json:"total"`
 }
 
+type VoteMaterialRequestDTO struct {
+	Vote    entities.MaterialVoteValue `json:"vote"`
+	Helpful bool                       `json:"helpful"`
+}
+
+type VoteMaterialResponseDTO struct {
+	MaterialID uuid.UUID          `json:"material_id"`
+	Votes      MaterialVotesDTO   `json:"votes"`
+	MyVote     *MaterialMyVoteDTO `json:"my_vote,omitempty"`
+}
+
+type BrokenLinkDTO struct {
+	NodeID       uuid.UUID            `json:"node_id"`
+	MaterialID   uuid.UUID            `json:"material_id"`
+	MaterialName string               `json:"material_name"`
+	URL          string               `json:"url"`
+	LinkCheck    MaterialLinkCheckDTO `json:"link_check"`
+}
+
+type BrokenLinksResponseDTO struct {
+	RoadmapID string          `json:"roadmap_id"`
+	Links     []BrokenLinkDTO `json:"links"`
+	Total     int             `json:"total"`
+}
+
+type NodeNoteDTO struct {
+	Content   string    `json:"content"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type UpsertNodeNoteRequestDTO struct {
+	Content string `json:"content"`
+}
+
+type NodeNoteResponseDTO struct {
+	RoadmapID string    `json:"roadmap_id"`
+	NodeID    uuid.UUID `json:"node_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NodeNoteSearchResultDTO struct {
+	RoadmapID string    `json:"roadmap_id"`
+	NodeID    uuid.UUID `json:"node_id"`
+	Snippet   string    `json:"snippet"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NodeNoteSearchResponseDTO struct {
+	Notes  []NodeNoteSearchResultDTO `json:"notes"`
+	Total  int                       `json:"total"`
+	Limit  int                       `json:"limit"`
+	Offset int                       `json:"offset"`
+}
+
+type RoadmapExportDTO struct {
+	FileName string `json:"-"`
+	Content  []byte `json:"-"`
+}
+
 type DeleteMaterialResponseDTO struct {
 	Message string `json:"message"`
 }
+
+type EditOperationType string
+
+const (
+	EditOperationAddNode    EditOperationType = "add_node"
+	EditOperationUpdateNode EditOperationType = "update_node"
+	EditOperationMoveNode   EditOperationType = "move_node"
+	EditOperationDeleteNode EditOperationType = "delete_node"
+	EditOperationAddEdge    EditOperationType = "add_edge"
+	EditOperationDeleteEdge EditOperationType = "delete_edge"
+)
+
+type EditOperationDTO struct {
+	ID          string            `json:"id"`
+	Type        EditOperationType `json:"type"`
+	BaseVersion int64             `json:"base_version"`
+	Node        *NodeDTO          `json:"node,omitempty"`
+	NodeID      *uuid.UUID        `json:"node_id,omitempty"`
+	Changes     *NodeChangesDTO   `json:"changes,omitempty"`
+	Position    *Position         `json:"position,omitempty"`
+	Edge        *EdgeDTO          `json:"edge,omitempty"`
+	EdgeID      string            `json:"edge_id,omitempty"`
+}
+
+type NodeChangesDTO struct {
+	Label       *string `json:"label,omitempty"`
+	Type        *string `json:"type,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type EditOperationRequestDTO struct {
+	RoadmapID string           `json:"roadmap_id"`
+	Operation EditOperationDTO `json:"operation"`
+}
+
+type AppliedEditOperationDTO struct {
+	RoadmapID string           `json:"roadmap_id"`
+	Version   int64            `json:"version"`
+	UserID    uuid.UUID        `json:"user_id"`
+	Operation EditOperationDTO `json:"operation"`
+	AppliedAt time.Time        `json:"applied_at"`
+}
+
+type EditingSnapshotDTO struct {
+	RoadmapID    string                  `json:"roadmap_id"`
+	Version      int64                   `json:"version"`
+	CanEdit      bool                    `json:"can_edit"`
+	Roadmap      RoadmapWithMaterialsDTO `json:"roadmap"`
+	Participants []string                `json:"participants"`
+}
@@ -4,9 +4,12 @@ package dto
 
 import (
 	json "encoding/json"
+	entities "github.com/F0urward/proftwist-backend/internal/entities"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +20,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto(in *jlexer.Lexer, out *UpdateRoadmapResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto(in *jlexer.Lexer, out *VoteMaterialResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -31,11 +34,33 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto(i
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "roadmap":
+		case "material_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Roadmap).UnmarshalEasyJSON(in)
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.MaterialID).UnmarshalText(data))
+				}
+			}
+		case "votes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Votes).UnmarshalEasyJSON(in)
+			}
+		case "my_vote":
+			if in.IsNull() {
+				in.Skip()
+				out.MyVote = nil
+			} else {
+				if out.MyVote == nil {
+					out.MyVote = new(MaterialMyVoteDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.MyVote).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -47,42 +72,52 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto(i
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto(out *jwriter.Writer, in UpdateRoadmapResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto(out *jwriter.Writer, in VoteMaterialResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"roadmap\":"
+		const prefix string = ",\"material_id\":"
 		out.RawString(prefix[1:])
-		(in.Roadmap).MarshalEasyJSON(out)
+		out.RawText((in.MaterialID).MarshalText())
+	}
+	{
+		const prefix string = ",\"votes\":"
+		out.RawString(prefix)
+		(in.Votes).MarshalEasyJSON(out)
+	}
+	if in.MyVote != nil {
+		const prefix string = ",\"my_vote\":"
+		out.RawString(prefix)
+		(*in.MyVote).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdateRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
+func (v VoteMaterialResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdateRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VoteMaterialResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdateRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *VoteMaterialResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdateRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VoteMaterialResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(in *jlexer.Lexer, out *UpdateRoadmapRequestDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(in *jlexer.Lexer, out *VoteMaterialRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -96,59 +131,120 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "nodes":
+		case "vote":
 			if in.IsNull() {
 				in.Skip()
-				out.Nodes = nil
+			} else {
+				out.Vote = entities.MaterialVoteValue(in.String())
+			}
+		case "helpful":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Helpful = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(out *jwriter.Writer, in VoteMaterialRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vote\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Vote))
+	}
+	{
+		const prefix string = ",\"helpful\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Helpful))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VoteMaterialRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VoteMaterialRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VoteMaterialRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VoteMaterialRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(in *jlexer.Lexer, out *UpsertNodeQuizRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "questions":
+			if in.IsNull() {
+				in.Skip()
+				out.Questions = nil
 			} else {
 				in.Delim('[')
-				if out.Nodes == nil {
+				if out.Questions == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]NodeDTO, 0, 0)
+						out.Questions = make([]QuizQuestionDTO, 0, 0)
 					} else {
-						out.Nodes = []NodeDTO{}
+						out.Questions = []QuizQuestionDTO{}
 					}
 				} else {
-					out.Nodes = (out.Nodes)[:0]
+					out.Questions = (out.Questions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 NodeDTO
+					var v1 QuizQuestionDTO
 					if in.IsNull() {
 						in.Skip()
 					} else {
 						(v1).UnmarshalEasyJSON(in)
 					}
-					out.Nodes = append(out.Nodes, v1)
+					out.Questions = append(out.Questions, v1)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "edges":
+		case "passing_score":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
 			} else {
-				in.Delim('[')
-				if out.Edges == nil {
-					if !in.IsDelim(']') {
-						out.Edges = make([]EdgeDTO, 0, 1)
-					} else {
-						out.Edges = []EdgeDTO{}
-					}
-				} else {
-					out.Edges = (out.Edges)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v2 EdgeDTO
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v2).UnmarshalEasyJSON(in)
-					}
-					out.Edges = append(out.Edges, v2)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.PassingScore = int(in.Int())
+			}
+		case "auto_complete":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AutoComplete = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -160,71 +256,63 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(out *jwriter.Writer, in UpdateRoadmapRequestDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(out *jwriter.Writer, in UpsertNodeQuizRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if len(in.Nodes) != 0 {
-		const prefix string = ",\"nodes\":"
-		first = false
+	{
+		const prefix string = ",\"questions\":"
 		out.RawString(prefix[1:])
-		{
-			out.RawByte('[')
-			for v3, v4 := range in.Nodes {
-				if v3 > 0 {
-					out.RawByte(',')
-				}
-				(v4).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.Edges) != 0 {
-		const prefix string = ",\"edges\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		if in.Questions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
-		}
-		{
 			out.RawByte('[')
-			for v5, v6 := range in.Edges {
-				if v5 > 0 {
+			for v2, v3 := range in.Questions {
+				if v2 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v3).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"passing_score\":"
+		out.RawString(prefix)
+		out.Int(int(in.PassingScore))
+	}
+	{
+		const prefix string = ",\"auto_complete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoComplete))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdateRoadmapRequestDTO) MarshalJSON() ([]byte, error) {
+func (v UpsertNodeQuizRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdateRoadmapRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(w, v)
+func (v UpsertNodeQuizRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdateRoadmapRequestDTO) UnmarshalJSON(data []byte) error {
+func (v *UpsertNodeQuizRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdateRoadmapRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto1(l, v)
+func (v *UpsertNodeQuizRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(in *jlexer.Lexer, out *UpdateNodeProgressRequestDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(in *jlexer.Lexer, out *UpsertNodeNoteRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -238,19 +326,11 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "node_id":
+		case "content":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.NodeID).UnmarshalText(data))
-				}
-			}
-		case "status":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Status = NodeProgressStatus(in.String())
+				out.Content = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -262,47 +342,42 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(out *jwriter.Writer, in UpdateNodeProgressRequestDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(out *jwriter.Writer, in UpsertNodeNoteRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"node_id\":"
+		const prefix string = ",\"content\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.NodeID).MarshalText())
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.String(string(in.Status))
+		out.String(string(in.Content))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdateNodeProgressRequestDTO) MarshalJSON() ([]byte, error) {
+func (v UpsertNodeNoteRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdateNodeProgressRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(w, v)
+func (v UpsertNodeNoteRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdateNodeProgressRequestDTO) UnmarshalJSON(data []byte) error {
+func (v *UpsertNodeNoteRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdateNodeProgressRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto2(l, v)
+func (v *UpsertNodeNoteRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(in *jlexer.Lexer, out *RoadmapWithProgressDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(in *jlexer.Lexer, out *UpsertLearningGoalRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -316,82 +391,32 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "_id":
+		case "deadline":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				if data := in.Raw(); in.Ok() {
-					in.AddError((out.ID).UnmarshalJSON(data))
+					in.AddError((out.Deadline).UnmarshalJSON(data))
 				}
 			}
-		case "nodes":
-			if in.IsNull() {
-				in.Skip()
-				out.Nodes = nil
-			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]NodeWithProgressDTO, 0, 0)
-					} else {
-						out.Nodes = []NodeWithProgressDTO{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 NodeWithProgressDTO
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v7).UnmarshalEasyJSON(in)
-					}
-					out.Nodes = append(out.Nodes, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "edges":
+		case "weekly_pace":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
 			} else {
-				in.Delim('[')
-				if out.Edges == nil {
-					if !in.IsDelim(']') {
-						out.Edges = make([]EdgeDTO, 0, 1)
-					} else {
-						out.Edges = []EdgeDTO{}
-					}
-				} else {
-					out.Edges = (out.Edges)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v8 EdgeDTO
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v8).UnmarshalEasyJSON(in)
-					}
-					out.Edges = append(out.Edges, v8)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.WeeklyPace = int(in.Int())
 			}
-		case "created_at":
+		case "reminders_enabled":
 			if in.IsNull() {
 				in.Skip()
+				out.RemindersEnabled = nil
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				if out.RemindersEnabled == nil {
+					out.RemindersEnabled = new(bool)
 				}
-			}
-		case "updated_at":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.RemindersEnabled = bool(in.Bool())
 				}
 			}
 		default:
@@ -404,96 +429,106 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(out *jwriter.Writer, in RoadmapWithProgressDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(out *jwriter.Writer, in UpsertLearningGoalRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if true {
-		const prefix string = ",\"_id\":"
-		first = false
+	{
+		const prefix string = ",\"deadline\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.ID).MarshalJSON())
+		out.Raw((in.Deadline).MarshalJSON())
 	}
-	if len(in.Nodes) != 0 {
-		const prefix string = ",\"nodes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v9, v10 := range in.Nodes {
-				if v9 > 0 {
-					out.RawByte(',')
-				}
-				(v10).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+	{
+		const prefix string = ",\"weekly_pace\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeeklyPace))
 	}
-	if len(in.Edges) != 0 {
-		const prefix string = ",\"edges\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v11, v12 := range in.Edges {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+	if in.RemindersEnabled != nil {
+		const prefix string = ",\"reminders_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.RemindersEnabled))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpsertLearningGoalRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpsertLearningGoalRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpsertLearningGoalRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpsertLearningGoalRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(in *jlexer.Lexer, out *UploadMaterialRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"created_at\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		default:
+			in.SkipRecursive()
 		}
-		out.Raw((in.CreatedAt).MarshalJSON())
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(out *jwriter.Writer, in UploadMaterialRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RoadmapWithProgressDTO) MarshalJSON() ([]byte, error) {
+func (v UploadMaterialRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RoadmapWithProgressDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(w, v)
+func (v UploadMaterialRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RoadmapWithProgressDTO) UnmarshalJSON(data []byte) error {
+func (v *UploadMaterialRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RoadmapWithProgressDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto3(l, v)
+func (v *UploadMaterialRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(in *jlexer.Lexer, out *RoadmapWithMaterialsDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(in *jlexer.Lexer, out *UpdateRoadmapResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -507,37 +542,94 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "_id":
+		case "roadmap":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.ID).UnmarshalJSON(data))
-				}
+				(out.Roadmap).UnmarshalEasyJSON(in)
 			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(out *jwriter.Writer, in UpdateRoadmapResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap\":"
+		out.RawString(prefix[1:])
+		(in.Roadmap).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(in *jlexer.Lexer, out *UpdateRoadmapRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
 		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.NodesWithMaterials = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.NodesWithMaterials == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.NodesWithMaterials = make([]NodeWithMaterialsDTO, 0, 0)
+						out.Nodes = make([]NodeDTO, 0, 0)
 					} else {
-						out.NodesWithMaterials = []NodeWithMaterialsDTO{}
+						out.Nodes = []NodeDTO{}
 					}
 				} else {
-					out.NodesWithMaterials = (out.NodesWithMaterials)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 NodeWithMaterialsDTO
+					var v4 NodeDTO
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v13).UnmarshalEasyJSON(in)
+						(v4).UnmarshalEasyJSON(in)
 					}
-					out.NodesWithMaterials = append(out.NodesWithMaterials, v13)
+					out.Nodes = append(out.Nodes, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -558,32 +650,54 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(
 					out.Edges = (out.Edges)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v14 EdgeDTO
+					var v5 EdgeDTO
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v14).UnmarshalEasyJSON(in)
+						(v5).UnmarshalEasyJSON(in)
 					}
-					out.Edges = append(out.Edges, v14)
+					out.Edges = append(out.Edges, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "created_at":
+		case "enforce_prerequisites":
 			if in.IsNull() {
 				in.Skip()
+				out.EnforcePrerequisites = nil
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				if out.EnforcePrerequisites == nil {
+					out.EnforcePrerequisites = new(bool)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.EnforcePrerequisites = bool(in.Bool())
 				}
 			}
-		case "updated_at":
+		case "node_id_map":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.NodeIDMap = make(map[string]string)
+				} else {
+					out.NodeIDMap = nil
 				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v6 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v6 = string(in.String())
+					}
+					(out.NodeIDMap)[key] = v6
+					in.WantComma()
+				}
+				in.Delim('}')
 			}
 		default:
 			in.SkipRecursive()
@@ -595,31 +709,21 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(out *jwriter.Writer, in RoadmapWithMaterialsDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(out *jwriter.Writer, in UpdateRoadmapRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if true {
-		const prefix string = ",\"_id\":"
+	if len(in.Nodes) != 0 {
+		const prefix string = ",\"nodes\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.Raw((in.ID).MarshalJSON())
-	}
-	if len(in.NodesWithMaterials) != 0 {
-		const prefix string = ",\"nodes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
 		{
 			out.RawByte('[')
-			for v15, v16 := range in.NodesWithMaterials {
-				if v15 > 0 {
+			for v7, v8 := range in.Nodes {
+				if v7 > 0 {
 					out.RawByte(',')
 				}
-				(v16).MarshalEasyJSON(out)
+				(v8).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -634,57 +738,76 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(
 		}
 		{
 			out.RawByte('[')
-			for v17, v18 := range in.Edges {
-				if v17 > 0 {
+			for v9, v10 := range in.Edges {
+				if v9 > 0 {
 					out.RawByte(',')
 				}
-				(v18).MarshalEasyJSON(out)
+				(v10).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"created_at\":"
+	if in.EnforcePrerequisites != nil {
+		const prefix string = ",\"enforce_prerequisites\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Bool(bool(*in.EnforcePrerequisites))
 	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	if len(in.NodeIDMap) != 0 {
+		const prefix string = ",\"node_id_map\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v11First := true
+			for v11Name, v11Value := range in.NodeIDMap {
+				if v11First {
+					v11First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v11Name))
+				out.RawByte(':')
+				out.String(string(v11Value))
+			}
+			out.RawByte('}')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RoadmapWithMaterialsDTO) MarshalJSON() ([]byte, error) {
+func (v UpdateRoadmapRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RoadmapWithMaterialsDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(w, v)
+func (v UpdateRoadmapRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RoadmapWithMaterialsDTO) UnmarshalJSON(data []byte) error {
+func (v *UpdateRoadmapRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RoadmapWithMaterialsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto4(l, v)
+func (v *UpdateRoadmapRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(in *jlexer.Lexer, out *RoadmapDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(in *jlexer.Lexer, out *UpdateNodeProgressRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -698,82 +821,38 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "_id":
+		case "node_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.ID).UnmarshalJSON(data))
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
 				}
 			}
-		case "nodes":
+		case "status":
 			if in.IsNull() {
 				in.Skip()
-				out.Nodes = nil
 			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]NodeDTO, 0, 0)
-					} else {
-						out.Nodes = []NodeDTO{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v19 NodeDTO
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v19).UnmarshalEasyJSON(in)
-					}
-					out.Nodes = append(out.Nodes, v19)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Status = NodeProgressStatus(in.String())
 			}
-		case "edges":
+		case "time_spent_minutes":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
 			} else {
-				in.Delim('[')
-				if out.Edges == nil {
-					if !in.IsDelim(']') {
-						out.Edges = make([]EdgeDTO, 0, 1)
-					} else {
-						out.Edges = []EdgeDTO{}
-					}
-				} else {
-					out.Edges = (out.Edges)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v20 EdgeDTO
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v20).UnmarshalEasyJSON(in)
-					}
-					out.Edges = append(out.Edges, v20)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.TimeSpentMinutes = int(in.Int())
 			}
-		case "created_at":
+		case "confidence":
 			if in.IsNull() {
 				in.Skip()
+				out.Confidence = nil
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				if out.Confidence == nil {
+					out.Confidence = new(int)
 				}
-			}
-		case "updated_at":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Confidence = int(in.Int())
 				}
 			}
 		default:
@@ -786,96 +865,57 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(out *jwriter.Writer, in RoadmapDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(out *jwriter.Writer, in UpdateNodeProgressRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if true {
-		const prefix string = ",\"_id\":"
-		first = false
+	{
+		const prefix string = ",\"node_id\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.ID).MarshalJSON())
-	}
-	if len(in.Nodes) != 0 {
-		const prefix string = ",\"nodes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v21, v22 := range in.Nodes {
-				if v21 > 0 {
-					out.RawByte(',')
-				}
-				(v22).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.RawText((in.NodeID).MarshalText())
 	}
-	if len(in.Edges) != 0 {
-		const prefix string = ",\"edges\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v23, v24 := range in.Edges {
-				if v23 > 0 {
-					out.RawByte(',')
-				}
-				(v24).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"created_at\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Raw((in.CreatedAt).MarshalJSON())
+	if in.TimeSpentMinutes != 0 {
+		const prefix string = ",\"time_spent_minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.TimeSpentMinutes))
 	}
-	{
-		const prefix string = ",\"updated_at\":"
+	if in.Confidence != nil {
+		const prefix string = ",\"confidence\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.Int(int(*in.Confidence))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RoadmapDTO) MarshalJSON() ([]byte, error) {
+func (v UpdateNodeProgressRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RoadmapDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(w, v)
+func (v UpdateNodeProgressRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RoadmapDTO) UnmarshalJSON(data []byte) error {
+func (v *UpdateNodeProgressRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RoadmapDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto5(l, v)
+func (v *UpdateNodeProgressRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(in *jlexer.Lexer, out *Position) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(in *jlexer.Lexer, out *SuggestedMaterialDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -889,17 +929,23 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "x":
+		case "name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.X = float64(in.Float64())
+				out.Name = string(in.String())
 			}
-		case "y":
+		case "type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Y = float64(in.Float64())
+				out.Type = entities.MaterialType(in.String())
+			}
+		case "url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.URL = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -911,47 +957,52 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(out *jwriter.Writer, in Position) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(out *jwriter.Writer, in SuggestedMaterialDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"x\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.X))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"y\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Y))
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Position) MarshalJSON() ([]byte, error) {
+func (v SuggestedMaterialDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(w, v)
+func (v SuggestedMaterialDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Position) UnmarshalJSON(data []byte) error {
+func (v *SuggestedMaterialDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto6(l, v)
+func (v *SuggestedMaterialDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(in *jlexer.Lexer, out *NodeWithProgressDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(in *jlexer.Lexer, out *SuggestNodeContentResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -965,49 +1016,21 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "id":
+		case "roadmapId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.ID).UnmarshalText(data))
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.RoadmapID).UnmarshalJSON(data))
 				}
 			}
-		case "type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
-		case "position":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Position).UnmarshalEasyJSON(in)
-			}
-		case "data":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Data).UnmarshalEasyJSON(in)
-			}
-		case "measured":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Measured).UnmarshalEasyJSON(in)
-			}
-		case "selected":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Selected = bool(in.Bool())
-			}
-		case "dragging":
+		case "nodeId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Dragging = bool(in.Bool())
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
 			}
 		case "description":
 			if in.IsNull() {
@@ -1015,19 +1038,38 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(
 			} else {
 				out.Description = string(in.String())
 			}
-		case "progress":
+		case "materials":
 			if in.IsNull() {
 				in.Skip()
-				out.Progress = nil
+				out.Materials = nil
 			} else {
-				if out.Progress == nil {
-					out.Progress = new(NodeProgress)
-				}
-				if in.IsNull() {
-					in.Skip()
+				in.Delim('[')
+				if out.Materials == nil {
+					if !in.IsDelim(']') {
+						out.Materials = make([]SuggestedMaterialDTO, 0, 1)
+					} else {
+						out.Materials = []SuggestedMaterialDTO{}
+					}
 				} else {
-					(*out.Progress).UnmarshalEasyJSON(in)
+					out.Materials = (out.Materials)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 SuggestedMaterialDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v12).UnmarshalEasyJSON(in)
+					}
+					out.Materials = append(out.Materials, v12)
+					in.WantComma()
 				}
+				in.Delim(']')
+			}
+		case "rejected_materials":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RejectedMaterials = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -1039,82 +1081,7322 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(out *jwriter.Writer, in NodeWithProgressDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(out *jwriter.Writer, in SuggestNodeContentResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"roadmapId\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Raw((in.RoadmapID).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"position\":"
+		const prefix string = ",\"nodeId\":"
 		out.RawString(prefix)
-		(in.Position).MarshalEasyJSON(out)
+		out.RawText((in.NodeID).MarshalText())
 	}
 	{
-		const prefix string = ",\"data\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		(in.Data).MarshalEasyJSON(out)
+		out.String(string(in.Description))
 	}
 	{
-		const prefix string = ",\"measured\":"
+		const prefix string = ",\"materials\":"
 		out.RawString(prefix)
-		(in.Measured).MarshalEasyJSON(out)
+		if in.Materials == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v13, v14 := range in.Materials {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				(v14).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"selected\":"
+		const prefix string = ",\"rejected_materials\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Selected))
+		out.Int(int(in.RejectedMaterials))
 	}
-	{
-		const prefix string = ",\"dragging\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Dragging))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestNodeContentResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestNodeContentResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestNodeContentResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestNodeContentResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(in *jlexer.Lexer, out *SuggestNodeContentRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "instructions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Instructions = string(in.String())
+			}
+		case "complexity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Complexity = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(out *jwriter.Writer, in SuggestNodeContentRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"instructions\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Instructions))
+	}
+	{
+		const prefix string = ",\"complexity\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.Complexity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestNodeContentRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestNodeContentRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestNodeContentRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestNodeContentRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(in *jlexer.Lexer, out *SubmitQuizAttemptRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "answers":
+			if in.IsNull() {
+				in.Skip()
+				out.Answers = nil
+			} else {
+				in.Delim('[')
+				if out.Answers == nil {
+					if !in.IsDelim(']') {
+						out.Answers = make([]QuizAnswerDTO, 0, 1)
+					} else {
+						out.Answers = []QuizAnswerDTO{}
+					}
+				} else {
+					out.Answers = (out.Answers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 QuizAnswerDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v15).UnmarshalEasyJSON(in)
+					}
+					out.Answers = append(out.Answers, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(out *jwriter.Writer, in SubmitQuizAttemptRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"answers\":"
+		out.RawString(prefix[1:])
+		if in.Answers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Answers {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				(v17).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubmitQuizAttemptRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubmitQuizAttemptRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubmitQuizAttemptRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubmitQuizAttemptRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(in *jlexer.Lexer, out *RoadmapWithProgressDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ID).UnmarshalJSON(data))
+				}
+			}
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]NodeWithProgressDTO, 0, 0)
+					} else {
+						out.Nodes = []NodeWithProgressDTO{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 NodeWithProgressDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v18).UnmarshalEasyJSON(in)
+					}
+					out.Nodes = append(out.Nodes, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]EdgeDTO, 0, 1)
+					} else {
+						out.Edges = []EdgeDTO{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 EdgeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v19).UnmarshalEasyJSON(in)
+					}
+					out.Edges = append(out.Edges, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "enforce_prerequisites":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EnforcePrerequisites = bool(in.Bool())
+			}
+		case "updated_by":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedBy = nil
+			} else {
+				if out.UpdatedBy == nil {
+					out.UpdatedBy = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.UpdatedBy).UnmarshalText(data))
+					}
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(out *jwriter.Writer, in RoadmapWithProgressDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Raw((in.ID).MarshalJSON())
+	}
+	if len(in.Nodes) != 0 {
+		const prefix string = ",\"nodes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v20, v21 := range in.Nodes {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Edges) != 0 {
+		const prefix string = ",\"edges\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v22, v23 := range in.Edges {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				(v23).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"enforce_prerequisites\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.EnforcePrerequisites))
+	}
+	if in.UpdatedBy != nil {
+		const prefix string = ",\"updated_by\":"
+		out.RawString(prefix)
+		out.RawText((*in.UpdatedBy).MarshalText())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapWithProgressDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapWithProgressDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapWithProgressDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapWithProgressDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(in *jlexer.Lexer, out *RoadmapWithMaterialsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ID).UnmarshalJSON(data))
+				}
+			}
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.NodesWithMaterials = nil
+			} else {
+				in.Delim('[')
+				if out.NodesWithMaterials == nil {
+					if !in.IsDelim(']') {
+						out.NodesWithMaterials = make([]NodeWithMaterialsDTO, 0, 0)
+					} else {
+						out.NodesWithMaterials = []NodeWithMaterialsDTO{}
+					}
+				} else {
+					out.NodesWithMaterials = (out.NodesWithMaterials)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 NodeWithMaterialsDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v24).UnmarshalEasyJSON(in)
+					}
+					out.NodesWithMaterials = append(out.NodesWithMaterials, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]EdgeDTO, 0, 1)
+					} else {
+						out.Edges = []EdgeDTO{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 EdgeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v25).UnmarshalEasyJSON(in)
+					}
+					out.Edges = append(out.Edges, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "updated_by":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedBy = nil
+			} else {
+				if out.UpdatedBy == nil {
+					out.UpdatedBy = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.UpdatedBy).UnmarshalText(data))
+					}
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(out *jwriter.Writer, in RoadmapWithMaterialsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Raw((in.ID).MarshalJSON())
+	}
+	if len(in.NodesWithMaterials) != 0 {
+		const prefix string = ",\"nodes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v26, v27 := range in.NodesWithMaterials {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Edges) != 0 {
+		const prefix string = ",\"edges\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v28, v29 := range in.Edges {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				(v29).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.UpdatedBy != nil {
+		const prefix string = ",\"updated_by\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.UpdatedBy).MarshalText())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapWithMaterialsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapWithMaterialsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapWithMaterialsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapWithMaterialsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(in *jlexer.Lexer, out *RoadmapExportDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(out *jwriter.Writer, in RoadmapExportDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapExportDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapExportDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapExportDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapExportDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(in *jlexer.Lexer, out *RoadmapDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ID).UnmarshalJSON(data))
+				}
+			}
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]NodeDTO, 0, 0)
+					} else {
+						out.Nodes = []NodeDTO{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 NodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v30).UnmarshalEasyJSON(in)
+					}
+					out.Nodes = append(out.Nodes, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]EdgeDTO, 0, 1)
+					} else {
+						out.Edges = []EdgeDTO{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 EdgeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v31).UnmarshalEasyJSON(in)
+					}
+					out.Edges = append(out.Edges, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(out *jwriter.Writer, in RoadmapDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Raw((in.ID).MarshalJSON())
+	}
+	if len(in.Nodes) != 0 {
+		const prefix string = ",\"nodes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v32, v33 := range in.Nodes {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				(v33).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Edges) != 0 {
+		const prefix string = ",\"edges\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v34, v35 := range in.Edges {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				(v35).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoadmapDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoadmapDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoadmapDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoadmapDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(in *jlexer.Lexer, out *RegenerateSubtreeDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Topic":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Topic = string(in.String())
+			}
+		case "Description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "Complexity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Complexity = string(in.String())
+			}
+		case "Instructions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Instructions = string(in.String())
+			}
+		case "Mode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Mode = RegenerationMode(in.String())
+			}
+		case "Node":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Node).UnmarshalEasyJSON(in)
+			}
+		case "Ancestors":
+			if in.IsNull() {
+				in.Skip()
+				out.Ancestors = nil
+			} else {
+				in.Delim('[')
+				if out.Ancestors == nil {
+					if !in.IsDelim(']') {
+						out.Ancestors = make([]NodeDTO, 0, 0)
+					} else {
+						out.Ancestors = []NodeDTO{}
+					}
+				} else {
+					out.Ancestors = (out.Ancestors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v36 NodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v36).UnmarshalEasyJSON(in)
+					}
+					out.Ancestors = append(out.Ancestors, v36)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Siblings":
+			if in.IsNull() {
+				in.Skip()
+				out.Siblings = nil
+			} else {
+				in.Delim('[')
+				if out.Siblings == nil {
+					if !in.IsDelim(']') {
+						out.Siblings = make([]NodeDTO, 0, 0)
+					} else {
+						out.Siblings = []NodeDTO{}
+					}
+				} else {
+					out.Siblings = (out.Siblings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 NodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v37).UnmarshalEasyJSON(in)
+					}
+					out.Siblings = append(out.Siblings, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Subtree":
+			if in.IsNull() {
+				in.Skip()
+				out.Subtree = nil
+			} else {
+				in.Delim('[')
+				if out.Subtree == nil {
+					if !in.IsDelim(']') {
+						out.Subtree = make([]NodeDTO, 0, 0)
+					} else {
+						out.Subtree = []NodeDTO{}
+					}
+				} else {
+					out.Subtree = (out.Subtree)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 NodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v38).UnmarshalEasyJSON(in)
+					}
+					out.Subtree = append(out.Subtree, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(out *jwriter.Writer, in RegenerateSubtreeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Topic\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"Complexity\":"
+		out.RawString(prefix)
+		out.String(string(in.Complexity))
+	}
+	{
+		const prefix string = ",\"Instructions\":"
+		out.RawString(prefix)
+		out.String(string(in.Instructions))
+	}
+	{
+		const prefix string = ",\"Mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"Node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Ancestors\":"
+		out.RawString(prefix)
+		if in.Ancestors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v39, v40 := range in.Ancestors {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				(v40).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Siblings\":"
+		out.RawString(prefix)
+		if in.Siblings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Siblings {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Subtree\":"
+		out.RawString(prefix)
+		if in.Subtree == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v43, v44 := range in.Subtree {
+				if v43 > 0 {
+					out.RawByte(',')
+				}
+				(v44).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RegenerateSubtreeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RegenerateSubtreeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RegenerateSubtreeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RegenerateSubtreeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(in *jlexer.Lexer, out *RegenerateNodeResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmapId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.RoadmapID).UnmarshalJSON(data))
+				}
+			}
+		case "nodeId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
+			}
+		case "added_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AddedNodes = int(in.Int())
+			}
+		case "removed_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RemovedNodes = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(out *jwriter.Writer, in RegenerateNodeResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmapId\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.RoadmapID).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"nodeId\":"
+		out.RawString(prefix)
+		out.RawText((in.NodeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"added_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.AddedNodes))
+	}
+	{
+		const prefix string = ",\"removed_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.RemovedNodes))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RegenerateNodeResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RegenerateNodeResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RegenerateNodeResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RegenerateNodeResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(in *jlexer.Lexer, out *RegenerateNodeRequestDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "instructions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Instructions = string(in.String())
+			}
+		case "mode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Mode = RegenerationMode(in.String())
+			}
+		case "complexity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Complexity = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(out *jwriter.Writer, in RegenerateNodeRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"instructions\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Instructions))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"complexity\":"
+		out.RawString(prefix)
+		out.String(string(in.Complexity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RegenerateNodeRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RegenerateNodeRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RegenerateNodeRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RegenerateNodeRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(in *jlexer.Lexer, out *QuizQuestionDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = entities.QuizQuestionType(in.String())
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]QuizOptionDTO, 0, 1)
+					} else {
+						out.Options = []QuizOptionDTO{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v45 QuizOptionDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v45).UnmarshalEasyJSON(in)
+					}
+					out.Options = append(out.Options, v45)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "accepted_answers":
+			if in.IsNull() {
+				in.Skip()
+				out.AcceptedAnswers = nil
+			} else {
+				in.Delim('[')
+				if out.AcceptedAnswers == nil {
+					if !in.IsDelim(']') {
+						out.AcceptedAnswers = make([]string, 0, 4)
+					} else {
+						out.AcceptedAnswers = []string{}
+					}
+				} else {
+					out.AcceptedAnswers = (out.AcceptedAnswers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v46 = string(in.String())
+					}
+					out.AcceptedAnswers = append(out.AcceptedAnswers, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "explanation":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Explanation = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(out *jwriter.Writer, in QuizQuestionDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v47, v48 := range in.Options {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				(v48).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AcceptedAnswers) != 0 {
+		const prefix string = ",\"accepted_answers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v49, v50 := range in.AcceptedAnswers {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v50))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Explanation != "" {
+		const prefix string = ",\"explanation\":"
+		out.RawString(prefix)
+		out.String(string(in.Explanation))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizQuestionDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizQuestionDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizQuestionDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizQuestionDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(in *jlexer.Lexer, out *QuizOptionDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "correct":
+			if in.IsNull() {
+				in.Skip()
+				out.Correct = nil
+			} else {
+				if out.Correct == nil {
+					out.Correct = new(bool)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Correct = bool(in.Bool())
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(out *jwriter.Writer, in QuizOptionDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.Correct != nil {
+		const prefix string = ",\"correct\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.Correct))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizOptionDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizOptionDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizOptionDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizOptionDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(in *jlexer.Lexer, out *QuizAttemptResultDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "attempt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Attempt).UnmarshalEasyJSON(in)
+			}
+		case "answers":
+			if in.IsNull() {
+				in.Skip()
+				out.Answers = nil
+			} else {
+				in.Delim('[')
+				if out.Answers == nil {
+					if !in.IsDelim(']') {
+						out.Answers = make([]QuizAnswerResultDTO, 0, 0)
+					} else {
+						out.Answers = []QuizAnswerResultDTO{}
+					}
+				} else {
+					out.Answers = (out.Answers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v51 QuizAnswerResultDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v51).UnmarshalEasyJSON(in)
+					}
+					out.Answers = append(out.Answers, v51)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "node_completed":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NodeCompleted = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(out *jwriter.Writer, in QuizAttemptResultDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attempt\":"
+		out.RawString(prefix[1:])
+		(in.Attempt).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"answers\":"
+		out.RawString(prefix)
+		if in.Answers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v52, v53 := range in.Answers {
+				if v52 > 0 {
+					out.RawByte(',')
+				}
+				(v53).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"node_completed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.NodeCompleted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizAttemptResultDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizAttemptResultDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizAttemptResultDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizAttemptResultDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(in *jlexer.Lexer, out *QuizAttemptListResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "attempts":
+			if in.IsNull() {
+				in.Skip()
+				out.Attempts = nil
+			} else {
+				in.Delim('[')
+				if out.Attempts == nil {
+					if !in.IsDelim(']') {
+						out.Attempts = make([]QuizAttemptDTO, 0, 0)
+					} else {
+						out.Attempts = []QuizAttemptDTO{}
+					}
+				} else {
+					out.Attempts = (out.Attempts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v54 QuizAttemptDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v54).UnmarshalEasyJSON(in)
+					}
+					out.Attempts = append(out.Attempts, v54)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = int(in.Int())
+			}
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int(in.Int())
+			}
+		case "offset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Offset = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(out *jwriter.Writer, in QuizAttemptListResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix[1:])
+		if in.Attempts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.Attempts {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				(v56).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizAttemptListResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizAttemptListResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizAttemptListResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizAttemptListResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(in *jlexer.Lexer, out *QuizAttemptDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = string(in.String())
+			}
+		case "correct_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CorrectCount = int(in.Int())
+			}
+		case "total_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalCount = int(in.Int())
+			}
+		case "score":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Score = int(in.Int())
+			}
+		case "passed":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Passed = bool(in.Bool())
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(out *jwriter.Writer, in QuizAttemptDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"correct_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CorrectCount))
+	}
+	{
+		const prefix string = ",\"total_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"passed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Passed))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizAttemptDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizAttemptDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizAttemptDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizAttemptDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(in *jlexer.Lexer, out *QuizAnswerResultDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "question_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.QuestionID).UnmarshalText(data))
+				}
+			}
+		case "option_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.OptionIDs = nil
+			} else {
+				in.Delim('[')
+				if out.OptionIDs == nil {
+					if !in.IsDelim(']') {
+						out.OptionIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.OptionIDs = []uuid.UUID{}
+					}
+				} else {
+					out.OptionIDs = (out.OptionIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v57 uuid.UUID
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						if data := in.UnsafeBytes(); in.Ok() {
+							in.AddError((v57).UnmarshalText(data))
+						}
+					}
+					out.OptionIDs = append(out.OptionIDs, v57)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "correct":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Correct = bool(in.Bool())
+			}
+		case "correct_option_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.CorrectOptionIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CorrectOptionIDs == nil {
+					if !in.IsDelim(']') {
+						out.CorrectOptionIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.CorrectOptionIDs = []uuid.UUID{}
+					}
+				} else {
+					out.CorrectOptionIDs = (out.CorrectOptionIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 uuid.UUID
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						if data := in.UnsafeBytes(); in.Ok() {
+							in.AddError((v58).UnmarshalText(data))
+						}
+					}
+					out.CorrectOptionIDs = append(out.CorrectOptionIDs, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "accepted_answers":
+			if in.IsNull() {
+				in.Skip()
+				out.AcceptedAnswers = nil
+			} else {
+				in.Delim('[')
+				if out.AcceptedAnswers == nil {
+					if !in.IsDelim(']') {
+						out.AcceptedAnswers = make([]string, 0, 4)
+					} else {
+						out.AcceptedAnswers = []string{}
+					}
+				} else {
+					out.AcceptedAnswers = (out.AcceptedAnswers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v59 = string(in.String())
+					}
+					out.AcceptedAnswers = append(out.AcceptedAnswers, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "explanation":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Explanation = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(out *jwriter.Writer, in QuizAnswerResultDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.QuestionID).MarshalText())
+	}
+	if len(in.OptionIDs) != 0 {
+		const prefix string = ",\"option_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v60, v61 := range in.OptionIDs {
+				if v60 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v61).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Text != "" {
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"correct\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Correct))
+	}
+	if len(in.CorrectOptionIDs) != 0 {
+		const prefix string = ",\"correct_option_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v62, v63 := range in.CorrectOptionIDs {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v63).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AcceptedAnswers) != 0 {
+		const prefix string = ",\"accepted_answers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v64, v65 := range in.AcceptedAnswers {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v65))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Explanation != "" {
+		const prefix string = ",\"explanation\":"
+		out.RawString(prefix)
+		out.String(string(in.Explanation))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizAnswerResultDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizAnswerResultDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizAnswerResultDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizAnswerResultDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(in *jlexer.Lexer, out *QuizAnswerDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "question_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.QuestionID).UnmarshalText(data))
+				}
+			}
+		case "option_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.OptionIDs = nil
+			} else {
+				in.Delim('[')
+				if out.OptionIDs == nil {
+					if !in.IsDelim(']') {
+						out.OptionIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.OptionIDs = []uuid.UUID{}
+					}
+				} else {
+					out.OptionIDs = (out.OptionIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v66 uuid.UUID
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						if data := in.UnsafeBytes(); in.Ok() {
+							in.AddError((v66).UnmarshalText(data))
+						}
+					}
+					out.OptionIDs = append(out.OptionIDs, v66)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(out *jwriter.Writer, in QuizAnswerDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.QuestionID).MarshalText())
+	}
+	if len(in.OptionIDs) != 0 {
+		const prefix string = ",\"option_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v67, v68 := range in.OptionIDs {
+				if v67 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v68).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Text != "" {
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuizAnswerDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuizAnswerDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuizAnswerDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuizAnswerDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(in *jlexer.Lexer, out *ProgressTimelineResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]ProgressEventDTO, 0, 0)
+					} else {
+						out.Events = []ProgressEventDTO{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v69 ProgressEventDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v69).UnmarshalEasyJSON(in)
+					}
+					out.Events = append(out.Events, v69)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = int(in.Int())
+			}
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int(in.Int())
+			}
+		case "offset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Offset = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(out *jwriter.Writer, in ProgressTimelineResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix)
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v70, v71 := range in.Events {
+				if v70 > 0 {
+					out.RawByte(',')
+				}
+				(v71).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProgressTimelineResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProgressTimelineResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProgressTimelineResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProgressTimelineResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(in *jlexer.Lexer, out *ProgressSummaryNodeDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Label = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = NodeProgressStatus(in.String())
+			}
+		case "status_label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StatusLabel = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(out *jwriter.Writer, in ProgressSummaryNodeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"status_label\":"
+		out.RawString(prefix)
+		out.String(string(in.StatusLabel))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProgressSummaryNodeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProgressSummaryNodeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProgressSummaryNodeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProgressSummaryNodeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto29(in *jlexer.Lexer, out *ProgressSummaryDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "total_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalNodes = int(in.Int())
+			}
+		case "done_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DoneCount = int(in.Int())
+			}
+		case "in_progress_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InProgressCount = int(in.Int())
+			}
+		case "skipped_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SkippedCount = int(in.Int())
+			}
+		case "pending_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PendingCount = int(in.Int())
+			}
+		case "completion_percent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CompletionPercent = float64(in.Float64())
+			}
+		case "time_spent_minutes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeSpentMinutes = int(in.Int())
+			}
+		case "next_nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.NextNodes = nil
+			} else {
+				in.Delim('[')
+				if out.NextNodes == nil {
+					if !in.IsDelim(']') {
+						out.NextNodes = make([]ProgressSummaryNodeDTO, 0, 1)
+					} else {
+						out.NextNodes = []ProgressSummaryNodeDTO{}
+					}
+				} else {
+					out.NextNodes = (out.NextNodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 ProgressSummaryNodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v72).UnmarshalEasyJSON(in)
+					}
+					out.NextNodes = append(out.NextNodes, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "last_activity_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LastActivityAt = nil
+			} else {
+				if out.LastActivityAt == nil {
+					out.LastActivityAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.LastActivityAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto29(out *jwriter.Writer, in ProgressSummaryDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"total_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalNodes))
+	}
+	{
+		const prefix string = ",\"done_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.DoneCount))
+	}
+	{
+		const prefix string = ",\"in_progress_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.InProgressCount))
+	}
+	{
+		const prefix string = ",\"skipped_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.SkippedCount))
+	}
+	{
+		const prefix string = ",\"pending_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.PendingCount))
+	}
+	{
+		const prefix string = ",\"completion_percent\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.CompletionPercent))
+	}
+	{
+		const prefix string = ",\"time_spent_minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.TimeSpentMinutes))
+	}
+	{
+		const prefix string = ",\"next_nodes\":"
+		out.RawString(prefix)
+		if in.NextNodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v73, v74 := range in.NextNodes {
+				if v73 > 0 {
+					out.RawByte(',')
+				}
+				(v74).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.LastActivityAt != nil {
+		const prefix string = ",\"last_activity_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastActivityAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProgressSummaryDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProgressSummaryDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProgressSummaryDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProgressSummaryDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto29(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto30(in *jlexer.Lexer, out *ProgressEventDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "node_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
+			}
+		case "node_label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NodeLabel = string(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "from_status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FromStatus = NodeProgressStatus(in.String())
+			}
+		case "from_status_label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FromStatusLabel = string(in.String())
+			}
+		case "to_status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ToStatus = NodeProgressStatus(in.String())
+			}
+		case "to_status_label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ToStatusLabel = string(in.String())
+			}
+		case "time_spent_minutes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeSpentMinutes = int(in.Int())
+			}
+		case "confidence":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Confidence = int(in.Int())
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto30(out *jwriter.Writer, in ProgressEventDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"node_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.NodeID).MarshalText())
+	}
+	if in.NodeLabel != "" {
+		const prefix string = ",\"node_label\":"
+		out.RawString(prefix)
+		out.String(string(in.NodeLabel))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.FromStatus != "" {
+		const prefix string = ",\"from_status\":"
+		out.RawString(prefix)
+		out.String(string(in.FromStatus))
+	}
+	if in.FromStatusLabel != "" {
+		const prefix string = ",\"from_status_label\":"
+		out.RawString(prefix)
+		out.String(string(in.FromStatusLabel))
+	}
+	if in.ToStatus != "" {
+		const prefix string = ",\"to_status\":"
+		out.RawString(prefix)
+		out.String(string(in.ToStatus))
+	}
+	if in.ToStatusLabel != "" {
+		const prefix string = ",\"to_status_label\":"
+		out.RawString(prefix)
+		out.String(string(in.ToStatusLabel))
+	}
+	if in.TimeSpentMinutes != 0 {
+		const prefix string = ",\"time_spent_minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.TimeSpentMinutes))
+	}
+	if in.Confidence != 0 {
+		const prefix string = ",\"confidence\":"
+		out.RawString(prefix)
+		out.Int(int(in.Confidence))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProgressEventDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProgressEventDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProgressEventDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProgressEventDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto30(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto31(in *jlexer.Lexer, out *Position) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.X = float64(in.Float64())
+			}
+		case "y":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Y = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto31(out *jwriter.Writer, in Position) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.X))
+	}
+	{
+		const prefix string = ",\"y\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Y))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Position) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Position) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto31(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto32(in *jlexer.Lexer, out *NodeWithProgressDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Position).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Data).UnmarshalEasyJSON(in)
+			}
+		case "measured":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Measured).UnmarshalEasyJSON(in)
+			}
+		case "selected":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Selected = bool(in.Bool())
+			}
+		case "dragging":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dragging = bool(in.Bool())
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "progress":
+			if in.IsNull() {
+				in.Skip()
+				out.Progress = nil
+			} else {
+				if out.Progress == nil {
+					out.Progress = new(NodeProgress)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Progress).UnmarshalEasyJSON(in)
+				}
+			}
+		case "note":
+			if in.IsNull() {
+				in.Skip()
+				out.Note = nil
+			} else {
+				if out.Note == nil {
+					out.Note = new(NodeNoteDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Note).UnmarshalEasyJSON(in)
+				}
+			}
+		case "locked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Locked = bool(in.Bool())
+			}
+		case "locked_by":
+			if in.IsNull() {
+				in.Skip()
+				out.LockedBy = nil
+			} else {
+				in.Delim('[')
+				if out.LockedBy == nil {
+					if !in.IsDelim(']') {
+						out.LockedBy = make([]uuid.UUID, 0, 4)
+					} else {
+						out.LockedBy = []uuid.UUID{}
+					}
+				} else {
+					out.LockedBy = (out.LockedBy)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 uuid.UUID
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						if data := in.UnsafeBytes(); in.Ok() {
+							in.AddError((v75).UnmarshalText(data))
+						}
+					}
+					out.LockedBy = append(out.LockedBy, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_by":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedBy = nil
+			} else {
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.CreatedBy).UnmarshalText(data))
+					}
+				}
+			}
+		case "updated_by":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedBy = nil
+			} else {
+				if out.UpdatedBy == nil {
+					out.UpdatedBy = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.UpdatedBy).UnmarshalText(data))
+					}
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto32(out *jwriter.Writer, in NodeWithProgressDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		(in.Position).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(in.Data).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"measured\":"
+		out.RawString(prefix)
+		(in.Measured).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"selected\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Selected))
+	}
+	{
+		const prefix string = ",\"dragging\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dragging))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.Progress != nil {
+		const prefix string = ",\"progress\":"
+		out.RawString(prefix)
+		(*in.Progress).MarshalEasyJSON(out)
+	}
+	if in.Note != nil {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		(*in.Note).MarshalEasyJSON(out)
+	}
+	if in.Locked {
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
+	if len(in.LockedBy) != 0 {
+		const prefix string = ",\"locked_by\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v76, v77 := range in.LockedBy {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v77).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.CreatedBy != nil {
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		out.RawText((*in.CreatedBy).MarshalText())
+	}
+	if in.UpdatedBy != nil {
+		const prefix string = ",\"updated_by\":"
+		out.RawString(prefix)
+		out.RawText((*in.UpdatedBy).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeWithProgressDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeWithProgressDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeWithProgressDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeWithProgressDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto32(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto33(in *jlexer.Lexer, out *NodeWithMaterialsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Position).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Data).UnmarshalEasyJSON(in)
+			}
+		case "measured":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Measured).UnmarshalEasyJSON(in)
+			}
+		case "selected":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Selected = bool(in.Bool())
+			}
+		case "dragging":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dragging = bool(in.Bool())
+			}
+		case "materials":
+			if in.IsNull() {
+				in.Skip()
+				out.Materials = nil
+			} else {
+				in.Delim('[')
+				if out.Materials == nil {
+					if !in.IsDelim(']') {
+						out.Materials = make([]Material, 0, 0)
+					} else {
+						out.Materials = []Material{}
+					}
+				} else {
+					out.Materials = (out.Materials)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 Material
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v78).UnmarshalEasyJSON(in)
+					}
+					out.Materials = append(out.Materials, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_by":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedBy = nil
+			} else {
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.CreatedBy).UnmarshalText(data))
+					}
+				}
+			}
+		case "updated_by":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedBy = nil
+			} else {
+				if out.UpdatedBy == nil {
+					out.UpdatedBy = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.UpdatedBy).UnmarshalText(data))
+					}
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto33(out *jwriter.Writer, in NodeWithMaterialsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		(in.Position).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(in.Data).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"measured\":"
+		out.RawString(prefix)
+		(in.Measured).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"selected\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Selected))
+	}
+	{
+		const prefix string = ",\"dragging\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dragging))
+	}
+	if len(in.Materials) != 0 {
+		const prefix string = ",\"materials\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v79, v80 := range in.Materials {
+				if v79 > 0 {
+					out.RawByte(',')
+				}
+				(v80).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.CreatedBy != nil {
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		out.RawText((*in.CreatedBy).MarshalText())
+	}
+	if in.UpdatedBy != nil {
+		const prefix string = ",\"updated_by\":"
+		out.RawString(prefix)
+		out.RawText((*in.UpdatedBy).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeWithMaterialsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeWithMaterialsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeWithMaterialsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeWithMaterialsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto33(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto34(in *jlexer.Lexer, out *NodeSuggestionsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Topic":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Topic = string(in.String())
+			}
+		case "Description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "Complexity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Complexity = string(in.String())
+			}
+		case "Instructions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Instructions = string(in.String())
+			}
+		case "MaxMaterials":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxMaterials = int(in.Int())
+			}
+		case "Node":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Node).UnmarshalEasyJSON(in)
+			}
+		case "Ancestors":
+			if in.IsNull() {
+				in.Skip()
+				out.Ancestors = nil
+			} else {
+				in.Delim('[')
+				if out.Ancestors == nil {
+					if !in.IsDelim(']') {
+						out.Ancestors = make([]NodeDTO, 0, 0)
+					} else {
+						out.Ancestors = []NodeDTO{}
+					}
+				} else {
+					out.Ancestors = (out.Ancestors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 NodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v81).UnmarshalEasyJSON(in)
+					}
+					out.Ancestors = append(out.Ancestors, v81)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto34(out *jwriter.Writer, in NodeSuggestionsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Topic\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"Complexity\":"
+		out.RawString(prefix)
+		out.String(string(in.Complexity))
+	}
+	{
+		const prefix string = ",\"Instructions\":"
+		out.RawString(prefix)
+		out.String(string(in.Instructions))
+	}
+	{
+		const prefix string = ",\"MaxMaterials\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMaterials))
+	}
+	{
+		const prefix string = ",\"Node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Ancestors\":"
+		out.RawString(prefix)
+		if in.Ancestors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v82, v83 := range in.Ancestors {
+				if v82 > 0 {
+					out.RawByte(',')
+				}
+				(v83).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeSuggestionsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeSuggestionsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeSuggestionsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeSuggestionsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto34(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto35(in *jlexer.Lexer, out *NodeQuizDraftDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Topic":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Topic = string(in.String())
+			}
+		case "Description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "Complexity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Complexity = string(in.String())
+			}
+		case "Instructions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Instructions = string(in.String())
+			}
+		case "QuestionsCount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuestionsCount = int(in.Int())
+			}
+		case "Node":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Node).UnmarshalEasyJSON(in)
+			}
+		case "Ancestors":
+			if in.IsNull() {
+				in.Skip()
+				out.Ancestors = nil
+			} else {
+				in.Delim('[')
+				if out.Ancestors == nil {
+					if !in.IsDelim(']') {
+						out.Ancestors = make([]NodeDTO, 0, 0)
+					} else {
+						out.Ancestors = []NodeDTO{}
+					}
+				} else {
+					out.Ancestors = (out.Ancestors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v84 NodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v84).UnmarshalEasyJSON(in)
+					}
+					out.Ancestors = append(out.Ancestors, v84)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto35(out *jwriter.Writer, in NodeQuizDraftDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Topic\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"Complexity\":"
+		out.RawString(prefix)
+		out.String(string(in.Complexity))
+	}
+	{
+		const prefix string = ",\"Instructions\":"
+		out.RawString(prefix)
+		out.String(string(in.Instructions))
+	}
+	{
+		const prefix string = ",\"QuestionsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuestionsCount))
+	}
+	{
+		const prefix string = ",\"Node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Ancestors\":"
+		out.RawString(prefix)
+		if in.Ancestors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.Ancestors {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				(v86).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeQuizDraftDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeQuizDraftDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeQuizDraftDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeQuizDraftDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto35(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto36(in *jlexer.Lexer, out *NodeQuizDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "node_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
+			}
+		case "questions":
+			if in.IsNull() {
+				in.Skip()
+				out.Questions = nil
+			} else {
+				in.Delim('[')
+				if out.Questions == nil {
+					if !in.IsDelim(']') {
+						out.Questions = make([]QuizQuestionDTO, 0, 0)
+					} else {
+						out.Questions = []QuizQuestionDTO{}
+					}
+				} else {
+					out.Questions = (out.Questions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 QuizQuestionDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v87).UnmarshalEasyJSON(in)
+					}
+					out.Questions = append(out.Questions, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "passing_score":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PassingScore = int(in.Int())
+			}
+		case "auto_complete":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AutoComplete = bool(in.Bool())
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "last_attempt":
+			if in.IsNull() {
+				in.Skip()
+				out.LastAttempt = nil
+			} else {
+				if out.LastAttempt == nil {
+					out.LastAttempt = new(QuizAttemptDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.LastAttempt).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto36(out *jwriter.Writer, in NodeQuizDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"node_id\":"
+		out.RawString(prefix)
+		out.RawText((in.NodeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"questions\":"
+		out.RawString(prefix)
+		if in.Questions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v88, v89 := range in.Questions {
+				if v88 > 0 {
+					out.RawByte(',')
+				}
+				(v89).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"passing_score\":"
+		out.RawString(prefix)
+		out.Int(int(in.PassingScore))
+	}
+	{
+		const prefix string = ",\"auto_complete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoComplete))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	if in.LastAttempt != nil {
+		const prefix string = ",\"last_attempt\":"
+		out.RawString(prefix)
+		(*in.LastAttempt).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeQuizDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeQuizDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeQuizDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeQuizDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto36(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto37(in *jlexer.Lexer, out *NodeProgress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = NodeProgressStatus(in.String())
+			}
+		case "status_label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StatusLabel = string(in.String())
+			}
+		case "started_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartedAt = nil
+			} else {
+				if out.StartedAt == nil {
+					out.StartedAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.StartedAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		case "time_spent_minutes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeSpentMinutes = int(in.Int())
+			}
+		case "confidence":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Confidence = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto37(out *jwriter.Writer, in NodeProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"status_label\":"
+		out.RawString(prefix)
+		out.String(string(in.StatusLabel))
+	}
+	if in.StartedAt != nil {
+		const prefix string = ",\"started_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.StartedAt).MarshalJSON())
+	}
+	if in.CompletedAt != nil {
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CompletedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"time_spent_minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.TimeSpentMinutes))
+	}
+	if in.Confidence != 0 {
+		const prefix string = ",\"confidence\":"
+		out.RawString(prefix)
+		out.Int(int(in.Confidence))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeProgress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeProgress) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeProgress) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeProgress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto37(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto38(in *jlexer.Lexer, out *NodeNoteSearchResultDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "node_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
+			}
+		case "snippet":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Snippet = string(in.String())
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto38(out *jwriter.Writer, in NodeNoteSearchResultDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"node_id\":"
+		out.RawString(prefix)
+		out.RawText((in.NodeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"snippet\":"
+		out.RawString(prefix)
+		out.String(string(in.Snippet))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeNoteSearchResultDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeNoteSearchResultDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeNoteSearchResultDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeNoteSearchResultDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto38(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto39(in *jlexer.Lexer, out *NodeNoteSearchResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "notes":
+			if in.IsNull() {
+				in.Skip()
+				out.Notes = nil
+			} else {
+				in.Delim('[')
+				if out.Notes == nil {
+					if !in.IsDelim(']') {
+						out.Notes = make([]NodeNoteSearchResultDTO, 0, 0)
+					} else {
+						out.Notes = []NodeNoteSearchResultDTO{}
+					}
+				} else {
+					out.Notes = (out.Notes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v90 NodeNoteSearchResultDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v90).UnmarshalEasyJSON(in)
+					}
+					out.Notes = append(out.Notes, v90)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = int(in.Int())
+			}
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int(in.Int())
+			}
+		case "offset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Offset = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto39(out *jwriter.Writer, in NodeNoteSearchResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notes\":"
+		out.RawString(prefix[1:])
+		if in.Notes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v91, v92 := range in.Notes {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				(v92).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeNoteSearchResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeNoteSearchResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeNoteSearchResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeNoteSearchResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto39(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto40(in *jlexer.Lexer, out *NodeNoteResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "node_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
+			}
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Content = string(in.String())
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto40(out *jwriter.Writer, in NodeNoteResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"node_id\":"
+		out.RawString(prefix)
+		out.RawText((in.NodeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeNoteResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeNoteResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeNoteResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeNoteResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto40(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto41(in *jlexer.Lexer, out *NodeNoteDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Content = string(in.String())
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto41(out *jwriter.Writer, in NodeNoteDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeNoteDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeNoteDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeNoteDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeNoteDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto41(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto42(in *jlexer.Lexer, out *NodeData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Label = string(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto42(out *jwriter.Writer, in NodeData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto42(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto43(in *jlexer.Lexer, out *NodeDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Position).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Data).UnmarshalEasyJSON(in)
+			}
+		case "measured":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Measured).UnmarshalEasyJSON(in)
+			}
+		case "selected":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Selected = bool(in.Bool())
+			}
+		case "dragging":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dragging = bool(in.Bool())
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto43(out *jwriter.Writer, in NodeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		(in.Position).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(in.Data).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"measured\":"
+		out.RawString(prefix)
+		(in.Measured).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"selected\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Selected))
+	}
+	{
+		const prefix string = ",\"dragging\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dragging))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto43(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto44(in *jlexer.Lexer, out *NodeChangesDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "label":
+			if in.IsNull() {
+				in.Skip()
+				out.Label = nil
+			} else {
+				if out.Label == nil {
+					out.Label = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Label = string(in.String())
+				}
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+				out.Type = nil
+			} else {
+				if out.Type == nil {
+					out.Type = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Type = string(in.String())
+				}
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Description = string(in.String())
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto44(out *jwriter.Writer, in NodeChangesDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Label != nil {
+		const prefix string = ",\"label\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(*in.Label))
+	}
+	if in.Type != nil {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.Type))
+	}
+	if in.Description != nil {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NodeChangesDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NodeChangesDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NodeChangesDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NodeChangesDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto44(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto45(in *jlexer.Lexer, out *MyLearningRoadmapDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "roadmap_info_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapInfoID = string(in.String())
+			}
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "summary":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Summary).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto45(out *jwriter.Writer, in MyLearningRoadmapDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	if in.RoadmapInfoID != "" {
+		const prefix string = ",\"roadmap_info_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapInfoID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"summary\":"
+		out.RawString(prefix)
+		(in.Summary).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MyLearningRoadmapDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MyLearningRoadmapDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MyLearningRoadmapDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MyLearningRoadmapDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto45(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto46(in *jlexer.Lexer, out *MyLearningResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmaps":
+			if in.IsNull() {
+				in.Skip()
+				out.Roadmaps = nil
+			} else {
+				in.Delim('[')
+				if out.Roadmaps == nil {
+					if !in.IsDelim(']') {
+						out.Roadmaps = make([]MyLearningRoadmapDTO, 0, 0)
+					} else {
+						out.Roadmaps = []MyLearningRoadmapDTO{}
+					}
+				} else {
+					out.Roadmaps = (out.Roadmaps)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v93 MyLearningRoadmapDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v93).UnmarshalEasyJSON(in)
+					}
+					out.Roadmaps = append(out.Roadmaps, v93)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = int(in.Int())
+			}
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int(in.Int())
+			}
+		case "offset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Offset = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto46(out *jwriter.Writer, in MyLearningResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmaps\":"
+		out.RawString(prefix[1:])
+		if in.Roadmaps == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v94, v95 := range in.Roadmaps {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				(v95).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MyLearningResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MyLearningResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MyLearningResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MyLearningResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto46(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto47(in *jlexer.Lexer, out *MigrateProgressResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "source_roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SourceRoadmapID = string(in.String())
+			}
+		case "kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = entities.RoadmapLineageKind(in.String())
+			}
+		case "migrated_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MigratedNodes = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto47(out *jwriter.Writer, in MigrateProgressResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"source_roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SourceRoadmapID))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"migrated_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.MigratedNodes))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MigrateProgressResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MigrateProgressResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MigrateProgressResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MigrateProgressResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto47(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto48(in *jlexer.Lexer, out *Measured) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "width":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Width = float64(in.Float64())
+			}
+		case "height":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Height = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto48(out *jwriter.Writer, in Measured) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Height))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Measured) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Measured) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Measured) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Measured) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto48(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto49(in *jlexer.Lexer, out *MaterialVotesDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "upvotes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Upvotes = int(in.Int())
+			}
+		case "downvotes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Downvotes = int(in.Int())
+			}
+		case "helpful":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Helpful = int(in.Int())
+			}
+		case "score":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Score = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto49(out *jwriter.Writer, in MaterialVotesDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"upvotes\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Upvotes))
+	}
+	{
+		const prefix string = ",\"downvotes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Downvotes))
+	}
+	{
+		const prefix string = ",\"helpful\":"
+		out.RawString(prefix)
+		out.Int(int(in.Helpful))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialVotesDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialVotesDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialVotesDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialVotesDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto49(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto50(in *jlexer.Lexer, out *MaterialPreviewDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Title = string(in.String())
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Description = string(in.String())
+			}
+		case "site_name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SiteName = string(in.String())
+			}
+		case "favicon_url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FaviconURL = string(in.String())
+			}
+		case "image_url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ImageURL = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto50(out *jwriter.Writer, in MaterialPreviewDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.SiteName != "" {
+		const prefix string = ",\"site_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SiteName))
+	}
+	if in.FaviconURL != "" {
+		const prefix string = ",\"favicon_url\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FaviconURL))
+	}
+	if in.ImageURL != "" {
+		const prefix string = ",\"image_url\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ImageURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialPreviewDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialPreviewDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialPreviewDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialPreviewDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto50(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto51(in *jlexer.Lexer, out *MaterialMyVoteDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "vote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Vote = entities.MaterialVoteValue(in.String())
+			}
+		case "helpful":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Helpful = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto51(out *jwriter.Writer, in MaterialMyVoteDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Vote != "" {
+		const prefix string = ",\"vote\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Vote))
+	}
+	{
+		const prefix string = ",\"helpful\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Helpful))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialMyVoteDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialMyVoteDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialMyVoteDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialMyVoteDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto51(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto52(in *jlexer.Lexer, out *MaterialListResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "materials":
+			if in.IsNull() {
+				in.Skip()
+				out.Materials = nil
+			} else {
+				in.Delim('[')
+				if out.Materials == nil {
+					if !in.IsDelim(']') {
+						out.Materials = make([]EnrichedMaterialResponseDTO, 0, 0)
+					} else {
+						out.Materials = []EnrichedMaterialResponseDTO{}
+					}
+				} else {
+					out.Materials = (out.Materials)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v96 EnrichedMaterialResponseDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v96).UnmarshalEasyJSON(in)
+					}
+					out.Materials = append(out.Materials, v96)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto52(out *jwriter.Writer, in MaterialListResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"materials\":"
+		out.RawString(prefix[1:])
+		if in.Materials == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v97, v98 := range in.Materials {
+				if v97 > 0 {
+					out.RawByte(',')
+				}
+				(v98).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialListResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialListResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialListResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialListResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto52(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto53(in *jlexer.Lexer, out *MaterialLinkCheckDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = entities.MaterialLinkStatus(in.String())
+			}
+		case "status_code":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StatusCode = int(in.Int())
+			}
+		case "final_url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FinalURL = string(in.String())
+			}
+		case "redirects":
+			if in.IsNull() {
+				in.Skip()
+				out.Redirects = nil
+			} else {
+				in.Delim('[')
+				if out.Redirects == nil {
+					if !in.IsDelim(']') {
+						out.Redirects = make([]string, 0, 4)
+					} else {
+						out.Redirects = []string{}
+					}
+				} else {
+					out.Redirects = (out.Redirects)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v99 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v99 = string(in.String())
+					}
+					out.Redirects = append(out.Redirects, v99)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Error = string(in.String())
+			}
+		case "checked_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CheckedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto53(out *jwriter.Writer, in MaterialLinkCheckDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.StatusCode != 0 {
+		const prefix string = ",\"status_code\":"
+		out.RawString(prefix)
+		out.Int(int(in.StatusCode))
+	}
+	if in.FinalURL != "" {
+		const prefix string = ",\"final_url\":"
+		out.RawString(prefix)
+		out.String(string(in.FinalURL))
+	}
+	if len(in.Redirects) != 0 {
+		const prefix string = ",\"redirects\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v100, v101 := range in.Redirects {
+				if v100 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v101))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"checked_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialLinkCheckDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialLinkCheckDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialLinkCheckDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialLinkCheckDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto53(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto54(in *jlexer.Lexer, out *MaterialFileDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "size":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Size = int64(in.Int64())
+			}
+		case "content_type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContentType = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto54(out *jwriter.Writer, in MaterialFileDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"content_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialFileDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialFileDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialFileDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialFileDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto54(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto55(in *jlexer.Lexer, out *MaterialAuthorDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "username":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Username = string(in.String())
+			}
+		case "avatar_url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvatarURL = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto55(out *jwriter.Writer, in MaterialAuthorDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	if in.AvatarURL != "" {
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaterialAuthorDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaterialAuthorDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaterialAuthorDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaterialAuthorDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto55(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto56(in *jlexer.Lexer, out *Material) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.URL = string(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = entities.MaterialType(in.String())
+			}
+		case "duration_minutes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DurationMinutes = int(in.Int())
+			}
+		case "language":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Language = string(in.String())
+			}
+		case "difficulty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Difficulty = entities.MaterialDifficulty(in.String())
+			}
+		case "is_paid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsPaid = bool(in.Bool())
+			}
+		case "file":
+			if in.IsNull() {
+				in.Skip()
+				out.File = nil
+			} else {
+				if out.File == nil {
+					out.File = new(MaterialFileDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.File).UnmarshalEasyJSON(in)
+				}
+			}
+		case "preview":
+			if in.IsNull() {
+				in.Skip()
+				out.Preview = nil
+			} else {
+				if out.Preview == nil {
+					out.Preview = new(MaterialPreviewDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Preview).UnmarshalEasyJSON(in)
+				}
+			}
+		case "author_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.AuthorID).UnmarshalText(data))
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto56(out *jwriter.Writer, in Material) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.DurationMinutes != 0 {
+		const prefix string = ",\"duration_minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.DurationMinutes))
+	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	if in.Difficulty != "" {
+		const prefix string = ",\"difficulty\":"
+		out.RawString(prefix)
+		out.String(string(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"is_paid\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPaid))
+	}
+	if in.File != nil {
+		const prefix string = ",\"file\":"
+		out.RawString(prefix)
+		(*in.File).MarshalEasyJSON(out)
+	}
+	if in.Preview != nil {
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		(*in.Preview).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"author_id\":"
+		out.RawString(prefix)
+		out.RawText((in.AuthorID).MarshalText())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Material) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Material) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Material) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Material) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto56(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto57(in *jlexer.Lexer, out *LearningOrderResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "enforce_prerequisites":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EnforcePrerequisites = bool(in.Bool())
+			}
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]LearningOrderNodeDTO, 0, 0)
+					} else {
+						out.Nodes = []LearningOrderNodeDTO{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v102 LearningOrderNodeDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v102).UnmarshalEasyJSON(in)
+					}
+					out.Nodes = append(out.Nodes, v102)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto57(out *jwriter.Writer, in LearningOrderResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"enforce_prerequisites\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EnforcePrerequisites))
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v103, v104 := range in.Nodes {
+				if v103 > 0 {
+					out.RawByte(',')
+				}
+				(v104).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LearningOrderResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LearningOrderResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LearningOrderResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LearningOrderResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto57(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto58(in *jlexer.Lexer, out *LearningOrderNodeDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.ID).UnmarshalText(data))
+				}
+			}
+		case "label":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Label = string(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "progress":
+			if in.IsNull() {
+				in.Skip()
+				out.Progress = nil
+			} else {
+				if out.Progress == nil {
+					out.Progress = new(NodeProgress)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Progress).UnmarshalEasyJSON(in)
+				}
+			}
+		case "locked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Locked = bool(in.Bool())
+			}
+		case "locked_by":
+			if in.IsNull() {
+				in.Skip()
+				out.LockedBy = nil
+			} else {
+				in.Delim('[')
+				if out.LockedBy == nil {
+					if !in.IsDelim(']') {
+						out.LockedBy = make([]uuid.UUID, 0, 4)
+					} else {
+						out.LockedBy = []uuid.UUID{}
+					}
+				} else {
+					out.LockedBy = (out.LockedBy)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v105 uuid.UUID
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						if data := in.UnsafeBytes(); in.Ok() {
+							in.AddError((v105).UnmarshalText(data))
+						}
+					}
+					out.LockedBy = append(out.LockedBy, v105)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto58(out *jwriter.Writer, in LearningOrderNodeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Progress != nil {
+		const prefix string = ",\"progress\":"
+		out.RawString(prefix)
+		(*in.Progress).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
+	if len(in.LockedBy) != 0 {
+		const prefix string = ",\"locked_by\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v106, v107 := range in.LockedBy {
+				if v106 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v107).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LearningOrderNodeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LearningOrderNodeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LearningOrderNodeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LearningOrderNodeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto58(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto59(in *jlexer.Lexer, out *LearningGoalListResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "goals":
+			if in.IsNull() {
+				in.Skip()
+				out.Goals = nil
+			} else {
+				in.Delim('[')
+				if out.Goals == nil {
+					if !in.IsDelim(']') {
+						out.Goals = make([]LearningGoalDTO, 0, 0)
+					} else {
+						out.Goals = []LearningGoalDTO{}
+					}
+				} else {
+					out.Goals = (out.Goals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v108 LearningGoalDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v108).UnmarshalEasyJSON(in)
+					}
+					out.Goals = append(out.Goals, v108)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto59(out *jwriter.Writer, in LearningGoalListResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"goals\":"
+		out.RawString(prefix[1:])
+		if in.Goals == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v109, v110 := range in.Goals {
+				if v109 > 0 {
+					out.RawByte(',')
+				}
+				(v110).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LearningGoalListResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LearningGoalListResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LearningGoalListResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LearningGoalListResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto59(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto60(in *jlexer.Lexer, out *LearningGoalDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapID = string(in.String())
+			}
+		case "roadmap_info_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapInfoID = string(in.String())
+			}
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "deadline":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Deadline).UnmarshalJSON(data))
+				}
+			}
+		case "weekly_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WeeklyPace = int(in.Int())
+			}
+		case "reminders_enabled":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RemindersEnabled = bool(in.Bool())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = entities.LearningGoalStatus(in.String())
+			}
+		case "total_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalNodes = int(in.Int())
+			}
+		case "finished_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FinishedNodes = int(in.Int())
+			}
+		case "remaining_nodes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RemainingNodes = int(in.Int())
+			}
+		case "actual_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualPace = float64(in.Float64())
+			}
+		case "required_pace":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RequiredPace = float64(in.Float64())
+			}
+		case "projected_completion_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ProjectedCompletionAt = nil
+			} else {
+				if out.ProjectedCompletionAt == nil {
+					out.ProjectedCompletionAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.ProjectedCompletionAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		case "last_reminded_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LastRemindedAt = nil
+			} else {
+				if out.LastRemindedAt == nil {
+					out.LastRemindedAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.LastRemindedAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto60(out *jwriter.Writer, in LearningGoalDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoadmapID))
+	}
+	if in.RoadmapInfoID != "" {
+		const prefix string = ",\"roadmap_info_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapInfoID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"deadline\":"
+		out.RawString(prefix)
+		out.Raw((in.Deadline).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"weekly_pace\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeeklyPace))
+	}
+	{
+		const prefix string = ",\"reminders_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RemindersEnabled))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"total_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalNodes))
+	}
+	{
+		const prefix string = ",\"finished_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.FinishedNodes))
+	}
+	{
+		const prefix string = ",\"remaining_nodes\":"
+		out.RawString(prefix)
+		out.Int(int(in.RemainingNodes))
+	}
+	{
+		const prefix string = ",\"actual_pace\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ActualPace))
+	}
+	{
+		const prefix string = ",\"required_pace\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RequiredPace))
+	}
+	if in.ProjectedCompletionAt != nil {
+		const prefix string = ",\"projected_completion_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ProjectedCompletionAt).MarshalJSON())
+	}
+	if in.LastRemindedAt != nil {
+		const prefix string = ",\"last_reminded_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastRemindedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LearningGoalDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LearningGoalDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LearningGoalDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LearningGoalDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto60(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto61(in *jlexer.Lexer, out *GetByIDRoadmapWithProgressResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Roadmap).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto61(out *jwriter.Writer, in GetByIDRoadmapWithProgressResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap\":"
+		out.RawString(prefix[1:])
+		(in.Roadmap).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetByIDRoadmapWithProgressResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetByIDRoadmapWithProgressResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetByIDRoadmapWithProgressResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetByIDRoadmapWithProgressResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto61(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto62(in *jlexer.Lexer, out *GetByIDRoadmapWithMaterialsResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.RoadmapWithMaterials).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto62(out *jwriter.Writer, in GetByIDRoadmapWithMaterialsResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap\":"
+		out.RawString(prefix[1:])
+		(in.RoadmapWithMaterials).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetByIDRoadmapWithMaterialsResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetByIDRoadmapWithMaterialsResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetByIDRoadmapWithMaterialsResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetByIDRoadmapWithMaterialsResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto62(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto63(in *jlexer.Lexer, out *GetByIDRoadmapResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Roadmap).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto63(out *jwriter.Writer, in GetByIDRoadmapResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmap\":"
+		out.RawString(prefix[1:])
+		(in.Roadmap).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetByIDRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetByIDRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetByIDRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetByIDRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto63(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto64(in *jlexer.Lexer, out *GetAllRoadmapsResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmaps":
+			if in.IsNull() {
+				in.Skip()
+				out.Roadmaps = nil
+			} else {
+				in.Delim('[')
+				if out.Roadmaps == nil {
+					if !in.IsDelim(']') {
+						out.Roadmaps = make([]RoadmapDTO, 0, 0)
+					} else {
+						out.Roadmaps = []RoadmapDTO{}
+					}
+				} else {
+					out.Roadmaps = (out.Roadmaps)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v111 RoadmapDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v111).UnmarshalEasyJSON(in)
+					}
+					out.Roadmaps = append(out.Roadmaps, v111)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto64(out *jwriter.Writer, in GetAllRoadmapsResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmaps\":"
+		out.RawString(prefix[1:])
+		if in.Roadmaps == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v112, v113 := range in.Roadmaps {
+				if v112 > 0 {
+					out.RawByte(',')
+				}
+				(v113).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetAllRoadmapsResponseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetAllRoadmapsResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetAllRoadmapsResponseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetAllRoadmapsResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto64(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto65(in *jlexer.Lexer, out *GenerateRoadmapResponseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "roadmapId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.RoadmapID).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
-	if in.Progress != nil {
-		const prefix string = ",\"progress\":"
-		out.RawString(prefix)
-		(*in.Progress).MarshalEasyJSON(out)
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto65(out *jwriter.Writer, in GenerateRoadmapResponseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"roadmapId\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.RoadmapID).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NodeWithProgressDTO) MarshalJSON() ([]byte, error) {
+func (v GenerateRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NodeWithProgressDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(w, v)
+func (v GenerateRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NodeWithProgressDTO) UnmarshalJSON(data []byte) error {
+func (v *GenerateRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NodeWithProgressDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto7(l, v)
+func (v *GenerateRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto65(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(in *jlexer.Lexer, out *NodeWithMaterialsDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto66(in *jlexer.Lexer, out *GenerateRoadmapRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1128,82 +8410,105 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "id":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.ID).UnmarshalText(data))
-				}
-			}
-		case "type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = string(in.String())
-			}
 		case "description":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Description = string(in.String())
-			}
-		case "position":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Position).UnmarshalEasyJSON(in)
+				out.Content = string(in.String())
 			}
-		case "data":
+		case "complexity":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Data).UnmarshalEasyJSON(in)
+				out.Complexity = string(in.String())
 			}
-		case "measured":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto66(out *jwriter.Writer, in GenerateRoadmapRequestDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"complexity\":"
+		out.RawString(prefix)
+		out.String(string(in.Complexity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GenerateRoadmapRequestDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GenerateRoadmapRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GenerateRoadmapRequestDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GenerateRoadmapRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto66(l, v)
+}
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto67(in *jlexer.Lexer, out *GenerateRoadmapDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Topic":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Measured).UnmarshalEasyJSON(in)
+				out.Topic = string(in.String())
 			}
-		case "selected":
+		case "Description":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Selected = bool(in.Bool())
+				out.Description = string(in.String())
 			}
-		case "dragging":
+		case "Content":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Dragging = bool(in.Bool())
+				out.Content = string(in.String())
 			}
-		case "materials":
+		case "Complexity":
 			if in.IsNull() {
 				in.Skip()
-				out.Materials = nil
 			} else {
-				in.Delim('[')
-				if out.Materials == nil {
-					if !in.IsDelim(']') {
-						out.Materials = make([]Material, 0, 0)
-					} else {
-						out.Materials = []Material{}
-					}
-				} else {
-					out.Materials = (out.Materials)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v25 Material
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v25).UnmarshalEasyJSON(in)
-					}
-					out.Materials = append(out.Materials, v25)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Complexity = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1215,91 +8520,57 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(out *jwriter.Writer, in NodeWithMaterialsDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto67(out *jwriter.Writer, in GenerateRoadmapDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"Topic\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
+		out.String(string(in.Topic))
 	}
 	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"Description\":"
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
 	{
-		const prefix string = ",\"position\":"
-		out.RawString(prefix)
-		(in.Position).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"data\":"
-		out.RawString(prefix)
-		(in.Data).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"measured\":"
-		out.RawString(prefix)
-		(in.Measured).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"selected\":"
+		const prefix string = ",\"Content\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Selected))
+		out.String(string(in.Content))
 	}
 	{
-		const prefix string = ",\"dragging\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Dragging))
-	}
-	if len(in.Materials) != 0 {
-		const prefix string = ",\"materials\":"
+		const prefix string = ",\"Complexity\":"
 		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v26, v27 := range in.Materials {
-				if v26 > 0 {
-					out.RawByte(',')
-				}
-				(v27).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Complexity))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NodeWithMaterialsDTO) MarshalJSON() ([]byte, error) {
+func (v GenerateRoadmapDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NodeWithMaterialsDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(w, v)
+func (v GenerateRoadmapDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NodeWithMaterialsDTO) UnmarshalJSON(data []byte) error {
+func (v *GenerateRoadmapDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NodeWithMaterialsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto8(l, v)
+func (v *GenerateRoadmapDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto67(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(in *jlexer.Lexer, out *NodeProgress) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto68(in *jlexer.Lexer, out *GenerateNodeQuizResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1313,11 +8584,46 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "status":
+		case "roadmap_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Status = NodeProgressStatus(in.String())
+				out.RoadmapID = string(in.String())
+			}
+		case "node_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.NodeID).UnmarshalText(data))
+				}
+			}
+		case "questions":
+			if in.IsNull() {
+				in.Skip()
+				out.Questions = nil
+			} else {
+				in.Delim('[')
+				if out.Questions == nil {
+					if !in.IsDelim(']') {
+						out.Questions = make([]QuizQuestionDTO, 0, 0)
+					} else {
+						out.Questions = []QuizQuestionDTO{}
+					}
+				} else {
+					out.Questions = (out.Questions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v114 QuizQuestionDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v114).UnmarshalEasyJSON(in)
+					}
+					out.Questions = append(out.Questions, v114)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1329,42 +8635,63 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(out *jwriter.Writer, in NodeProgress) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto68(out *jwriter.Writer, in GenerateNodeQuizResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"roadmap_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"node_id\":"
+		out.RawString(prefix)
+		out.RawText((in.NodeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"questions\":"
+		out.RawString(prefix)
+		if in.Questions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v115, v116 := range in.Questions {
+				if v115 > 0 {
+					out.RawByte(',')
+				}
+				(v116).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NodeProgress) MarshalJSON() ([]byte, error) {
+func (v GenerateNodeQuizResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NodeProgress) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(w, v)
+func (v GenerateNodeQuizResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NodeProgress) UnmarshalJSON(data []byte) error {
+func (v *GenerateNodeQuizResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NodeProgress) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto9(l, v)
+func (v *GenerateNodeQuizResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto68(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(in *jlexer.Lexer, out *NodeData) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto69(in *jlexer.Lexer, out *GenerateNodeQuizRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1378,17 +8705,23 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "label":
+		case "instructions":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Label = string(in.String())
+				out.Instructions = string(in.String())
 			}
-		case "type":
+		case "complexity":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Complexity = string(in.String())
+			}
+		case "questions_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuestionsCount = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -1400,47 +8733,52 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(out *jwriter.Writer, in NodeData) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto69(out *jwriter.Writer, in GenerateNodeQuizRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"label\":"
+		const prefix string = ",\"instructions\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Label))
+		out.String(string(in.Instructions))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"complexity\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.Complexity))
+	}
+	{
+		const prefix string = ",\"questions_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuestionsCount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NodeData) MarshalJSON() ([]byte, error) {
+func (v GenerateNodeQuizRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NodeData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(w, v)
+func (v GenerateNodeQuizRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NodeData) UnmarshalJSON(data []byte) error {
+func (v *GenerateNodeQuizRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NodeData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto10(l, v)
+func (v *GenerateNodeQuizRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto69(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(in *jlexer.Lexer, out *NodeDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto70(in *jlexer.Lexer, out *EnrichedMaterialResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1462,47 +8800,131 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11
 					in.AddError((out.ID).UnmarshalText(data))
 				}
 			}
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.URL = string(in.String())
+			}
 		case "type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Type = entities.MaterialType(in.String())
 			}
-		case "position":
+		case "duration_minutes":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Position).UnmarshalEasyJSON(in)
+				out.DurationMinutes = int(in.Int())
 			}
-		case "data":
+		case "language":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Data).UnmarshalEasyJSON(in)
+				out.Language = string(in.String())
 			}
-		case "measured":
+		case "difficulty":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Measured).UnmarshalEasyJSON(in)
+				out.Difficulty = entities.MaterialDifficulty(in.String())
 			}
-		case "selected":
+		case "is_paid":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Selected = bool(in.Bool())
+				out.IsPaid = bool(in.Bool())
 			}
-		case "dragging":
+		case "file":
 			if in.IsNull() {
 				in.Skip()
+				out.File = nil
 			} else {
-				out.Dragging = bool(in.Bool())
+				if out.File == nil {
+					out.File = new(MaterialFileDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.File).UnmarshalEasyJSON(in)
+				}
 			}
-		case "description":
+		case "author":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Description = string(in.String())
+				(out.Author).UnmarshalEasyJSON(in)
+			}
+		case "preview":
+			if in.IsNull() {
+				in.Skip()
+				out.Preview = nil
+			} else {
+				if out.Preview == nil {
+					out.Preview = new(MaterialPreviewDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Preview).UnmarshalEasyJSON(in)
+				}
+			}
+		case "link_check":
+			if in.IsNull() {
+				in.Skip()
+				out.LinkCheck = nil
+			} else {
+				if out.LinkCheck == nil {
+					out.LinkCheck = new(MaterialLinkCheckDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.LinkCheck).UnmarshalEasyJSON(in)
+				}
+			}
+		case "votes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Votes).UnmarshalEasyJSON(in)
+			}
+		case "my_vote":
+			if in.IsNull() {
+				in.Skip()
+				out.MyVote = nil
+			} else {
+				if out.MyVote == nil {
+					out.MyVote = new(MaterialMyVoteDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.MyVote).UnmarshalEasyJSON(in)
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -1514,7 +8936,7 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(out *jwriter.Writer, in NodeDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto70(out *jwriter.Writer, in EnrichedMaterialResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1523,68 +8945,108 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11
 		out.RawString(prefix[1:])
 		out.RawText((in.ID).MarshalText())
 	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
 	{
 		const prefix string = ",\"type\":"
 		out.RawString(prefix)
 		out.String(string(in.Type))
 	}
-	{
-		const prefix string = ",\"position\":"
+	if in.DurationMinutes != 0 {
+		const prefix string = ",\"duration_minutes\":"
 		out.RawString(prefix)
-		(in.Position).MarshalEasyJSON(out)
+		out.Int(int(in.DurationMinutes))
+	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	if in.Difficulty != "" {
+		const prefix string = ",\"difficulty\":"
+		out.RawString(prefix)
+		out.String(string(in.Difficulty))
 	}
 	{
-		const prefix string = ",\"data\":"
+		const prefix string = ",\"is_paid\":"
 		out.RawString(prefix)
-		(in.Data).MarshalEasyJSON(out)
+		out.Bool(bool(in.IsPaid))
+	}
+	if in.File != nil {
+		const prefix string = ",\"file\":"
+		out.RawString(prefix)
+		(*in.File).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"measured\":"
+		const prefix string = ",\"author\":"
 		out.RawString(prefix)
-		(in.Measured).MarshalEasyJSON(out)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	if in.Preview != nil {
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		(*in.Preview).MarshalEasyJSON(out)
+	}
+	if in.LinkCheck != nil {
+		const prefix string = ",\"link_check\":"
+		out.RawString(prefix)
+		(*in.LinkCheck).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"selected\":"
+		const prefix string = ",\"votes\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Selected))
+		(in.Votes).MarshalEasyJSON(out)
+	}
+	if in.MyVote != nil {
+		const prefix string = ",\"my_vote\":"
+		out.RawString(prefix)
+		(*in.MyVote).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"dragging\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Dragging))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
+	{
+		const prefix string = ",\"updated_at\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NodeDTO) MarshalJSON() ([]byte, error) {
+func (v EnrichedMaterialResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NodeDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(w, v)
+func (v EnrichedMaterialResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NodeDTO) UnmarshalJSON(data []byte) error {
+func (v *EnrichedMaterialResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NodeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto11(l, v)
+func (v *EnrichedMaterialResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto70(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(in *jlexer.Lexer, out *Measured) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto71(in *jlexer.Lexer, out *EditingSnapshotDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1598,114 +9060,56 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "width":
+		case "roadmap_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Width = float64(in.Float64())
+				out.RoadmapID = string(in.String())
 			}
-		case "height":
+		case "version":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Height = float64(in.Float64())
+				out.Version = int64(in.Int64())
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(out *jwriter.Writer, in Measured) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"width\":"
-		out.RawString(prefix[1:])
-		out.Float64(float64(in.Width))
-	}
-	{
-		const prefix string = ",\"height\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.Height))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Measured) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Measured) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Measured) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Measured) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto12(l, v)
-}
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(in *jlexer.Lexer, out *MaterialListResponseDTO) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		switch key {
-		case "materials":
+		case "can_edit":
 			if in.IsNull() {
 				in.Skip()
-				out.Materials = nil
+			} else {
+				out.CanEdit = bool(in.Bool())
+			}
+		case "roadmap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Roadmap).UnmarshalEasyJSON(in)
+			}
+		case "participants":
+			if in.IsNull() {
+				in.Skip()
+				out.Participants = nil
 			} else {
 				in.Delim('[')
-				if out.Materials == nil {
+				if out.Participants == nil {
 					if !in.IsDelim(']') {
-						out.Materials = make([]EnrichedMaterialResponseDTO, 0, 0)
+						out.Participants = make([]string, 0, 4)
 					} else {
-						out.Materials = []EnrichedMaterialResponseDTO{}
+						out.Participants = []string{}
 					}
 				} else {
-					out.Materials = (out.Materials)[:0]
+					out.Participants = (out.Participants)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v28 EnrichedMaterialResponseDTO
+					var v117 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v28).UnmarshalEasyJSON(in)
-					}
-					out.Materials = append(out.Materials, v28)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "total":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Total = int(in.Int())
+						v117 = string(in.String())
+					}
+					out.Participants = append(out.Participants, v117)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1717,58 +9121,73 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(out *jwriter.Writer, in MaterialListResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto71(out *jwriter.Writer, in EditingSnapshotDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"materials\":"
+		const prefix string = ",\"roadmap_id\":"
 		out.RawString(prefix[1:])
-		if in.Materials == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Version))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"roadmap\":"
+		out.RawString(prefix)
+		(in.Roadmap).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"participants\":"
+		out.RawString(prefix)
+		if in.Participants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v29, v30 := range in.Materials {
-				if v29 > 0 {
+			for v118, v119 := range in.Participants {
+				if v118 > 0 {
 					out.RawByte(',')
 				}
-				(v30).MarshalEasyJSON(out)
+				out.String(string(v119))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"total\":"
-		out.RawString(prefix)
-		out.Int(int(in.Total))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MaterialListResponseDTO) MarshalJSON() ([]byte, error) {
+func (v EditingSnapshotDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MaterialListResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(w, v)
+func (v EditingSnapshotDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MaterialListResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *EditingSnapshotDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MaterialListResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto13(l, v)
+func (v *EditingSnapshotDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto71(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(in *jlexer.Lexer, out *MaterialAuthorDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto72(in *jlexer.Lexer, out *EditOperationRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1782,25 +9201,17 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "id":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.ID).UnmarshalText(data))
-				}
-			}
-		case "username":
+		case "roadmap_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Username = string(in.String())
+				out.RoadmapID = string(in.String())
 			}
-		case "avatar_url":
+		case "operation":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.AvatarURL = string(in.String())
+				(out.Operation).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -1812,52 +9223,47 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(out *jwriter.Writer, in MaterialAuthorDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto72(out *jwriter.Writer, in EditOperationRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"roadmap_id\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
+		out.String(string(in.RoadmapID))
 	}
 	{
-		const prefix string = ",\"username\":"
+		const prefix string = ",\"operation\":"
 		out.RawString(prefix)
-		out.String(string(in.Username))
-	}
-	if in.AvatarURL != "" {
-		const prefix string = ",\"avatar_url\":"
-		out.RawString(prefix)
-		out.String(string(in.AvatarURL))
+		(in.Operation).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MaterialAuthorDTO) MarshalJSON() ([]byte, error) {
+func (v EditOperationRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MaterialAuthorDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(w, v)
+func (v EditOperationRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MaterialAuthorDTO) UnmarshalJSON(data []byte) error {
+func (v *EditOperationRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MaterialAuthorDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto14(l, v)
+func (v *EditOperationRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto72(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(in *jlexer.Lexer, out *Material) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto73(in *jlexer.Lexer, out *EditOperationDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1875,46 +9281,98 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.ID).UnmarshalText(data))
-				}
+				out.ID = string(in.String())
 			}
-		case "name":
+		case "type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Name = string(in.String())
+				out.Type = EditOperationType(in.String())
 			}
-		case "url":
+		case "base_version":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.URL = string(in.String())
+				out.BaseVersion = int64(in.Int64())
 			}
-		case "author_id":
+		case "node":
 			if in.IsNull() {
 				in.Skip()
+				out.Node = nil
 			} else {
-				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.AuthorID).UnmarshalText(data))
+				if out.Node == nil {
+					out.Node = new(NodeDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Node).UnmarshalEasyJSON(in)
 				}
 			}
-		case "created_at":
+		case "node_id":
 			if in.IsNull() {
 				in.Skip()
+				out.NodeID = nil
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				if out.NodeID == nil {
+					out.NodeID = new(uuid.UUID)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((*out.NodeID).UnmarshalText(data))
+					}
 				}
 			}
-		case "updated_at":
+		case "changes":
 			if in.IsNull() {
 				in.Skip()
+				out.Changes = nil
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				if out.Changes == nil {
+					out.Changes = new(NodeChangesDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Changes).UnmarshalEasyJSON(in)
+				}
+			}
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(Position)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Position).UnmarshalEasyJSON(in)
+				}
+			}
+		case "edge":
+			if in.IsNull() {
+				in.Skip()
+				out.Edge = nil
+			} else {
+				if out.Edge == nil {
+					out.Edge = new(EdgeDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Edge).UnmarshalEasyJSON(in)
 				}
 			}
+		case "edge_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EdgeID = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1925,67 +9383,82 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(out *jwriter.Writer, in Material) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto73(out *jwriter.Writer, in EditOperationDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"base_version\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.Int64(int64(in.BaseVersion))
 	}
-	{
-		const prefix string = ",\"author_id\":"
+	if in.Node != nil {
+		const prefix string = ",\"node\":"
 		out.RawString(prefix)
-		out.RawText((in.AuthorID).MarshalText())
+		(*in.Node).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"created_at\":"
+	if in.NodeID != nil {
+		const prefix string = ",\"node_id\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.RawText((*in.NodeID).MarshalText())
 	}
-	{
-		const prefix string = ",\"updated_at\":"
+	if in.Changes != nil {
+		const prefix string = ",\"changes\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		(*in.Changes).MarshalEasyJSON(out)
+	}
+	if in.Position != nil {
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		(*in.Position).MarshalEasyJSON(out)
+	}
+	if in.Edge != nil {
+		const prefix string = ",\"edge\":"
+		out.RawString(prefix)
+		(*in.Edge).MarshalEasyJSON(out)
+	}
+	if in.EdgeID != "" {
+		const prefix string = ",\"edge_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EdgeID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Material) MarshalJSON() ([]byte, error) {
+func (v EditOperationDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Material) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(w, v)
+func (v EditOperationDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Material) UnmarshalJSON(data []byte) error {
+func (v *EditOperationDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Material) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto15(l, v)
+func (v *EditOperationDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto73(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(in *jlexer.Lexer, out *GetByIDRoadmapWithProgressResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto74(in *jlexer.Lexer, out *EdgeDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1999,11 +9472,29 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "roadmap":
+		case "source":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Roadmap).UnmarshalEasyJSON(in)
+				out.Source = string(in.String())
+			}
+		case "target":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Target = string(in.String())
+			}
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = string(in.String())
+			}
+		case "prerequisite":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Prerequisite = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -2015,42 +9506,57 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(out *jwriter.Writer, in GetByIDRoadmapWithProgressResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto74(out *jwriter.Writer, in EdgeDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"roadmap\":"
+		const prefix string = ",\"source\":"
 		out.RawString(prefix[1:])
-		(in.Roadmap).MarshalEasyJSON(out)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		out.String(string(in.Target))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.Prerequisite {
+		const prefix string = ",\"prerequisite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Prerequisite))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetByIDRoadmapWithProgressResponseDTO) MarshalJSON() ([]byte, error) {
+func (v EdgeDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetByIDRoadmapWithProgressResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(w, v)
+func (v EdgeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetByIDRoadmapWithProgressResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *EdgeDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetByIDRoadmapWithProgressResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto16(l, v)
+func (v *EdgeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto74(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(in *jlexer.Lexer, out *GetByIDRoadmapWithMaterialsResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto75(in *jlexer.Lexer, out *DeleteMaterialResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2064,11 +9570,11 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "roadmap":
+		case "message":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.RoadmapWithMaterials).UnmarshalEasyJSON(in)
+				out.Message = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2080,42 +9586,42 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(out *jwriter.Writer, in GetByIDRoadmapWithMaterialsResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto75(out *jwriter.Writer, in DeleteMaterialResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"roadmap\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		(in.RoadmapWithMaterials).MarshalEasyJSON(out)
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetByIDRoadmapWithMaterialsResponseDTO) MarshalJSON() ([]byte, error) {
+func (v DeleteMaterialResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetByIDRoadmapWithMaterialsResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(w, v)
+func (v DeleteMaterialResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetByIDRoadmapWithMaterialsResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *DeleteMaterialResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetByIDRoadmapWithMaterialsResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto17(l, v)
+func (v *DeleteMaterialResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto75(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(in *jlexer.Lexer, out *GetByIDRoadmapResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto76(in *jlexer.Lexer, out *CreateRoadmapResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2133,7 +9639,7 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Roadmap).UnmarshalEasyJSON(in)
+				(out.RoadmapWithMaterials).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -2145,42 +9651,42 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(out *jwriter.Writer, in GetByIDRoadmapResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto76(out *jwriter.Writer, in CreateRoadmapResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"roadmap\":"
 		out.RawString(prefix[1:])
-		(in.Roadmap).MarshalEasyJSON(out)
+		(in.RoadmapWithMaterials).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetByIDRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
+func (v CreateRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetByIDRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(w, v)
+func (v CreateRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetByIDRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *CreateRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetByIDRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto18(l, v)
+func (v *CreateRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto76(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(in *jlexer.Lexer, out *GetAllRoadmapsResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto77(in *jlexer.Lexer, out *CreateRoadmapRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2194,32 +9700,25 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "roadmaps":
-			if in.IsNull() {
-				in.Skip()
-				out.Roadmaps = nil
-			} else {
-				in.Delim('[')
-				if out.Roadmaps == nil {
-					if !in.IsDelim(']') {
-						out.Roadmaps = make([]RoadmapDTO, 0, 0)
-					} else {
-						out.Roadmaps = []RoadmapDTO{}
-					}
-				} else {
-					out.Roadmaps = (out.Roadmaps)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v31 RoadmapDTO
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v31).UnmarshalEasyJSON(in)
-					}
-					out.Roadmaps = append(out.Roadmaps, v31)
-					in.WantComma()
+		case "author_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.AuthorID).UnmarshalText(data))
 				}
-				in.Delim(']')
+			}
+		case "is_public":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsPublic = bool(in.Bool())
+			}
+		case "roadmap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Roadmap).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -2231,53 +9730,52 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(out *jwriter.Writer, in GetAllRoadmapsResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto77(out *jwriter.Writer, in CreateRoadmapRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"roadmaps\":"
+		const prefix string = ",\"author_id\":"
 		out.RawString(prefix[1:])
-		if in.Roadmaps == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v32, v33 := range in.Roadmaps {
-				if v32 > 0 {
-					out.RawByte(',')
-				}
-				(v33).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.RawText((in.AuthorID).MarshalText())
+	}
+	{
+		const prefix string = ",\"is_public\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPublic))
+	}
+	{
+		const prefix string = ",\"roadmap\":"
+		out.RawString(prefix)
+		(in.Roadmap).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetAllRoadmapsResponseDTO) MarshalJSON() ([]byte, error) {
+func (v CreateRoadmapRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetAllRoadmapsResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(w, v)
+func (v CreateRoadmapRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetAllRoadmapsResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *CreateRoadmapRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetAllRoadmapsResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto19(l, v)
+func (v *CreateRoadmapRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto77(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(in *jlexer.Lexer, out *GenerateRoadmapResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto78(in *jlexer.Lexer, out *CreateMaterialRequestDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2291,13 +9789,47 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "roadmapId":
+		case "name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.RoadmapID).UnmarshalJSON(data))
-				}
+				out.Name = string(in.String())
+			}
+		case "url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.URL = string(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = entities.MaterialType(in.String())
+			}
+		case "duration_minutes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DurationMinutes = int(in.Int())
+			}
+		case "language":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Language = string(in.String())
+			}
+		case "difficulty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Difficulty = entities.MaterialDifficulty(in.String())
+			}
+		case "is_paid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsPaid = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -2309,42 +9841,72 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(out *jwriter.Writer, in GenerateRoadmapResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto78(out *jwriter.Writer, in CreateMaterialRequestDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"roadmapId\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.RoadmapID).MarshalJSON())
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"duration_minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.DurationMinutes))
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"difficulty\":"
+		out.RawString(prefix)
+		out.String(string(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"is_paid\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPaid))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GenerateRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
+func (v CreateMaterialRequestDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GenerateRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(w, v)
+func (v CreateMaterialRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GenerateRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *CreateMaterialRequestDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GenerateRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto20(l, v)
+func (v *CreateMaterialRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto78(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(in *jlexer.Lexer, out *GenerateRoadmapRequestDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto79(in *jlexer.Lexer, out *CertificateVerificationResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2358,17 +9920,25 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "description":
+		case "valid":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Content = string(in.String())
+				out.Valid = bool(in.Bool())
 			}
-		case "complexity":
+		case "certificate":
 			if in.IsNull() {
 				in.Skip()
+				out.Certificate = nil
 			} else {
-				out.Complexity = string(in.String())
+				if out.Certificate == nil {
+					out.Certificate = new(CertificateDTO)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Certificate).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -2380,47 +9950,47 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(out *jwriter.Writer, in GenerateRoadmapRequestDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto79(out *jwriter.Writer, in CertificateVerificationResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"valid\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Content))
+		out.Bool(bool(in.Valid))
 	}
-	{
-		const prefix string = ",\"complexity\":"
+	if in.Certificate != nil {
+		const prefix string = ",\"certificate\":"
 		out.RawString(prefix)
-		out.String(string(in.Complexity))
+		(*in.Certificate).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GenerateRoadmapRequestDTO) MarshalJSON() ([]byte, error) {
+func (v CertificateVerificationResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GenerateRoadmapRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(w, v)
+func (v CertificateVerificationResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GenerateRoadmapRequestDTO) UnmarshalJSON(data []byte) error {
+func (v *CertificateVerificationResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GenerateRoadmapRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto21(l, v)
+func (v *CertificateVerificationResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto79(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(in *jlexer.Lexer, out *GenerateRoadmapDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto80(in *jlexer.Lexer, out *CertificateListResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2434,29 +10004,32 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Topic":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Topic = string(in.String())
-			}
-		case "Description":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Description = string(in.String())
-			}
-		case "Content":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Content = string(in.String())
-			}
-		case "Complexity":
+		case "certificates":
 			if in.IsNull() {
 				in.Skip()
+				out.Certificates = nil
 			} else {
-				out.Complexity = string(in.String())
+				in.Delim('[')
+				if out.Certificates == nil {
+					if !in.IsDelim(']') {
+						out.Certificates = make([]CertificateDTO, 0, 0)
+					} else {
+						out.Certificates = []CertificateDTO{}
+					}
+				} else {
+					out.Certificates = (out.Certificates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v120 CertificateDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v120).UnmarshalEasyJSON(in)
+					}
+					out.Certificates = append(out.Certificates, v120)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -2468,57 +10041,53 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(out *jwriter.Writer, in GenerateRoadmapDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto80(out *jwriter.Writer, in CertificateListResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Topic\":"
+		const prefix string = ",\"certificates\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Topic))
-	}
-	{
-		const prefix string = ",\"Description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"Content\":"
-		out.RawString(prefix)
-		out.String(string(in.Content))
-	}
-	{
-		const prefix string = ",\"Complexity\":"
-		out.RawString(prefix)
-		out.String(string(in.Complexity))
+		if in.Certificates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v121, v122 := range in.Certificates {
+				if v121 > 0 {
+					out.RawByte(',')
+				}
+				(v122).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GenerateRoadmapDTO) MarshalJSON() ([]byte, error) {
+func (v CertificateListResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GenerateRoadmapDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(w, v)
+func (v CertificateListResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GenerateRoadmapDTO) UnmarshalJSON(data []byte) error {
+func (v *CertificateListResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GenerateRoadmapDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto22(l, v)
+func (v *CertificateListResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto80(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(in *jlexer.Lexer, out *EnrichedMaterialResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto81(in *jlexer.Lexer, out *CertificateDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2540,40 +10109,58 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23
 					in.AddError((out.ID).UnmarshalText(data))
 				}
 			}
-		case "name":
+		case "user_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Name = string(in.String())
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.UserID).UnmarshalText(data))
+				}
 			}
-		case "url":
+		case "user_name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.URL = string(in.String())
+				out.UserName = string(in.String())
 			}
-		case "author":
+		case "roadmap_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Author).UnmarshalEasyJSON(in)
+				out.RoadmapID = string(in.String())
 			}
-		case "created_at":
+		case "roadmap_name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.CreatedAt).UnmarshalJSON(data))
-				}
+				out.RoadmapName = string(in.String())
 			}
-		case "updated_at":
+		case "roadmap_version":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RoadmapVersion = string(in.String())
+			}
+		case "issued_at":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				if data := in.Raw(); in.Ok() {
-					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+					in.AddError((out.IssuedAt).UnmarshalJSON(data))
 				}
 			}
+		case "signature":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Signature = string(in.String())
+			}
+		case "url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.URL = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2584,7 +10171,7 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(out *jwriter.Writer, in EnrichedMaterialResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto81(out *jwriter.Writer, in CertificateDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2594,57 +10181,72 @@ func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23
 		out.RawText((in.ID).MarshalText())
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.RawText((in.UserID).MarshalText())
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"user_name\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.String(string(in.UserName))
+	}
+	{
+		const prefix string = ",\"roadmap_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"roadmap_name\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapName))
+	}
+	{
+		const prefix string = ",\"roadmap_version\":"
+		out.RawString(prefix)
+		out.String(string(in.RoadmapVersion))
 	}
 	{
-		const prefix string = ",\"author\":"
+		const prefix string = ",\"issued_at\":"
 		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
+		out.Raw((in.IssuedAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"signature\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.Signature))
 	}
-	{
-		const prefix string = ",\"updated_at\":"
+	if in.URL != "" {
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.URL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EnrichedMaterialResponseDTO) MarshalJSON() ([]byte, error) {
+func (v CertificateDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EnrichedMaterialResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(w, v)
+func (v CertificateDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EnrichedMaterialResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *CertificateDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EnrichedMaterialResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto23(l, v)
+func (v *CertificateDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto81(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(in *jlexer.Lexer, out *EdgeDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto82(in *jlexer.Lexer, out *CalendarFeedFileDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2658,24 +10260,6 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "source":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Source = string(in.String())
-			}
-		case "target":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Target = string(in.String())
-			}
-		case "id":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ID = string(in.String())
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2686,52 +10270,37 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(out *jwriter.Writer, in EdgeDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto82(out *jwriter.Writer, in CalendarFeedFileDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"source\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Source))
-	}
-	{
-		const prefix string = ",\"target\":"
-		out.RawString(prefix)
-		out.String(string(in.Target))
-	}
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.String(string(in.ID))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EdgeDTO) MarshalJSON() ([]byte, error) {
+func (v CalendarFeedFileDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EdgeDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(w, v)
+func (v CalendarFeedFileDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EdgeDTO) UnmarshalJSON(data []byte) error {
+func (v *CalendarFeedFileDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EdgeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto24(l, v)
+func (v *CalendarFeedFileDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto82(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(in *jlexer.Lexer, out *DeleteMaterialResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto83(in *jlexer.Lexer, out *CalendarFeedDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2745,11 +10314,49 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "message":
+		case "url":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Message = string(in.String())
+				out.URL = string(in.String())
+			}
+		case "event_count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EventCount = int(in.Int())
+			}
+		case "generated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.GeneratedAt = nil
+			} else {
+				if out.GeneratedAt == nil {
+					out.GeneratedAt = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					if data := in.Raw(); in.Ok() {
+						in.AddError((*out.GeneratedAt).UnmarshalJSON(data))
+					}
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -2761,42 +10368,62 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(out *jwriter.Writer, in DeleteMaterialResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto83(out *jwriter.Writer, in CalendarFeedDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"event_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.EventCount))
+	}
+	if in.GeneratedAt != nil {
+		const prefix string = ",\"generated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.GeneratedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteMaterialResponseDTO) MarshalJSON() ([]byte, error) {
+func (v CalendarFeedDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteMaterialResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(w, v)
+func (v CalendarFeedDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteMaterialResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *CalendarFeedDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteMaterialResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto25(l, v)
+func (v *CalendarFeedDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto83(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(in *jlexer.Lexer, out *CreateRoadmapResponseDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto84(in *jlexer.Lexer, out *BrokenLinksResponseDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2810,11 +10437,44 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "roadmap":
+		case "roadmap_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.RoadmapWithMaterials).UnmarshalEasyJSON(in)
+				out.RoadmapID = string(in.String())
+			}
+		case "links":
+			if in.IsNull() {
+				in.Skip()
+				out.Links = nil
+			} else {
+				in.Delim('[')
+				if out.Links == nil {
+					if !in.IsDelim(']') {
+						out.Links = make([]BrokenLinkDTO, 0, 0)
+					} else {
+						out.Links = []BrokenLinkDTO{}
+					}
+				} else {
+					out.Links = (out.Links)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v123 BrokenLinkDTO
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v123).UnmarshalEasyJSON(in)
+					}
+					out.Links = append(out.Links, v123)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -2826,42 +10486,63 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(out *jwriter.Writer, in CreateRoadmapResponseDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto84(out *jwriter.Writer, in BrokenLinksResponseDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"roadmap\":"
+		const prefix string = ",\"roadmap_id\":"
 		out.RawString(prefix[1:])
-		(in.RoadmapWithMaterials).MarshalEasyJSON(out)
+		out.String(string(in.RoadmapID))
+	}
+	{
+		const prefix string = ",\"links\":"
+		out.RawString(prefix)
+		if in.Links == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v124, v125 := range in.Links {
+				if v124 > 0 {
+					out.RawByte(',')
+				}
+				(v125).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateRoadmapResponseDTO) MarshalJSON() ([]byte, error) {
+func (v BrokenLinksResponseDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateRoadmapResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(w, v)
+func (v BrokenLinksResponseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateRoadmapResponseDTO) UnmarshalJSON(data []byte) error {
+func (v *BrokenLinksResponseDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateRoadmapResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto26(l, v)
+func (v *BrokenLinksResponseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto84(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(in *jlexer.Lexer, out *CreateRoadmapRequestDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto85(in *jlexer.Lexer, out *BrokenLinkDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2875,25 +10556,39 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "author_id":
+		case "node_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				if data := in.UnsafeBytes(); in.Ok() {
-					in.AddError((out.AuthorID).UnmarshalText(data))
+					in.AddError((out.NodeID).UnmarshalText(data))
 				}
 			}
-		case "is_public":
+		case "material_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsPublic = bool(in.Bool())
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.MaterialID).UnmarshalText(data))
+				}
 			}
-		case "roadmap":
+		case "material_name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Roadmap).UnmarshalEasyJSON(in)
+				out.MaterialName = string(in.String())
+			}
+		case "url":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.URL = string(in.String())
+			}
+		case "link_check":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.LinkCheck).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -2905,52 +10600,62 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(out *jwriter.Writer, in CreateRoadmapRequestDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto85(out *jwriter.Writer, in BrokenLinkDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"author_id\":"
+		const prefix string = ",\"node_id\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.AuthorID).MarshalText())
+		out.RawText((in.NodeID).MarshalText())
 	}
 	{
-		const prefix string = ",\"is_public\":"
+		const prefix string = ",\"material_id\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsPublic))
+		out.RawText((in.MaterialID).MarshalText())
 	}
 	{
-		const prefix string = ",\"roadmap\":"
+		const prefix string = ",\"material_name\":"
 		out.RawString(prefix)
-		(in.Roadmap).MarshalEasyJSON(out)
+		out.String(string(in.MaterialName))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"link_check\":"
+		out.RawString(prefix)
+		(in.LinkCheck).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateRoadmapRequestDTO) MarshalJSON() ([]byte, error) {
+func (v BrokenLinkDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateRoadmapRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(w, v)
+func (v BrokenLinkDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateRoadmapRequestDTO) UnmarshalJSON(data []byte) error {
+func (v *BrokenLinkDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateRoadmapRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto27(l, v)
+func (v *BrokenLinkDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto85(l, v)
 }
-func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(in *jlexer.Lexer, out *CreateMaterialRequestDTO) {
+func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto86(in *jlexer.Lexer, out *AppliedEditOperationDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2964,17 +10669,39 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "name":
+		case "roadmap_id":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Name = string(in.String())
+				out.RoadmapID = string(in.String())
 			}
-		case "url":
+		case "version":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.URL = string(in.String())
+				out.Version = int64(in.Int64())
+			}
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((out.UserID).UnmarshalText(data))
+				}
+			}
+		case "operation":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Operation).UnmarshalEasyJSON(in)
+			}
+		case "applied_at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AppliedAt).UnmarshalJSON(data))
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -2986,43 +10713,58 @@ func easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(out *jwriter.Writer, in CreateMaterialRequestDTO) {
+func easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto86(out *jwriter.Writer, in AppliedEditOperationDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"roadmap_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.String(string(in.RoadmapID))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"version\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.Int64(int64(in.Version))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.RawText((in.UserID).MarshalText())
+	}
+	{
+		const prefix string = ",\"operation\":"
+		out.RawString(prefix)
+		(in.Operation).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"applied_at\":"
+		out.RawString(prefix)
+		out.Raw((in.AppliedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateMaterialRequestDTO) MarshalJSON() ([]byte, error) {
+func (v AppliedEditOperationDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(&w, v)
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateMaterialRequestDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(w, v)
+func (v AppliedEditOperationDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComF0urwardProftwistBackendServicesRoadmapDto86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateMaterialRequestDTO) UnmarshalJSON(data []byte) error {
+func (v *AppliedEditOperationDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(&r, v)
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateMaterialRequestDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto28(l, v)
+func (v *AppliedEditOperationDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComF0urwardProftwistBackendServicesRoadmapDto86(l, v)
 }
@@ -1,9 +1,12 @@
 package dto
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/F0urward/proftwist-backend/internal/entities"
 )
@@ -25,18 +28,21 @@ func EntityToWithMaterialsDTO(entity *entities.Roadmap) RoadmapWithMaterialsDTO
 		ID:                 entity.ID,
 		NodesWithMaterials: NodesToWithMaterialsDTO(entity.Nodes),
 		Edges:              EdgesToDTO(entity.Edges),
+		UpdatedBy:          entity.UpdatedBy,
 		CreatedAt:          entity.CreatedAt,
 		UpdatedAt:          entity.UpdatedAt,
 	}
 }
 
-func EntityToDTOWithProgress(entity *entities.Roadmap, userProgress *entities.UserProgress) RoadmapWithProgressDTO {
+func EntityToDTOWithProgress(entity *entities.Roadmap, userProgress *entities.UserProgress, lang string) RoadmapWithProgressDTO {
 	return RoadmapWithProgressDTO{
-		ID:        entity.ID,
-		Nodes:     NodesToDTOWithProgress(entity.Nodes, userProgress),
-		Edges:     EdgesToDTO(entity.Edges),
-		CreatedAt: entity.CreatedAt,
-		UpdatedAt: entity.UpdatedAt,
+		ID:                   entity.ID,
+		Nodes:                NodesToDTOWithProgress(entity.Nodes, userProgress, lang),
+		Edges:                EdgesToDTO(entity.Edges),
+		EnforcePrerequisites: entity.EnforcePrerequisites,
+		UpdatedBy:            entity.UpdatedBy,
+		CreatedAt:            entity.CreatedAt,
+		UpdatedAt:            entity.UpdatedAt,
 	}
 }
 
@@ -93,6 +99,10 @@ func UpdateRequestToEntityWithMaterials(existing *entities.Roadmap, request *Upd
 		updated.Edges = DTOToEdges(request.Edges)
 	}
 
+	if request.EnforcePrerequisites != nil {
+		updated.EnforcePrerequisites = *request.EnforcePrerequisites
+	}
+
 	updated.UpdatedAt = time.Now()
 
 	return &updated
@@ -129,6 +139,8 @@ func mergeNodesWithMaterials(existingNodes []entities.RoadmapNode, newNodesDTO [
 
 		if existingNode, exists := existingNodesMap[newNodeDTO.ID]; exists {
 			newNode.Materials = existingNode.Materials
+			newNode.CreatedBy = existingNode.CreatedBy
+			newNode.UpdatedBy = existingNode.UpdatedBy
 		} else {
 			newNode.Materials = []entities.Material{}
 		}
@@ -197,12 +209,14 @@ func NodesToWithMaterialsDTO(nodes []entities.RoadmapNode) []NodeWithMaterialsDT
 			Selected:  node.Selected,
 			Dragging:  node.Dragging,
 			Materials: MaterialListToDTO(node.Materials),
+			CreatedBy: node.CreatedBy,
+			UpdatedBy: node.UpdatedBy,
 		}
 	}
 	return result
 }
 
-func NodesToDTOWithProgress(nodes []entities.RoadmapNode, userProgress *entities.UserProgress) []NodeWithProgressDTO {
+func NodesToDTOWithProgress(nodes []entities.RoadmapNode, userProgress *entities.UserProgress, lang string) []NodeWithProgressDTO {
 	if nodes == nil {
 		return nil
 	}
@@ -227,9 +241,11 @@ func NodesToDTOWithProgress(nodes []entities.RoadmapNode, userProgress *entities
 					Width:  node.Measured.Width,
 					Height: node.Measured.Height,
 				},
-				Selected: node.Selected,
-				Dragging: node.Dragging,
-				Progress: nil,
+				Selected:  node.Selected,
+				Dragging:  node.Dragging,
+				Progress:  nil,
+				CreatedBy: node.CreatedBy,
+				UpdatedBy: node.UpdatedBy,
 			}
 		}
 		return result
@@ -252,19 +268,17 @@ func NodesToDTOWithProgress(nodes []entities.RoadmapNode, userProgress *entities
 				Width:  node.Measured.Width,
 				Height: node.Measured.Height,
 			},
-			Selected: node.Selected,
-			Dragging: node.Dragging,
+			Selected:  node.Selected,
+			Dragging:  node.Dragging,
+			CreatedBy: node.CreatedBy,
+			UpdatedBy: node.UpdatedBy,
 		}
 
-		if progress, exists := userProgress.Progress[node.ID]; exists {
-			nodeDTO.Progress = &NodeProgress{
-				Status: NodeProgressStatus(progress.Status),
-			}
-		} else {
-			nodeDTO.Progress = &NodeProgress{
-				Status: NodeProgressPending,
-			}
+		progress, exists := userProgress.Progress[node.ID]
+		if !exists {
+			progress = entities.NodeProgress{Status: entities.NodeProgressPending}
 		}
+		nodeDTO.Progress = NodeProgressToDTO(progress, lang)
 
 		result[i] = nodeDTO
 	}
@@ -347,9 +361,10 @@ func EdgesToDTO(edges []entities.RoadmapEdge) []EdgeDTO {
 	result := make([]EdgeDTO, len(edges))
 	for i, edge := range edges {
 		result[i] = EdgeDTO{
-			Source: edge.Source,
-			Target: edge.Target,
-			ID:     edge.ID,
+			Source:       edge.Source,
+			Target:       edge.Target,
+			ID:           edge.ID,
+			Prerequisite: edge.Prerequisite,
 		}
 	}
 	return result
@@ -363,14 +378,167 @@ func DTOToEdges(edgesDTO []EdgeDTO) []entities.RoadmapEdge {
 	result := make([]entities.RoadmapEdge, len(edgesDTO))
 	for i, edgeDTO := range edgesDTO {
 		result[i] = entities.RoadmapEdge{
-			Source: edgeDTO.Source,
-			Target: edgeDTO.Target,
-			ID:     edgeDTO.ID,
+			Source:       edgeDTO.Source,
+			Target:       edgeDTO.Target,
+			ID:           edgeDTO.ID,
+			Prerequisite: edgeDTO.Prerequisite,
 		}
 	}
 	return result
 }
 
+// ==================== Note Mappers ====================
+
+const noteSnippetRadius = 80
+
+func ApplyNodeLocks(nodes []NodeWithProgressDTO, locked map[uuid.UUID][]uuid.UUID) {
+	for i := range nodes {
+		if requiredIDs, ok := locked[nodes[i].ID]; ok && len(requiredIDs) > 0 {
+			nodes[i].Locked = true
+			nodes[i].LockedBy = requiredIDs
+		}
+	}
+}
+
+func ApplyNodeNotes(nodes []NodeWithProgressDTO, notes map[uuid.UUID]*entities.NodeNote) {
+	for i := range nodes {
+		if note, ok := notes[nodes[i].ID]; ok && note != nil {
+			nodes[i].Note = &NodeNoteDTO{
+				Content:   note.Content,
+				UpdatedAt: note.UpdatedAt,
+			}
+		}
+	}
+}
+
+func NodeNoteToDTO(note *entities.NodeNote) NodeNoteResponseDTO {
+	return NodeNoteResponseDTO{
+		RoadmapID: note.RoadmapID.Hex(),
+		NodeID:    note.NodeID,
+		Content:   note.Content,
+		CreatedAt: note.CreatedAt,
+		UpdatedAt: note.UpdatedAt,
+	}
+}
+
+func UpsertNodeNoteRequestToEntity(req *UpsertNodeNoteRequestDTO, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) *entities.NodeNote {
+	now := time.Now()
+	return &entities.NodeNote{
+		UserID:    userID,
+		RoadmapID: roadmapID,
+		NodeID:    nodeID,
+		Content:   req.Content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func NodeNotesToSearchDTO(notes []*entities.NodeNote, query string, total, limit, offset int) NodeNoteSearchResponseDTO {
+	results := make([]NodeNoteSearchResultDTO, 0, len(notes))
+
+	for _, note := range notes {
+		if note == nil {
+			continue
+		}
+
+		results = append(results, NodeNoteSearchResultDTO{
+			RoadmapID: note.RoadmapID.Hex(),
+			NodeID:    note.NodeID,
+			Snippet:   noteSnippet(note.Content, query),
+			UpdatedAt: note.UpdatedAt,
+		})
+	}
+
+	return NodeNoteSearchResponseDTO{
+		Notes:  results,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+// noteSnippet cuts the note around the first query word found in it.
+func noteSnippet(content, query string) string {
+	runes := []rune(content)
+	lower := []rune(strings.ToLower(content))
+
+	start := 0
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if idx := indexRunes(lower, []rune(word)); idx >= 0 {
+			start = idx
+			break
+		}
+	}
+
+	from := max(start-noteSnippetRadius, 0)
+	to := min(start+noteSnippetRadius, len(runes))
+
+	snippet := strings.TrimSpace(string(runes[from:to]))
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(runes) {
+		snippet += "…"
+	}
+
+	return snippet
+}
+
+func indexRunes(haystack, needle []rune) int {
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if string(haystack[i:i+len(needle)]) == string(needle) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ==================== Export Mappers ====================
+
+func RoadmapToMarkdownExport(name, description string, roadmap *entities.Roadmap, progress *entities.UserProgress, notes map[uuid.UUID]*entities.NodeNote) *RoadmapExportDTO {
+	var b strings.Builder
+
+	if name == "" {
+		name = roadmap.ID.Hex()
+	}
+
+	fmt.Fprintf(&b, "# %s\n\n", name)
+	if description = strings.TrimSpace(description); description != "" {
+		fmt.Fprintf(&b, "%s\n\n", description)
+	}
+
+	for _, node := range roadmap.Nodes {
+		fmt.Fprintf(&b, "## %s\n\n", node.Data.Label)
+
+		if progress != nil {
+			if nodeProgress, ok := progress.Progress[node.ID]; ok && nodeProgress.Status != "" {
+				fmt.Fprintf(&b, "**Статус:** %s\n\n", nodeProgress.Status.Label(entities.DefaultLanguage))
+			}
+		}
+
+		if desc := strings.TrimSpace(node.Description); desc != "" {
+			fmt.Fprintf(&b, "%s\n\n", desc)
+		}
+
+		if len(node.Materials) > 0 {
+			b.WriteString("### Материалы\n\n")
+			for _, material := range node.Materials {
+				fmt.Fprintf(&b, "- [%s](%s)\n", material.Name, material.URL)
+			}
+			b.WriteString("\n")
+		}
+
+		if note, ok := notes[node.ID]; ok && note != nil {
+			fmt.Fprintf(&b, "### Мои заметки\n\n%s\n\n", strings.TrimSpace(note.Content))
+		}
+	}
+
+	return &RoadmapExportDTO{
+		FileName: fmt.Sprintf("roadmap-%s.md", roadmap.ID.Hex()),
+		Content:  []byte(b.String()),
+	}
+}
+
 // ==================== Material Mappers ====================
 
 func MaterialListToDTO(materials []entities.Material) []Material {
@@ -381,12 +549,19 @@ func MaterialListToDTO(materials []entities.Material) []Material {
 	result := make([]Material, len(materials))
 	for i, material := range materials {
 		result[i] = Material{
-			ID:        material.ID,
-			Name:      material.Name,
-			URL:       material.URL,
-			AuthorID:  material.AuthorID,
-			CreatedAt: material.CreatedAt,
-			UpdatedAt: material.UpdatedAt,
+			ID:              material.ID,
+			Name:            material.Name,
+			URL:             material.URL,
+			Type:            material.Type.OrDefault(),
+			DurationMinutes: material.DurationMinutes,
+			Language:        material.Language,
+			Difficulty:      material.Difficulty,
+			IsPaid:          material.IsPaid,
+			File:            MaterialFileToDTO(material.File),
+			Preview:         MaterialPreviewToDTO(material.Preview),
+			AuthorID:        material.AuthorID,
+			CreatedAt:       material.CreatedAt,
+			UpdatedAt:       material.UpdatedAt,
 		}
 	}
 	return result
@@ -400,12 +575,18 @@ func DTOToMaterials(materialsDTO []Material) []entities.Material {
 	result := make([]entities.Material, len(materialsDTO))
 	for i, materialDTO := range materialsDTO {
 		result[i] = entities.Material{
-			ID:        materialDTO.ID,
-			Name:      materialDTO.Name,
-			URL:       materialDTO.URL,
-			AuthorID:  materialDTO.AuthorID,
-			CreatedAt: materialDTO.CreatedAt,
-			UpdatedAt: materialDTO.UpdatedAt,
+			ID:              materialDTO.ID,
+			Name:            materialDTO.Name,
+			URL:             materialDTO.URL,
+			Type:            materialDTO.Type,
+			DurationMinutes: materialDTO.DurationMinutes,
+			Language:        materialDTO.Language,
+			Difficulty:      materialDTO.Difficulty,
+			IsPaid:          materialDTO.IsPaid,
+			Preview:         DTOToMaterialPreview(materialDTO.Preview),
+			AuthorID:        materialDTO.AuthorID,
+			CreatedAt:       materialDTO.CreatedAt,
+			UpdatedAt:       materialDTO.UpdatedAt,
 		}
 	}
 	return result
@@ -417,16 +598,108 @@ func MaterialToEnrichedDTO(material *entities.Material, author MaterialAuthorDTO
 	}
 
 	return EnrichedMaterialResponseDTO{
-		ID:        material.ID,
-		Name:      material.Name,
-		URL:       material.URL,
-		Author:    author,
-		CreatedAt: material.CreatedAt,
-		UpdatedAt: material.UpdatedAt,
+		ID:              material.ID,
+		Name:            material.Name,
+		URL:             material.URL,
+		Type:            material.Type.OrDefault(),
+		DurationMinutes: material.DurationMinutes,
+		Language:        material.Language,
+		Difficulty:      material.Difficulty,
+		IsPaid:          material.IsPaid,
+		File:            MaterialFileToDTO(material.File),
+		Preview:         MaterialPreviewToDTO(material.Preview),
+		LinkCheck:       MaterialLinkCheckToDTO(material.LinkCheck),
+		Author:          author,
+		CreatedAt:       material.CreatedAt,
+		UpdatedAt:       material.UpdatedAt,
+	}
+}
+
+func MaterialFileToDTO(file *entities.MaterialFile) *MaterialFileDTO {
+	if file == nil {
+		return nil
+	}
+
+	return &MaterialFileDTO{
+		Name:        file.Name,
+		Size:        file.Size,
+		ContentType: file.ContentType,
+	}
+}
+
+func MaterialPreviewToDTO(preview *entities.MaterialPreview) *MaterialPreviewDTO {
+	if preview == nil {
+		return nil
+	}
+
+	return &MaterialPreviewDTO{
+		Title:       preview.Title,
+		Description: preview.Description,
+		SiteName:    preview.SiteName,
+		FaviconURL:  preview.FaviconURL,
+		ImageURL:    preview.ImageURL,
 	}
 }
 
-func MaterialListToEnrichedDTO(materials []*entities.Material, authorData map[uuid.UUID]MaterialAuthorDTO) MaterialListResponseDTO {
+func DTOToMaterialPreview(previewDTO *MaterialPreviewDTO) *entities.MaterialPreview {
+	if previewDTO == nil {
+		return nil
+	}
+
+	return &entities.MaterialPreview{
+		Title:       previewDTO.Title,
+		Description: previewDTO.Description,
+		SiteName:    previewDTO.SiteName,
+		FaviconURL:  previewDTO.FaviconURL,
+		ImageURL:    previewDTO.ImageURL,
+	}
+}
+
+func MaterialLinkCheckToDTO(check *entities.MaterialLinkCheck) *MaterialLinkCheckDTO {
+	if check == nil {
+		return nil
+	}
+
+	return &MaterialLinkCheckDTO{
+		Status:     check.Status,
+		StatusCode: check.StatusCode,
+		FinalURL:   check.FinalURL,
+		Redirects:  check.Redirects,
+		Error:      check.Error,
+		CheckedAt:  check.CheckedAt,
+	}
+}
+
+func BrokenMaterialsToDTO(roadmapID primitive.ObjectID, targets []*entities.MaterialLinkTarget) BrokenLinksResponseDTO {
+	links := make([]BrokenLinkDTO, 0, len(targets))
+
+	for _, target := range targets {
+		if target == nil || target.Material.LinkCheck == nil {
+			continue
+		}
+
+		links = append(links, BrokenLinkDTO{
+			NodeID:       target.NodeID,
+			MaterialID:   target.Material.ID,
+			MaterialName: target.Material.Name,
+			URL:          target.Material.URL,
+			LinkCheck:    *MaterialLinkCheckToDTO(target.Material.LinkCheck),
+		})
+	}
+
+	return BrokenLinksResponseDTO{
+		RoadmapID: roadmapID.Hex(),
+		Links:     links,
+		Total:     len(links),
+	}
+}
+
+func MaterialListToEnrichedDTO(
+	materials []*entities.Material,
+	authorData map[uuid.UUID]MaterialAuthorDTO,
+	voteStats map[uuid.UUID]*entities.MaterialVoteStats,
+	myVotes map[uuid.UUID]*entities.MaterialVote,
+) MaterialListResponseDTO {
 	materialDTOs := make([]EnrichedMaterialResponseDTO, 0, len(materials))
 
 	for _, material := range materials {
@@ -443,7 +716,11 @@ func MaterialListToEnrichedDTO(materials []*entities.Material, authorData map[uu
 			}
 		}
 
-		materialDTOs = append(materialDTOs, MaterialToEnrichedDTO(material, author))
+		materialDTO := MaterialToEnrichedDTO(material, author)
+		materialDTO.Votes = MaterialVoteStatsToDTO(voteStats[material.ID])
+		materialDTO.MyVote = MaterialVoteToMyVoteDTO(myVotes[material.ID])
+
+		materialDTOs = append(materialDTOs, materialDTO)
 	}
 
 	return MaterialListResponseDTO{
@@ -452,34 +729,445 @@ func MaterialListToEnrichedDTO(materials []*entities.Material, authorData map[uu
 	}
 }
 
+func MaterialVoteStatsToDTO(stats *entities.MaterialVoteStats) MaterialVotesDTO {
+	if stats == nil {
+		return MaterialVotesDTO{}
+	}
+
+	return MaterialVotesDTO{
+		Upvotes:   stats.Upvotes,
+		Downvotes: stats.Downvotes,
+		Helpful:   stats.Helpful,
+		Score:     stats.WilsonScore(),
+	}
+}
+
+func MaterialVoteToMyVoteDTO(vote *entities.MaterialVote) *MaterialMyVoteDTO {
+	if vote == nil {
+		return nil
+	}
+
+	return &MaterialMyVoteDTO{
+		Vote:    vote.Value,
+		Helpful: vote.Helpful,
+	}
+}
+
+func VoteMaterialRequestToEntity(req *VoteMaterialRequestDTO, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID) *entities.MaterialVote {
+	now := time.Now()
+	return &entities.MaterialVote{
+		UserID:     userID,
+		RoadmapID:  roadmapID,
+		NodeID:     nodeID,
+		MaterialID: materialID,
+		Value:      req.Vote,
+		Helpful:    req.Helpful,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
 func CreateMaterialRequestToEntity(req CreateMaterialRequestDTO, authorID uuid.UUID) *entities.Material {
 	now := time.Now()
 	return &entities.Material{
-		ID:        uuid.New(),
-		Name:      req.Name,
-		URL:       req.URL,
-		AuthorID:  authorID,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:              uuid.New(),
+		Name:            req.Name,
+		URL:             req.URL,
+		Type:            req.Type.OrDefault(),
+		DurationMinutes: req.DurationMinutes,
+		Language:        req.Language,
+		Difficulty:      req.Difficulty,
+		IsPaid:          req.IsPaid,
+		AuthorID:        authorID,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+func UploadMaterialRequestToUploadInputEntity(req *UploadMaterialRequestDTO) *entities.UploadInput {
+	return &entities.UploadInput{
+		File:        req.File,
+		Name:        req.FileName,
+		Size:        req.Size,
+		ContentType: req.ContentType,
+		BucketName:  req.BucketName,
+	}
+}
+
+func UploadMaterialRequestToEntity(req *UploadMaterialRequestDTO, authorID uuid.UUID) *entities.Material {
+	now := time.Now()
+	return &entities.Material{
+		ID:              uuid.New(),
+		Name:            req.Name,
+		Type:            entities.MaterialTypeFile,
+		DurationMinutes: req.DurationMinutes,
+		Language:        req.Language,
+		Difficulty:      req.Difficulty,
+		IsPaid:          req.IsPaid,
+		AuthorID:        authorID,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// ==================== Regeneration Mappers ====================
+
+func (m RegenerationMode) IsValid() bool {
+	switch m {
+	case RegenerationModeReplace,
+		RegenerationModeExpand:
+		return true
+	default:
+		return false
 	}
 }
 
 // ==================== Progress Mappers ====================
 
-func (s NodeProgressStatus) IsValid() bool {
-	switch s {
-	case NodeProgressPending,
-		NodeProgressInProgress,
-		NodeProgressDone,
-		NodeProgressSkipped:
+func NodeProgressToDTO(progress entities.NodeProgress, lang string) *NodeProgress {
+	status := progress.Status
+	if status == "" {
+		status = entities.NodeProgressPending
+	}
+
+	return &NodeProgress{
+		Status:           NodeProgressStatus(status),
+		StatusLabel:      status.Label(lang),
+		StartedAt:        progress.StartedAt,
+		CompletedAt:      progress.CompletedAt,
+		TimeSpentMinutes: progress.TimeSpentMinutes,
+		Confidence:       progress.Confidence,
+	}
+}
+
+func ProgressEventsToTimelineDTO(roadmapID primitive.ObjectID, events []*entities.ProgressEvent, nodeLabels map[uuid.UUID]string, lang string, total, limit, offset int) ProgressTimelineResponseDTO {
+	result := make([]ProgressEventDTO, 0, len(events))
+
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+
+		eventDTO := ProgressEventDTO{
+			NodeID:           event.NodeID,
+			NodeLabel:        nodeLabels[event.NodeID],
+			Type:             string(event.Type),
+			FromStatus:       NodeProgressStatus(event.FromStatus),
+			ToStatus:         NodeProgressStatus(event.ToStatus),
+			TimeSpentMinutes: event.TimeSpentMinutes,
+			Confidence:       event.Confidence,
+			CreatedAt:        event.CreatedAt,
+		}
+		if event.FromStatus != "" {
+			eventDTO.FromStatusLabel = event.FromStatus.Label(lang)
+		}
+		if event.ToStatus != "" {
+			eventDTO.ToStatusLabel = event.ToStatus.Label(lang)
+		}
+
+		result = append(result, eventDTO)
+	}
+
+	return ProgressTimelineResponseDTO{
+		RoadmapID: roadmapID.Hex(),
+		Events:    result,
+		Total:     total,
+		Limit:     limit,
+		Offset:    offset,
+	}
+}
+
+func ProgressSummaryToDTO(roadmapID primitive.ObjectID, summary *entities.ProgressSummary, lang string) ProgressSummaryDTO {
+	nextNodes := make([]ProgressSummaryNodeDTO, 0, len(summary.NextNodes))
+	for _, node := range summary.NextNodes {
+		nextNodes = append(nextNodes, ProgressSummaryNodeDTO{
+			ID:          node.ID,
+			Label:       node.Label,
+			Status:      NodeProgressStatus(node.Status),
+			StatusLabel: node.Status.Label(lang),
+		})
+	}
+
+	return ProgressSummaryDTO{
+		RoadmapID:         roadmapID.Hex(),
+		TotalNodes:        summary.TotalNodes,
+		DoneCount:         summary.DoneCount,
+		InProgressCount:   summary.InProgressCount,
+		SkippedCount:      summary.SkippedCount,
+		PendingCount:      summary.PendingCount,
+		CompletionPercent: summary.CompletionPercent,
+		TimeSpentMinutes:  summary.TimeSpentMinutes,
+		NextNodes:         nextNodes,
+		LastActivityAt:    summary.LastActivityAt,
+	}
+}
+
+func LearningOrderToDTO(roadmap *entities.Roadmap, ordered []entities.RoadmapNode, userProgress *entities.UserProgress, locked map[uuid.UUID][]uuid.UUID, lang string) LearningOrderResponseDTO {
+	nodes := make([]LearningOrderNodeDTO, 0, len(ordered))
+	for _, node := range ordered {
+		nodeDTO := LearningOrderNodeDTO{
+			ID:       node.ID,
+			Label:    node.Data.Label,
+			Type:     node.Data.Type,
+			Locked:   len(locked[node.ID]) > 0,
+			LockedBy: locked[node.ID],
+		}
+
+		if userProgress != nil {
+			nodeDTO.Progress = NodeProgressToDTO(userProgress.Progress[node.ID], lang)
+		}
+
+		nodes = append(nodes, nodeDTO)
+	}
+
+	return LearningOrderResponseDTO{
+		RoadmapID:            roadmap.ID.Hex(),
+		EnforcePrerequisites: roadmap.EnforcePrerequisites,
+		Nodes:                nodes,
+	}
+}
+
+// ==================== Certificate Mappers ====================
+
+func CertificateToDTO(certificate *entities.Certificate) CertificateDTO {
+	return CertificateDTO{
+		ID:             certificate.ID,
+		UserID:         certificate.UserID,
+		UserName:       certificate.UserName,
+		RoadmapID:      certificate.RoadmapID.Hex(),
+		RoadmapName:    certificate.RoadmapName,
+		RoadmapVersion: certificate.RoadmapVersion,
+		IssuedAt:       certificate.IssuedAt,
+		Signature:      certificate.Signature,
+		URL:            certificate.URL,
+	}
+}
+
+func CertificatesToDTO(certificates []*entities.Certificate) []CertificateDTO {
+	result := make([]CertificateDTO, 0, len(certificates))
+	for _, certificate := range certificates {
+		if certificate != nil {
+			result = append(result, CertificateToDTO(certificate))
+		}
+	}
+	return result
+}
+
+// ==================== Learning Goal Mappers ====================
+
+func LearningGoalToDTO(goal *entities.LearningGoal, projection entities.LearningGoalProjection) LearningGoalDTO {
+	return LearningGoalDTO{
+		RoadmapID:             goal.RoadmapID.Hex(),
+		Deadline:              goal.Deadline,
+		WeeklyPace:            goal.WeeklyPace,
+		RemindersEnabled:      goal.RemindersEnabled,
+		Status:                projection.Status,
+		TotalNodes:            projection.TotalNodes,
+		FinishedNodes:         projection.FinishedNodes,
+		RemainingNodes:        projection.RemainingNodes,
+		ActualPace:            projection.ActualPace,
+		RequiredPace:          projection.RequiredPace,
+		ProjectedCompletionAt: projection.ProjectedCompletionAt,
+		LastRemindedAt:        goal.LastRemindedAt,
+		CreatedAt:             goal.CreatedAt,
+		UpdatedAt:             goal.UpdatedAt,
+	}
+}
+
+// ==================== Calendar Feed Mappers ====================
+
+func CalendarFeedToDTO(feed *entities.CalendarFeed, url string) CalendarFeedDTO {
+	return CalendarFeedDTO{
+		URL:         url,
+		EventCount:  len(feed.Events),
+		GeneratedAt: feed.GeneratedAt,
+		CreatedAt:   feed.CreatedAt,
+		UpdatedAt:   feed.UpdatedAt,
+	}
+}
+
+// ==================== Quiz Mappers ====================
+
+// QuizQuestionsToDTO maps quiz questions. Correct options and accepted answers
+// are only included with withAnswers, so learners cannot read them up front.
+func QuizQuestionsToDTO(questions []entities.QuizQuestion, withAnswers bool) []QuizQuestionDTO {
+	result := make([]QuizQuestionDTO, 0, len(questions))
+	for _, question := range questions {
+		questionDTO := QuizQuestionDTO{
+			ID:   question.ID,
+			Type: question.Type,
+			Text: question.Text,
+		}
+
+		for _, option := range question.Options {
+			optionDTO := QuizOptionDTO{
+				ID:   option.ID,
+				Text: option.Text,
+			}
+			if withAnswers {
+				correct := option.Correct
+				optionDTO.Correct = &correct
+			}
+			questionDTO.Options = append(questionDTO.Options, optionDTO)
+		}
+
+		if withAnswers {
+			questionDTO.AcceptedAnswers = question.AcceptedAnswers
+			questionDTO.Explanation = question.Explanation
+		}
+
+		result = append(result, questionDTO)
+	}
+	return result
+}
+
+func QuizQuestionsFromDTO(questions []QuizQuestionDTO) []entities.QuizQuestion {
+	result := make([]entities.QuizQuestion, 0, len(questions))
+	for _, questionDTO := range questions {
+		question := entities.QuizQuestion{
+			ID:              questionDTO.ID,
+			Type:            questionDTO.Type,
+			Text:            strings.TrimSpace(questionDTO.Text),
+			Explanation:     strings.TrimSpace(questionDTO.Explanation),
+			AcceptedAnswers: make([]string, 0, len(questionDTO.AcceptedAnswers)),
+		}
+
+		for _, optionDTO := range questionDTO.Options {
+			question.Options = append(question.Options, entities.QuizOption{
+				ID:      optionDTO.ID,
+				Text:    strings.TrimSpace(optionDTO.Text),
+				Correct: optionDTO.Correct != nil && *optionDTO.Correct,
+			})
+		}
+
+		for _, answer := range questionDTO.AcceptedAnswers {
+			if answer = strings.TrimSpace(answer); answer != "" {
+				question.AcceptedAnswers = append(question.AcceptedAnswers, answer)
+			}
+		}
+
+		result = append(result, question)
+	}
+	return result
+}
+
+func NodeQuizToDTO(quiz *entities.NodeQuiz, withAnswers bool) NodeQuizDTO {
+	return NodeQuizDTO{
+		RoadmapID:    quiz.RoadmapID.Hex(),
+		NodeID:       quiz.NodeID,
+		Questions:    QuizQuestionsToDTO(quiz.Questions, withAnswers),
+		PassingScore: quiz.PassingScore,
+		AutoComplete: quiz.AutoComplete,
+		UpdatedAt:    quiz.UpdatedAt,
+	}
+}
+
+func QuizAnswersFromDTO(answers []QuizAnswerDTO) []entities.QuizAnswer {
+	result := make([]entities.QuizAnswer, 0, len(answers))
+	for _, answer := range answers {
+		result = append(result, entities.QuizAnswer{
+			QuestionID: answer.QuestionID,
+			OptionIDs:  answer.OptionIDs,
+			Text:       strings.TrimSpace(answer.Text),
+		})
+	}
+	return result
+}
+
+func QuizAttemptToDTO(attempt *entities.QuizAttempt) QuizAttemptDTO {
+	return QuizAttemptDTO{
+		ID:           attempt.ID.Hex(),
+		CorrectCount: attempt.CorrectCount,
+		TotalCount:   attempt.TotalCount,
+		Score:        attempt.Score,
+		Passed:       attempt.Passed,
+		CreatedAt:    attempt.CreatedAt,
+	}
+}
+
+func QuizAttemptsToDTO(attempts []*entities.QuizAttempt) []QuizAttemptDTO {
+	result := make([]QuizAttemptDTO, 0, len(attempts))
+	for _, attempt := range attempts {
+		if attempt != nil {
+			result = append(result, QuizAttemptToDTO(attempt))
+		}
+	}
+	return result
+}
+
+// QuizAttemptResultToDTO reveals the right answers together with the user's
+// own ones once the attempt is submitted.
+func QuizAttemptResultToDTO(quiz *entities.NodeQuiz, attempt *entities.QuizAttempt, nodeCompleted bool) QuizAttemptResultDTO {
+	questions := make(map[uuid.UUID]entities.QuizQuestion, len(quiz.Questions))
+	for _, question := range quiz.Questions {
+		questions[question.ID] = question
+	}
+
+	answers := make([]QuizAnswerResultDTO, 0, len(attempt.Answers))
+	for _, answer := range attempt.Answers {
+		answerDTO := QuizAnswerResultDTO{
+			QuestionID: answer.QuestionID,
+			OptionIDs:  answer.OptionIDs,
+			Text:       answer.Text,
+			Correct:    answer.Correct,
+		}
+
+		if question, ok := questions[answer.QuestionID]; ok {
+			for _, option := range question.Options {
+				if option.Correct {
+					answerDTO.CorrectOptionIDs = append(answerDTO.CorrectOptionIDs, option.ID)
+				}
+			}
+			answerDTO.AcceptedAnswers = question.AcceptedAnswers
+			answerDTO.Explanation = question.Explanation
+		}
+
+		answers = append(answers, answerDTO)
+	}
+
+	return QuizAttemptResultDTO{
+		Attempt:       QuizAttemptToDTO(attempt),
+		Answers:       answers,
+		NodeCompleted: nodeCompleted,
+	}
+}
+
+// ==================== Suggestion Mappers ====================
+
+func MaterialSuggestionsToDTO(suggestions []entities.MaterialSuggestion) []SuggestedMaterialDTO {
+	result := make([]SuggestedMaterialDTO, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		result = append(result, SuggestedMaterialDTO{
+			Name: suggestion.Name,
+			Type: suggestion.Type,
+			URL:  suggestion.URL,
+		})
+	}
+	return result
+}
+
+// ==================== Editing Mappers ====================
+
+func (t EditOperationType) IsValid() bool {
+	switch t {
+	case EditOperationAddNode,
+		EditOperationUpdateNode,
+		EditOperationMoveNode,
+		EditOperationDeleteNode,
+		EditOperationAddEdge,
+		EditOperationDeleteEdge:
 		return true
 	default:
 		return false
 	}
 }
 
-func UpdateNodeProgressRequestToEntity(req UpdateNodeProgressRequestDTO) entities.NodeProgress {
-	return entities.NodeProgress{
-		Status: entities.NodeProgressStatus(req.Status),
+func EntityToEditingSnapshotDTO(entity *entities.Roadmap, canEdit bool) *EditingSnapshotDTO {
+	return &EditingSnapshotDTO{
+		RoadmapID:    entity.ID.Hex(),
+		Version:      entity.Version,
+		CanEdit:      canEdit,
+		Roadmap:      EntityToWithMaterialsDTO(entity),
+		Participants: []string{},
 	}
 }
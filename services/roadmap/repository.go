@@ -2,7 +2,9 @@ package roadmap
 
 import (
 	"context"
+	"time"
 
+	"github.com/minio/minio-go/v7"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/F0urward/proftwist-backend/internal/entities"
@@ -12,17 +14,84 @@ import (
 
 type MongoRepository interface {
 	GetByID(context.Context, primitive.ObjectID) (*entities.Roadmap, error)
+	GetByIDs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*entities.Roadmap, error)
+	GetIDs(ctx context.Context, createdBefore time.Time) ([]primitive.ObjectID, error)
 	Create(context.Context, *entities.Roadmap) error
 	Update(context.Context, *entities.Roadmap) error
+	UpdateIfVersion(ctx context.Context, roadmap *entities.Roadmap, version int64) (bool, error)
 	Delete(context.Context, primitive.ObjectID) error
 	CreateMaterial(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, material *entities.Material) (*entities.Material, error)
 	DeleteMaterial(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID) error
 	GetMaterialByID(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID) (*entities.Material, error)
 	GetMaterialsByNode(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) ([]*entities.Material, error)
 	GetUserProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*entities.UserProgress, error)
+	GetUserProgressList(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.UserProgress, error)
+	CountUserProgress(ctx context.Context, userID uuid.UUID) (int, error)
 	UpsertUserProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, progress entities.NodeProgress) error
+	AppendProgressEvents(ctx context.Context, events []*entities.ProgressEvent) error
+	GetProgressEvents(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, limit, offset int) ([]*entities.ProgressEvent, error)
+	CountProgressEvents(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (int, error)
+	GetMaterialVote(ctx context.Context, userID uuid.UUID, materialID uuid.UUID) (*entities.MaterialVote, error)
+	GetUserMaterialVotes(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (map[uuid.UUID]*entities.MaterialVote, error)
+	GetMaterialVoteStats(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (map[uuid.UUID]*entities.MaterialVoteStats, error)
+	UpsertMaterialVote(ctx context.Context, vote *entities.MaterialVote) error
+	DeleteMaterialVote(ctx context.Context, userID uuid.UUID, materialID uuid.UUID) error
+	DeleteMaterialVotes(ctx context.Context, materialID uuid.UUID) error
+	GetMaterialsForLinkCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*entities.MaterialLinkTarget, error)
+	GetBrokenMaterials(ctx context.Context, roadmapID primitive.ObjectID) ([]*entities.MaterialLinkTarget, error)
+	UpdateMaterialLinkCheck(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, check *entities.MaterialLinkCheck) error
+	CountBrokenLinks(ctx context.Context) (map[primitive.ObjectID]int, error)
+	GetNodeNotes(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (map[uuid.UUID]*entities.NodeNote, error)
+	UpsertNodeNote(ctx context.Context, note *entities.NodeNote) (*entities.NodeNote, error)
+	DeleteNodeNote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error
+	SearchNodeNotes(ctx context.Context, userID uuid.UUID, query string, limit, offset int) ([]*entities.NodeNote, error)
+	CountNodeNotes(ctx context.Context, userID uuid.UUID, query string) (int, error)
+	CreateCertificate(ctx context.Context, certificate *entities.Certificate) (*entities.Certificate, error)
+	GetCertificateByID(ctx context.Context, id uuid.UUID) (*entities.Certificate, error)
+	GetCertificate(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, version string) (*entities.Certificate, error)
+	GetCertificatesByUser(ctx context.Context, userID uuid.UUID) ([]*entities.Certificate, error)
+	GetNodeQuiz(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*entities.NodeQuiz, error)
+	UpsertNodeQuiz(ctx context.Context, quiz *entities.NodeQuiz) (*entities.NodeQuiz, error)
+	DeleteNodeQuiz(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) error
+	CreateQuizAttempt(ctx context.Context, attempt *entities.QuizAttempt) error
+	GetQuizAttempts(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, limit, offset int) ([]*entities.QuizAttempt, error)
+	CountQuizAttempts(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (int, error)
+	GetLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*entities.LearningGoal, error)
+	GetLearningGoalsByUser(ctx context.Context, userID uuid.UUID) ([]*entities.LearningGoal, error)
+	UpsertLearningGoal(ctx context.Context, goal *entities.LearningGoal) (*entities.LearningGoal, error)
+	DeleteLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) error
+	GetLearningGoalsForCheck(ctx context.Context, checkedBefore, createdBefore time.Time, limit int) ([]*entities.LearningGoal, error)
+	ClaimLearningGoalCheck(ctx context.Context, goalID primitive.ObjectID, previous *time.Time, checkedAt time.Time) (bool, error)
+	MarkLearningGoalReminded(ctx context.Context, goalID primitive.ObjectID, remindedAt time.Time) error
+	CountCompletedNodesSince(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, since time.Time) (int, error)
+	GetCalendarFeedByUser(ctx context.Context, userID uuid.UUID) (*entities.CalendarFeed, error)
+	GetCalendarFeedByToken(ctx context.Context, token string) (*entities.CalendarFeed, error)
+	CreateCalendarFeed(ctx context.Context, feed *entities.CalendarFeed) (*entities.CalendarFeed, error)
+	UpdateCalendarFeedToken(ctx context.Context, userID uuid.UUID, token string, updatedAt time.Time) (*entities.CalendarFeed, error)
+	UpdateCalendarFeedEvents(ctx context.Context, userID uuid.UUID, events []entities.CalendarEvent, generatedAt time.Time) error
+	DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error
+	SaveRoadmapLineage(ctx context.Context, lineage *entities.RoadmapLineage) error
+	GetRoadmapLineage(ctx context.Context, roadmapID primitive.ObjectID) (*entities.RoadmapLineage, error)
+	DeleteRoadmapLineage(ctx context.Context, roadmapID primitive.ObjectID) error
+	RemapNodeProgress(ctx context.Context, roadmapID primitive.ObjectID, nodeIDMap map[uuid.UUID]uuid.UUID) (int64, error)
+}
+
+type AWSRepository interface {
+	PutObject(ctx context.Context, input entities.UploadInput) (*minio.UploadInfo, error)
+	RemoveObject(ctx context.Context, bucket string, fileName string) error
 }
 
 type GigachatWebapi interface {
 	GenerateRoadmapContent(ctx context.Context, req *dto.GenerateRoadmapDTO) (*entities.Roadmap, error)
+	RegenerateSubtreeContent(ctx context.Context, req *dto.RegenerateSubtreeDTO) (*entities.Roadmap, error)
+	SuggestNodeContent(ctx context.Context, req *dto.NodeSuggestionsDTO) (*entities.NodeSuggestions, error)
+	GenerateNodeQuiz(ctx context.Context, req *dto.NodeQuizDraftDTO) ([]entities.QuizQuestion, error)
+}
+
+type UnfurlWebapi interface {
+	Unfurl(ctx context.Context, rawURL string) (*entities.MaterialPreview, error)
+}
+
+type LinkCheckWebapi interface {
+	CheckLink(ctx context.Context, rawURL string) *entities.MaterialLinkCheck
 }
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+const (
+	repairQuestionDropped   = "question_dropped"
+	repairQuestionTypeFixed = "question_type_fixed"
+	quizMaxTextLength       = 2000
+	quizMaxOptions          = 10
+)
+
+type rawNodeQuiz struct {
+	Questions []rawQuizQuestion `json:"questions"`
+}
+
+type rawQuizQuestion struct {
+	Type            string          `json:"type"`
+	Text            string          `json:"text"`
+	Question        string          `json:"question"`
+	Options         []rawQuizOption `json:"options"`
+	AcceptedAnswers []string        `json:"accepted_answers"`
+	Explanation     string          `json:"explanation"`
+}
+
+type rawQuizOption struct {
+	Text    string `json:"text"`
+	Correct bool   `json:"correct"`
+}
+
+// repairNodeQuizJSON parses the quiz drafted by the model. Questions that cannot
+// be answered correctly are dropped, and choice questions get their type fixed
+// to match the number of correct options.
+func repairNodeQuizJSON(text string, maxQuestions int) ([]entities.QuizQuestion, *roadmapRepairReport, error) {
+	report := newRoadmapRepairReport()
+
+	jsonData, err := extractJSONTolerant(text, report)
+	if err != nil {
+		return nil, report, err
+	}
+
+	var raw rawNodeQuiz
+	if err := json.Unmarshal([]byte(jsonData), &raw); err != nil {
+		return nil, report, fmt.Errorf("failed to unmarshal quiz: %w", err)
+	}
+
+	questions := make([]entities.QuizQuestion, 0, len(raw.Questions))
+	for i, rawQuestion := range raw.Questions {
+		if len(questions) >= maxQuestions {
+			report.add(repairQuestionDropped, "")
+			continue
+		}
+
+		question, ok := normalizeQuizQuestion(rawQuestion, report)
+		if !ok {
+			report.add(repairQuestionDropped, fmt.Sprintf("question #%d cannot be answered", i+1))
+			continue
+		}
+
+		questions = append(questions, question)
+	}
+
+	if len(questions) == 0 {
+		return nil, report, fmt.Errorf("quiz contains no usable questions")
+	}
+
+	return questions, report, nil
+}
+
+func normalizeQuizQuestion(raw rawQuizQuestion, report *roadmapRepairReport) (entities.QuizQuestion, bool) {
+	text := strings.TrimSpace(raw.Text)
+	if text == "" {
+		text = strings.TrimSpace(raw.Question)
+	}
+	if text == "" || utf8.RuneCountInString(text) > quizMaxTextLength {
+		return entities.QuizQuestion{}, false
+	}
+
+	question := entities.QuizQuestion{
+		ID:          uuid.New(),
+		Type:        entities.QuizQuestionType(strings.ToLower(strings.TrimSpace(raw.Type))),
+		Text:        text,
+		Explanation: strings.TrimSpace(raw.Explanation),
+	}
+
+	if question.Type == entities.QuizQuestionShortAnswer || (!question.Type.IsValid() && len(raw.Options) == 0) {
+		if question.Type != entities.QuizQuestionShortAnswer {
+			question.Type = entities.QuizQuestionShortAnswer
+			report.add(repairQuestionTypeFixed, "")
+		}
+
+		for _, answer := range raw.AcceptedAnswers {
+			if answer = strings.TrimSpace(answer); answer != "" {
+				question.AcceptedAnswers = append(question.AcceptedAnswers, answer)
+			}
+		}
+
+		return question, len(question.AcceptedAnswers) > 0
+	}
+
+	correct := 0
+	for _, option := range raw.Options {
+		optionText := strings.TrimSpace(option.Text)
+		if optionText == "" || len(question.Options) >= quizMaxOptions {
+			continue
+		}
+		if option.Correct {
+			correct++
+		}
+		question.Options = append(question.Options, entities.QuizOption{
+			ID:      uuid.New(),
+			Text:    optionText,
+			Correct: option.Correct,
+		})
+	}
+
+	if len(question.Options) < 2 || correct == 0 {
+		return entities.QuizQuestion{}, false
+	}
+
+	switch {
+	case correct > 1 && question.Type != entities.QuizQuestionMultipleChoice:
+		question.Type = entities.QuizQuestionMultipleChoice
+		report.add(repairQuestionTypeFixed, "")
+	case !question.Type.IsChoice():
+		question.Type = entities.QuizQuestionSingleChoice
+		report.add(repairQuestionTypeFixed, "")
+	}
+
+	return question, true
+}
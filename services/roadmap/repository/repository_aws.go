@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type RoadmapAWSRepository struct {
+	client *minio.Client
+}
+
+func NewRoadmapAWSRepository(awsClient *minio.Client) roadmap.AWSRepository {
+	return &RoadmapAWSRepository{client: awsClient}
+}
+
+func (aws *RoadmapAWSRepository) PutObject(ctx context.Context, input entities.UploadInput) (*minio.UploadInfo, error) {
+	const op = "RoadmapAWSRepository.PutObject"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"bucket_name": input.BucketName,
+		"file_name":   input.Name,
+		"file_size":   input.Size,
+	})
+
+	fileName := aws.generateFileName(input.Name)
+
+	options := minio.PutObjectOptions{
+		ContentType:  input.ContentType,
+		UserMetadata: map[string]string{"x-amz-acl": "public-read"},
+	}
+
+	uploadInfo, err := aws.client.PutObject(ctx, input.BucketName, fileName, input.File, input.Size, options)
+	if err != nil {
+		logger.WithError(err).Error("failed to put object")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("generated_file_name", fileName).Info("successfully uploaded object")
+	return &uploadInfo, nil
+}
+
+func (aws *RoadmapAWSRepository) RemoveObject(ctx context.Context, bucket string, fileName string) error {
+	const op = "RoadmapAWSRepository.RemoveObject"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":        op,
+		"bucket":    bucket,
+		"file_name": fileName,
+	})
+
+	if err := aws.client.RemoveObject(ctx, bucket, fileName, minio.RemoveObjectOptions{}); err != nil {
+		logger.WithError(err).Error("failed to remove object")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("successfully removed object")
+	return nil
+}
+
+func (aws *RoadmapAWSRepository) generateFileName(fileName string) string {
+	uid := uuid.New().String()
+	return fmt.Sprintf("%s-%s", uid, fileName)
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,19 +19,46 @@ import (
 )
 
 const (
-	roadmapsCollectionName     = "roadmaps"
-	userProgressCollectionName = "user_progress"
+	roadmapsCollectionName       = "roadmaps"
+	userProgressCollectionName   = "user_progress"
+	materialVotesCollectionName  = "material_votes"
+	nodeNotesCollectionName      = "node_notes"
+	progressEventsCollectionName = "progress_events"
+	certificatesCollectionName   = "certificates"
+	nodeQuizzesCollectionName    = "node_quizzes"
+	quizAttemptsCollectionName   = "quiz_attempts"
+	learningGoalsCollectionName  = "learning_goals"
+	calendarFeedsCollectionName  = "calendar_feeds"
+	roadmapLineageCollectionName = "roadmap_lineage"
 )
 
 type RoadmapMongoRepository struct {
-	roadmapsCollection     *mongo.Collection
-	userProgressCollection *mongo.Collection
+	roadmapsCollection       *mongo.Collection
+	userProgressCollection   *mongo.Collection
+	materialVotesCollection  *mongo.Collection
+	nodeNotesCollection      *mongo.Collection
+	progressEventsCollection *mongo.Collection
+	certificatesCollection   *mongo.Collection
+	nodeQuizzesCollection    *mongo.Collection
+	quizAttemptsCollection   *mongo.Collection
+	learningGoalsCollection  *mongo.Collection
+	calendarFeedsCollection  *mongo.Collection
+	roadmapLineageCollection *mongo.Collection
 }
 
 func NewRoadmapMongoRepository(db *mongo.Database) roadmap.MongoRepository {
 	return &RoadmapMongoRepository{
-		roadmapsCollection:     db.Collection(roadmapsCollectionName),
-		userProgressCollection: db.Collection(userProgressCollectionName),
+		roadmapsCollection:       db.Collection(roadmapsCollectionName),
+		userProgressCollection:   db.Collection(userProgressCollectionName),
+		materialVotesCollection:  db.Collection(materialVotesCollectionName),
+		nodeNotesCollection:      db.Collection(nodeNotesCollectionName),
+		progressEventsCollection: db.Collection(progressEventsCollectionName),
+		certificatesCollection:   db.Collection(certificatesCollectionName),
+		nodeQuizzesCollection:    db.Collection(nodeQuizzesCollectionName),
+		quizAttemptsCollection:   db.Collection(quizAttemptsCollectionName),
+		learningGoalsCollection:  db.Collection(learningGoalsCollectionName),
+		calendarFeedsCollection:  db.Collection(calendarFeedsCollectionName),
+		roadmapLineageCollection: db.Collection(roadmapLineageCollectionName),
 	}
 }
 
@@ -54,6 +83,83 @@ func (r *RoadmapMongoRepository) GetByID(ctx context.Context, id primitive.Objec
 	return &roadmap, nil
 }
 
+func (r *RoadmapMongoRepository) GetByIDs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*entities.Roadmap, error) {
+	const op = "RoadmapRepository.GetByIDs"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":    op,
+		"count": len(ids),
+	})
+
+	result := make(map[primitive.ObjectID]*entities.Roadmap, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	cursor, err := r.roadmapsCollection.Find(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		logger.WithError(err).Error("failed to find roadmaps")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	for cursor.Next(ctx) {
+		var roadmap entities.Roadmap
+		if err := cursor.Decode(&roadmap); err != nil {
+			logger.WithError(err).Error("failed to decode roadmap")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result[roadmap.ID] = &roadmap
+	}
+
+	if err := cursor.Err(); err != nil {
+		logger.WithError(err).Error("cursor error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// GetIDs returns the IDs of all roadmaps created no later than createdBefore.
+func (r *RoadmapMongoRepository) GetIDs(ctx context.Context, createdBefore time.Time) ([]primitive.ObjectID, error) {
+	const op = "RoadmapRepository.GetIDs"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	opts := options.Find().SetProjection(bson.M{"id": 1})
+	cursor, err := r.roadmapsCollection.Find(ctx, bson.M{"createdat": bson.M{"$lte": createdBefore}}, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to find roadmaps")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	ids := []primitive.ObjectID{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID primitive.ObjectID `bson:"id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			logger.WithError(err).Error("failed to decode roadmap id")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ids = append(ids, doc.ID)
+	}
+
+	if err := cursor.Err(); err != nil {
+		logger.WithError(err).Error("cursor error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
 func (r *RoadmapMongoRepository) Create(ctx context.Context, roadmap *entities.Roadmap) error {
 	const op = "RoadmapRepository.Create"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -96,6 +202,7 @@ func (r *RoadmapMongoRepository) Update(ctx context.Context, roadmap *entities.R
 	})
 
 	roadmap.UpdatedAt = time.Now()
+	roadmap.Version++
 
 	result, err := r.roadmapsCollection.ReplaceOne(
 		ctx,
@@ -115,6 +222,39 @@ func (r *RoadmapMongoRepository) Update(ctx context.Context, roadmap *entities.R
 	return nil
 }
 
+// UpdateIfVersion replaces the roadmap only while the stored copy is still at
+// the given version. It reports false when another writer got there first.
+func (r *RoadmapMongoRepository) UpdateIfVersion(ctx context.Context, roadmap *entities.Roadmap, version int64) (bool, error) {
+	const op = "RoadmapRepository.UpdateIfVersion"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmap.ID.Hex(),
+		"version":    version,
+	})
+
+	filter := bson.M{"id": roadmap.ID, "version": version}
+	if version == 0 {
+		filter = bson.M{
+			"id": roadmap.ID,
+			"$or": bson.A{
+				bson.M{"version": 0},
+				bson.M{"version": bson.M{"$exists": false}},
+			},
+		}
+	}
+
+	roadmap.UpdatedAt = time.Now()
+	roadmap.Version = version + 1
+
+	result, err := r.roadmapsCollection.ReplaceOne(ctx, filter, roadmap)
+	if err != nil {
+		logger.WithError(err).Error("failed to update roadmap")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
 func (r *RoadmapMongoRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
 	const op = "RoadmapRepository.Delete"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -169,6 +309,9 @@ func (r *RoadmapMongoRepository) CreateMaterial(ctx context.Context, roadmapID p
 		"$set": bson.M{
 			"updated_at": time.Now(),
 		},
+		"$inc": bson.M{
+			"version": 1,
+		},
 	}
 
 	result, err := r.roadmapsCollection.UpdateOne(ctx, filter, update)
@@ -205,6 +348,9 @@ func (r *RoadmapMongoRepository) DeleteMaterial(ctx context.Context, roadmapID p
 		"$set": bson.M{
 			"updated_at": time.Now(),
 		},
+		"$inc": bson.M{
+			"version": 1,
+		},
 	}
 
 	arrayFilters := options.ArrayFilters{
@@ -335,9 +481,68 @@ func (r *RoadmapMongoRepository) GetUserProgress(ctx context.Context, userID uui
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	userProgress.Normalize()
+
 	return &userProgress, nil
 }
 
+func (r *RoadmapMongoRepository) GetUserProgressList(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.UserProgress, error) {
+	const op = "RoadmapRepository.GetUserProgressList"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updated_at", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.userProgressCollection.Find(ctx, userProgressListFilter(userID), opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to find user progress")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var progressList []*entities.UserProgress
+	if err := cursor.All(ctx, &progressList); err != nil {
+		logger.WithError(err).Error("failed to decode user progress")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, progress := range progressList {
+		progress.Normalize()
+	}
+
+	return progressList, nil
+}
+
+func (r *RoadmapMongoRepository) CountUserProgress(ctx context.Context, userID uuid.UUID) (int, error) {
+	const op = "RoadmapRepository.CountUserProgress"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	count, err := r.userProgressCollection.CountDocuments(ctx, userProgressListFilter(userID))
+	if err != nil {
+		logger.WithError(err).Error("failed to count user progress")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(count), nil
+}
+
+// userProgressListFilter skips documents whose nodes were all reset to pending.
+func userProgressListFilter(userID uuid.UUID) bson.M {
+	return bson.M{
+		"user_id":  userID,
+		"progress": bson.M{"$ne": bson.M{}},
+	}
+}
+
 func (r *RoadmapMongoRepository) UpsertUserProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, progress entities.NodeProgress) error {
 	const op = "RoadmapRepository.UpsertUserProgress"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -348,7 +553,7 @@ func (r *RoadmapMongoRepository) UpsertUserProgress(ctx context.Context, userID
 		"status":     progress.Status,
 	})
 
-	if progress.Status == entities.NodeProgressPending {
+	if progress.IsEmpty() {
 		filter := bson.M{
 			"user_id":    userID,
 			"roadmap_id": roadmapID,
@@ -365,14 +570,14 @@ func (r *RoadmapMongoRepository) UpsertUserProgress(ctx context.Context, userID
 
 		result, err := r.userProgressCollection.UpdateOne(ctx, filter, update)
 		if err != nil {
-			logger.WithError(err).Error("failed to remove pending progress")
+			logger.WithError(err).Error("failed to remove empty progress")
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
 		if result.MatchedCount == 0 {
 			logger.Debug("user progress document not found, nothing to update")
 		} else {
-			logger.Debug("removed pending progress for node")
+			logger.Debug("removed empty progress for node")
 		}
 
 		return nil
@@ -407,3 +612,1329 @@ func (r *RoadmapMongoRepository) UpsertUserProgress(ctx context.Context, userID
 	logger.Debug("updated user progress for node")
 	return nil
 }
+
+func (r *RoadmapMongoRepository) AppendProgressEvents(ctx context.Context, events []*entities.ProgressEvent) error {
+	const op = "RoadmapRepository.AppendProgressEvents"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":    op,
+		"count": len(events),
+	})
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(events))
+	for i, event := range events {
+		docs[i] = event
+	}
+
+	if _, err := r.progressEventsCollection.InsertMany(ctx, docs); err != nil {
+		logger.WithError(err).Error("failed to insert progress events")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) GetProgressEvents(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, limit, offset int) ([]*entities.ProgressEvent, error) {
+	const op = "RoadmapRepository.GetProgressEvents"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.progressEventsCollection.Find(ctx, bson.M{"user_id": userID, "roadmap_id": roadmapID}, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to find progress events")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var events []*entities.ProgressEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		logger.WithError(err).Error("failed to decode progress events")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range events {
+		if status, ok := entities.ParseNodeProgressStatus(string(event.FromStatus)); ok {
+			event.FromStatus = status
+		}
+		if status, ok := entities.ParseNodeProgressStatus(string(event.ToStatus)); ok {
+			event.ToStatus = status
+		}
+	}
+
+	return events, nil
+}
+
+func (r *RoadmapMongoRepository) CountProgressEvents(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (int, error) {
+	const op = "RoadmapRepository.CountProgressEvents"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	count, err := r.progressEventsCollection.CountDocuments(ctx, bson.M{"user_id": userID, "roadmap_id": roadmapID})
+	if err != nil {
+		logger.WithError(err).Error("failed to count progress events")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(count), nil
+}
+
+func (r *RoadmapMongoRepository) UpsertMaterialVote(ctx context.Context, vote *entities.MaterialVote) error {
+	const op = "RoadmapRepository.UpsertMaterialVote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"user_id":     vote.UserID.String(),
+		"material_id": vote.MaterialID.String(),
+		"value":       vote.Value,
+		"helpful":     vote.Helpful,
+	})
+
+	filter := bson.M{
+		"user_id":     vote.UserID,
+		"material_id": vote.MaterialID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"roadmap_id": vote.RoadmapID,
+			"node_id":    vote.NodeID,
+			"value":      vote.Value,
+			"helpful":    vote.Helpful,
+			"updated_at": vote.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": vote.CreatedAt,
+		},
+	}
+
+	opts := options.Update().SetUpsert(true)
+
+	if _, err := r.materialVotesCollection.UpdateOne(ctx, filter, update, opts); err != nil {
+		logger.WithError(err).Error("failed to upsert material vote")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Debug("upserted material vote")
+	return nil
+}
+
+func (r *RoadmapMongoRepository) DeleteMaterialVote(ctx context.Context, userID uuid.UUID, materialID uuid.UUID) error {
+	const op = "RoadmapRepository.DeleteMaterialVote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"user_id":     userID.String(),
+		"material_id": materialID.String(),
+	})
+
+	_, err := r.materialVotesCollection.DeleteOne(ctx, bson.M{
+		"user_id":     userID,
+		"material_id": materialID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete material vote")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Debug("deleted material vote")
+	return nil
+}
+
+func (r *RoadmapMongoRepository) DeleteMaterialVotes(ctx context.Context, materialID uuid.UUID) error {
+	const op = "RoadmapRepository.DeleteMaterialVotes"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"material_id": materialID.String(),
+	})
+
+	result, err := r.materialVotesCollection.DeleteMany(ctx, bson.M{"material_id": materialID})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete material votes")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("count", result.DeletedCount).Debug("deleted material votes")
+	return nil
+}
+
+func (r *RoadmapMongoRepository) GetMaterialVote(ctx context.Context, userID uuid.UUID, materialID uuid.UUID) (*entities.MaterialVote, error) {
+	const op = "RoadmapRepository.GetMaterialVote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"user_id":     userID.String(),
+		"material_id": materialID.String(),
+	})
+
+	var vote entities.MaterialVote
+	err := r.materialVotesCollection.FindOne(ctx, bson.M{
+		"user_id":     userID,
+		"material_id": materialID,
+	}).Decode(&vote)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get material vote")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &vote, nil
+}
+
+func (r *RoadmapMongoRepository) GetUserMaterialVotes(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (map[uuid.UUID]*entities.MaterialVote, error) {
+	const op = "RoadmapRepository.GetUserMaterialVotes"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	})
+
+	cursor, err := r.materialVotesCollection.Find(ctx, bson.M{
+		"user_id":    userID,
+		"roadmap_id": roadmapID,
+		"node_id":    nodeID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to find user material votes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var votes []*entities.MaterialVote
+	if err := cursor.All(ctx, &votes); err != nil {
+		logger.WithError(err).Error("failed to decode user material votes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make(map[uuid.UUID]*entities.MaterialVote, len(votes))
+	for _, vote := range votes {
+		result[vote.MaterialID] = vote
+	}
+
+	return result, nil
+}
+
+func (r *RoadmapMongoRepository) GetMaterialVoteStats(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (map[uuid.UUID]*entities.MaterialVoteStats, error) {
+	const op = "RoadmapRepository.GetMaterialVoteStats"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	})
+
+	countIf := func(cond bson.M) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{cond, 1, 0}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"roadmap_id": roadmapID, "node_id": nodeID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":       "$material_id",
+			"upvotes":   countIf(bson.M{"$eq": bson.A{"$value", entities.MaterialVoteUp}}),
+			"downvotes": countIf(bson.M{"$eq": bson.A{"$value", entities.MaterialVoteDown}}),
+			"helpful":   countIf(bson.M{"$eq": bson.A{"$helpful", true}}),
+		}}},
+	}
+
+	cursor, err := r.materialVotesCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.WithError(err).Error("failed to aggregate material votes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var stats []*entities.MaterialVoteStats
+	if err := cursor.All(ctx, &stats); err != nil {
+		logger.WithError(err).Error("failed to decode material vote stats")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make(map[uuid.UUID]*entities.MaterialVoteStats, len(stats))
+	for _, s := range stats {
+		result[s.MaterialID] = s
+	}
+
+	return result, nil
+}
+
+func (r *RoadmapMongoRepository) GetMaterialsForLinkCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*entities.MaterialLinkTarget, error) {
+	const op = "RoadmapRepository.GetMaterialsForLinkCheck"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":             op,
+		"checked_before": checkedBefore,
+		"limit":          limit,
+	})
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$unwind", Value: "$nodes"}},
+		{{Key: "$unwind", Value: "$nodes.materials"}},
+		{{Key: "$match", Value: bson.M{
+			"nodes.materials.file": nil,
+			"$or": bson.A{
+				bson.M{"nodes.materials.linkcheck": nil},
+				bson.M{"nodes.materials.linkcheck.checkedat": bson.M{"$lt": checkedBefore}},
+			},
+		}}},
+		{{Key: "$sort", Value: bson.M{"nodes.materials.linkcheck.checkedat": 1}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: materialLinkTargetProjection()}},
+	}
+
+	targets, err := r.aggregateMaterialLinkTargets(ctx, pipeline)
+	if err != nil {
+		logger.WithError(err).Error("failed to get materials for link check")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("count", len(targets)).Debug("retrieved materials for link check")
+	return targets, nil
+}
+
+func (r *RoadmapMongoRepository) GetBrokenMaterials(ctx context.Context, roadmapID primitive.ObjectID) ([]*entities.MaterialLinkTarget, error) {
+	const op = "RoadmapRepository.GetBrokenMaterials"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"id": roadmapID}}},
+		{{Key: "$unwind", Value: "$nodes"}},
+		{{Key: "$unwind", Value: "$nodes.materials"}},
+		{{Key: "$match", Value: bson.M{"nodes.materials.linkcheck.status": entities.MaterialLinkBroken}}},
+		{{Key: "$project", Value: materialLinkTargetProjection()}},
+	}
+
+	targets, err := r.aggregateMaterialLinkTargets(ctx, pipeline)
+	if err != nil {
+		logger.WithError(err).Error("failed to get broken materials")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return targets, nil
+}
+
+func (r *RoadmapMongoRepository) UpdateMaterialLinkCheck(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, check *entities.MaterialLinkCheck) error {
+	const op = "RoadmapRepository.UpdateMaterialLinkCheck"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"roadmap_id":  roadmapID.Hex(),
+		"node_id":     nodeID,
+		"material_id": materialID,
+		"status":      check.Status,
+	})
+
+	filter := bson.M{"id": roadmapID}
+	update := bson.M{
+		"$set": bson.M{
+			"nodes.$[node].materials.$[material].linkcheck": check,
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}
+
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{
+			bson.M{"node.id": nodeID},
+			bson.M{"material.id": materialID},
+		},
+	})
+
+	result, err := r.roadmapsCollection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to update material link check")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.MatchedCount == 0 {
+		logger.Debug("roadmap removed before link check was stored")
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) CountBrokenLinks(ctx context.Context) (map[primitive.ObjectID]int, error) {
+	const op = "RoadmapRepository.CountBrokenLinks"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$unwind", Value: "$nodes"}},
+		{{Key: "$unwind", Value: "$nodes.materials"}},
+		{{Key: "$match", Value: bson.M{"nodes.materials.linkcheck.status": entities.MaterialLinkBroken}}},
+		{{Key: "$group", Value: bson.M{"_id": "$id", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.roadmapsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.WithError(err).Error("failed to aggregate broken links")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var rows []struct {
+		RoadmapID primitive.ObjectID `bson:"_id"`
+		Count     int                `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		logger.WithError(err).Error("failed to decode broken link counts")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	counts := make(map[primitive.ObjectID]int, len(rows))
+	for _, row := range rows {
+		counts[row.RoadmapID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *RoadmapMongoRepository) aggregateMaterialLinkTargets(ctx context.Context, pipeline mongo.Pipeline) ([]*entities.MaterialLinkTarget, error) {
+	cursor, err := r.roadmapsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			ctxutil.GetLogger(ctx).WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var targets []*entities.MaterialLinkTarget
+	if err := cursor.All(ctx, &targets); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+func materialLinkTargetProjection() bson.M {
+	return bson.M{
+		"_id":        0,
+		"roadmap_id": "$id",
+		"node_id":    "$nodes.id",
+		"material":   "$nodes.materials",
+	}
+}
+
+func (r *RoadmapMongoRepository) GetNodeNotes(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (map[uuid.UUID]*entities.NodeNote, error) {
+	const op = "RoadmapRepository.GetNodeNotes"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	notes, err := r.findNodeNotes(ctx, bson.M{"user_id": userID, "roadmap_id": roadmapID}, options.Find())
+	if err != nil {
+		logger.WithError(err).Error("failed to get node notes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make(map[uuid.UUID]*entities.NodeNote, len(notes))
+	for _, note := range notes {
+		result[note.NodeID] = note
+	}
+
+	return result, nil
+}
+
+func (r *RoadmapMongoRepository) UpsertNodeNote(ctx context.Context, note *entities.NodeNote) (*entities.NodeNote, error) {
+	const op = "RoadmapRepository.UpsertNodeNote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    note.UserID.String(),
+		"roadmap_id": note.RoadmapID.Hex(),
+		"node_id":    note.NodeID.String(),
+	})
+
+	filter := bson.M{
+		"user_id":    note.UserID,
+		"roadmap_id": note.RoadmapID,
+		"node_id":    note.NodeID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"content":    note.Content,
+			"updated_at": note.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": note.CreatedAt,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var updated entities.NodeNote
+	if err := r.nodeNotesCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated); err != nil {
+		logger.WithError(err).Error("failed to upsert node note")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &updated, nil
+}
+
+func (r *RoadmapMongoRepository) DeleteNodeNote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	const op = "RoadmapRepository.DeleteNodeNote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	_, err := r.nodeNotesCollection.DeleteOne(ctx, bson.M{
+		"user_id":    userID,
+		"roadmap_id": roadmapID,
+		"node_id":    nodeID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete node note")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) SearchNodeNotes(ctx context.Context, userID uuid.UUID, query string, limit, offset int) ([]*entities.NodeNote, error) {
+	const op = "RoadmapRepository.SearchNodeNotes"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updated_at", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	notes, err := r.findNodeNotes(ctx, nodeNotesSearchFilter(userID, query), opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to search node notes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return notes, nil
+}
+
+func (r *RoadmapMongoRepository) CountNodeNotes(ctx context.Context, userID uuid.UUID, query string) (int, error) {
+	const op = "RoadmapRepository.CountNodeNotes"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	count, err := r.nodeNotesCollection.CountDocuments(ctx, nodeNotesSearchFilter(userID, query))
+	if err != nil {
+		logger.WithError(err).Error("failed to count node notes")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(count), nil
+}
+
+func (r *RoadmapMongoRepository) findNodeNotes(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entities.NodeNote, error) {
+	cursor, err := r.nodeNotesCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			ctxutil.GetLogger(ctx).WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var notes []*entities.NodeNote
+	if err := cursor.All(ctx, &notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// nodeNotesSearchFilter matches every word of the query case-insensitively. A user
+// has few enough notes that scanning them by user_id is cheaper than a text index.
+func nodeNotesSearchFilter(userID uuid.UUID, query string) bson.M {
+	filter := bson.M{"user_id": userID}
+
+	words := strings.Fields(query)
+	if len(words) == 0 {
+		return filter
+	}
+
+	conditions := make(bson.A, 0, len(words))
+	for _, word := range words {
+		conditions = append(conditions, bson.M{"content": primitive.Regex{
+			Pattern: regexp.QuoteMeta(word),
+			Options: "i",
+		}})
+	}
+	filter["$and"] = conditions
+
+	return filter
+}
+
+// CreateCertificate inserts the certificate unless the user already has one for
+// the same roadmap version, and returns the stored certificate either way.
+func (r *RoadmapMongoRepository) CreateCertificate(ctx context.Context, certificate *entities.Certificate) (*entities.Certificate, error) {
+	const op = "RoadmapRepository.CreateCertificate"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":             op,
+		"certificate_id": certificate.ID.String(),
+		"user_id":        certificate.UserID.String(),
+		"roadmap_id":     certificate.RoadmapID.Hex(),
+	})
+
+	filter := bson.M{
+		"user_id":         certificate.UserID,
+		"roadmap_id":      certificate.RoadmapID,
+		"roadmap_version": certificate.RoadmapVersion,
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var stored entities.Certificate
+	if err := r.certificatesCollection.FindOneAndUpdate(ctx, filter, bson.M{"$setOnInsert": certificate}, opts).Decode(&stored); err != nil {
+		logger.WithError(err).Error("failed to insert certificate")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &stored, nil
+}
+
+func (r *RoadmapMongoRepository) GetCertificateByID(ctx context.Context, id uuid.UUID) (*entities.Certificate, error) {
+	const op = "RoadmapRepository.GetCertificateByID"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":             op,
+		"certificate_id": id.String(),
+	})
+
+	var certificate entities.Certificate
+	if err := r.certificatesCollection.FindOne(ctx, bson.M{"id": id}).Decode(&certificate); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get certificate")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &certificate, nil
+}
+
+func (r *RoadmapMongoRepository) GetCertificate(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, version string) (*entities.Certificate, error) {
+	const op = "RoadmapRepository.GetCertificate"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+		"version":    version,
+	})
+
+	var certificate entities.Certificate
+	err := r.certificatesCollection.FindOne(ctx, bson.M{
+		"user_id":         userID,
+		"roadmap_id":      roadmapID,
+		"roadmap_version": version,
+	}).Decode(&certificate)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get certificate")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &certificate, nil
+}
+
+func (r *RoadmapMongoRepository) GetCertificatesByUser(ctx context.Context, userID uuid.UUID) ([]*entities.Certificate, error) {
+	const op = "RoadmapRepository.GetCertificatesByUser"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	opts := options.Find().SetSort(bson.D{{Key: "issued_at", Value: -1}})
+
+	cursor, err := r.certificatesCollection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to find certificates")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var certificates []*entities.Certificate
+	if err := cursor.All(ctx, &certificates); err != nil {
+		logger.WithError(err).Error("failed to decode certificates")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return certificates, nil
+}
+
+func (r *RoadmapMongoRepository) GetNodeQuiz(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*entities.NodeQuiz, error) {
+	const op = "RoadmapRepository.GetNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	var quiz entities.NodeQuiz
+	err := r.nodeQuizzesCollection.FindOne(ctx, bson.M{
+		"roadmap_id": roadmapID,
+		"node_id":    nodeID,
+	}).Decode(&quiz)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get node quiz")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &quiz, nil
+}
+
+func (r *RoadmapMongoRepository) UpsertNodeQuiz(ctx context.Context, quiz *entities.NodeQuiz) (*entities.NodeQuiz, error) {
+	const op = "RoadmapRepository.UpsertNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": quiz.RoadmapID.Hex(),
+		"node_id":    quiz.NodeID.String(),
+	})
+
+	filter := bson.M{
+		"roadmap_id": quiz.RoadmapID,
+		"node_id":    quiz.NodeID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"questions":     quiz.Questions,
+			"passing_score": quiz.PassingScore,
+			"auto_complete": quiz.AutoComplete,
+			"author_id":     quiz.AuthorID,
+			"updated_at":    quiz.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": quiz.CreatedAt,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var updated entities.NodeQuiz
+	if err := r.nodeQuizzesCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated); err != nil {
+		logger.WithError(err).Error("failed to upsert node quiz")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &updated, nil
+}
+
+func (r *RoadmapMongoRepository) DeleteNodeQuiz(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	const op = "RoadmapRepository.DeleteNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	result, err := r.nodeQuizzesCollection.DeleteOne(ctx, bson.M{
+		"roadmap_id": roadmapID,
+		"node_id":    nodeID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete node quiz")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.DeletedCount == 0 {
+		logger.Warn("node quiz not found for deletion")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("node quiz not found"))
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) CreateQuizAttempt(ctx context.Context, attempt *entities.QuizAttempt) error {
+	const op = "RoadmapRepository.CreateQuizAttempt"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    attempt.UserID.String(),
+		"roadmap_id": attempt.RoadmapID.Hex(),
+		"node_id":    attempt.NodeID.String(),
+	})
+
+	result, err := r.quizAttemptsCollection.InsertOne(ctx, attempt)
+	if err != nil {
+		logger.WithError(err).Error("failed to insert quiz attempt")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		attempt.ID = id
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) GetQuizAttempts(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, limit, offset int) ([]*entities.QuizAttempt, error) {
+	const op = "RoadmapRepository.GetQuizAttempts"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	filter := bson.M{"user_id": userID, "roadmap_id": roadmapID, "node_id": nodeID}
+
+	cursor, err := r.quizAttemptsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to find quiz attempts")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			logger.WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var attempts []*entities.QuizAttempt
+	if err := cursor.All(ctx, &attempts); err != nil {
+		logger.WithError(err).Error("failed to decode quiz attempts")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attempts, nil
+}
+
+func (r *RoadmapMongoRepository) CountQuizAttempts(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (int, error) {
+	const op = "RoadmapRepository.CountQuizAttempts"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	count, err := r.quizAttemptsCollection.CountDocuments(ctx, bson.M{"user_id": userID, "roadmap_id": roadmapID, "node_id": nodeID})
+	if err != nil {
+		logger.WithError(err).Error("failed to count quiz attempts")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(count), nil
+}
+
+func (r *RoadmapMongoRepository) GetLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*entities.LearningGoal, error) {
+	const op = "RoadmapRepository.GetLearningGoal"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	var goal entities.LearningGoal
+	err := r.learningGoalsCollection.FindOne(ctx, bson.M{
+		"user_id":    userID,
+		"roadmap_id": roadmapID,
+	}).Decode(&goal)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get learning goal")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &goal, nil
+}
+
+func (r *RoadmapMongoRepository) GetLearningGoalsByUser(ctx context.Context, userID uuid.UUID) ([]*entities.LearningGoal, error) {
+	const op = "RoadmapRepository.GetLearningGoalsByUser"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	opts := options.Find().SetSort(bson.D{{Key: "deadline", Value: 1}})
+
+	goals, err := r.findLearningGoals(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goals")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return goals, nil
+}
+
+func (r *RoadmapMongoRepository) UpsertLearningGoal(ctx context.Context, goal *entities.LearningGoal) (*entities.LearningGoal, error) {
+	const op = "RoadmapRepository.UpsertLearningGoal"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    goal.UserID.String(),
+		"roadmap_id": goal.RoadmapID.Hex(),
+	})
+
+	filter := bson.M{
+		"user_id":    goal.UserID,
+		"roadmap_id": goal.RoadmapID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"deadline":          goal.Deadline,
+			"weekly_pace":       goal.WeeklyPace,
+			"reminders_enabled": goal.RemindersEnabled,
+			"updated_at":        goal.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": goal.CreatedAt,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var updated entities.LearningGoal
+	if err := r.learningGoalsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated); err != nil {
+		logger.WithError(err).Error("failed to upsert learning goal")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &updated, nil
+}
+
+func (r *RoadmapMongoRepository) DeleteLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) error {
+	const op = "RoadmapRepository.DeleteLearningGoal"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	result, err := r.learningGoalsCollection.DeleteOne(ctx, bson.M{
+		"user_id":    userID,
+		"roadmap_id": roadmapID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete learning goal")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.DeletedCount == 0 {
+		logger.Warn("learning goal not found for deletion")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("learning goal not found"))
+	}
+
+	return nil
+}
+
+// GetLearningGoalsForCheck returns goals with reminders enabled that were
+// created before createdBefore and have not been checked since checkedBefore,
+// least recently checked first.
+func (r *RoadmapMongoRepository) GetLearningGoalsForCheck(ctx context.Context, checkedBefore, createdBefore time.Time, limit int) ([]*entities.LearningGoal, error) {
+	const op = "RoadmapRepository.GetLearningGoalsForCheck"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":             op,
+		"checked_before": checkedBefore,
+		"limit":          limit,
+	})
+
+	filter := bson.M{
+		"reminders_enabled": true,
+		"created_at":        bson.M{"$lte": createdBefore},
+		"$or": bson.A{
+			bson.M{"checked_at": nil},
+			bson.M{"checked_at": bson.M{"$lt": checkedBefore}},
+		},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "checked_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	goals, err := r.findLearningGoals(ctx, filter, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goals for check")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return goals, nil
+}
+
+// ClaimLearningGoalCheck moves the goal's checked_at from previous to checkedAt.
+// It reports false when another worker has already claimed the goal.
+func (r *RoadmapMongoRepository) ClaimLearningGoalCheck(ctx context.Context, goalID primitive.ObjectID, previous *time.Time, checkedAt time.Time) (bool, error) {
+	const op = "RoadmapRepository.ClaimLearningGoalCheck"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"goal_id": goalID.Hex(),
+	})
+
+	filter := bson.M{"_id": goalID, "checked_at": nil}
+	if previous != nil {
+		filter["checked_at"] = *previous
+	}
+
+	result, err := r.learningGoalsCollection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"checked_at": checkedAt}})
+	if err != nil {
+		logger.WithError(err).Error("failed to claim learning goal check")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *RoadmapMongoRepository) MarkLearningGoalReminded(ctx context.Context, goalID primitive.ObjectID, remindedAt time.Time) error {
+	const op = "RoadmapRepository.MarkLearningGoalReminded"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"goal_id": goalID.Hex(),
+	})
+
+	_, err := r.learningGoalsCollection.UpdateOne(ctx, bson.M{"_id": goalID}, bson.M{"$set": bson.M{"last_reminded_at": remindedAt}})
+	if err != nil {
+		logger.WithError(err).Error("failed to mark learning goal reminded")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) findLearningGoals(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entities.LearningGoal, error) {
+	cursor, err := r.learningGoalsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			ctxutil.GetLogger(ctx).WithError(err).Warn("failed to close cursor")
+		}
+	}()
+
+	var goals []*entities.LearningGoal
+	if err := cursor.All(ctx, &goals); err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+// CountCompletedNodesSince counts the distinct nodes the user marked as done
+// since the given time, so undoing and redoing a node counts once.
+func (r *RoadmapMongoRepository) CountCompletedNodesSince(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, since time.Time) (int, error) {
+	const op = "RoadmapRepository.CountCompletedNodesSince"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID.String(),
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	filter := bson.M{
+		"user_id":    userID,
+		"roadmap_id": roadmapID,
+		"type":       entities.ProgressEventStatusChanged,
+		"to_status": bson.M{"$in": bson.A{
+			entities.NodeProgressDone,
+			entities.NodeProgressDone.Label(entities.DefaultLanguage),
+		}},
+		"created_at": bson.M{"$gte": since},
+	}
+
+	nodeIDs, err := r.progressEventsCollection.Distinct(ctx, "node_id", filter)
+	if err != nil {
+		logger.WithError(err).Error("failed to count completed nodes")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return len(nodeIDs), nil
+}
+
+func (r *RoadmapMongoRepository) GetCalendarFeedByUser(ctx context.Context, userID uuid.UUID) (*entities.CalendarFeed, error) {
+	const op = "RoadmapRepository.GetCalendarFeedByUser"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	var feed entities.CalendarFeed
+	err := r.calendarFeedsCollection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&feed)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get calendar feed")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &feed, nil
+}
+
+func (r *RoadmapMongoRepository) GetCalendarFeedByToken(ctx context.Context, token string) (*entities.CalendarFeed, error) {
+	const op = "RoadmapRepository.GetCalendarFeedByToken"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	var feed entities.CalendarFeed
+	err := r.calendarFeedsCollection.FindOne(ctx, bson.M{"token": token}).Decode(&feed)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get calendar feed by token")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &feed, nil
+}
+
+// CreateCalendarFeed inserts the feed unless the user already has one, and
+// returns the stored feed either way.
+func (r *RoadmapMongoRepository) CreateCalendarFeed(ctx context.Context, feed *entities.CalendarFeed) (*entities.CalendarFeed, error) {
+	const op = "RoadmapRepository.CreateCalendarFeed"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": feed.UserID.String(),
+	})
+
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"token":      feed.Token,
+			"events":     bson.A{},
+			"created_at": feed.CreatedAt,
+			"updated_at": feed.UpdatedAt,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var stored entities.CalendarFeed
+	if err := r.calendarFeedsCollection.FindOneAndUpdate(ctx, bson.M{"user_id": feed.UserID}, update, opts).Decode(&stored); err != nil {
+		logger.WithError(err).Error("failed to create calendar feed")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &stored, nil
+}
+
+func (r *RoadmapMongoRepository) UpdateCalendarFeedToken(ctx context.Context, userID uuid.UUID, token string, updatedAt time.Time) (*entities.CalendarFeed, error) {
+	const op = "RoadmapRepository.UpdateCalendarFeedToken"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	update := bson.M{
+		"$set": bson.M{
+			"token":      token,
+			"updated_at": updatedAt,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated entities.CalendarFeed
+	if err := r.calendarFeedsCollection.FindOneAndUpdate(ctx, bson.M{"user_id": userID}, update, opts).Decode(&updated); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to update calendar feed token")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &updated, nil
+}
+
+func (r *RoadmapMongoRepository) UpdateCalendarFeedEvents(ctx context.Context, userID uuid.UUID, events []entities.CalendarEvent, generatedAt time.Time) error {
+	const op = "RoadmapRepository.UpdateCalendarFeedEvents"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+		"events":  len(events),
+	})
+
+	if events == nil {
+		events = []entities.CalendarEvent{}
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"events":       events,
+			"generated_at": generatedAt,
+		},
+	}
+
+	if _, err := r.calendarFeedsCollection.UpdateOne(ctx, bson.M{"user_id": userID}, update); err != nil {
+		logger.WithError(err).Error("failed to update calendar feed events")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error {
+	const op = "RoadmapRepository.DeleteCalendarFeed"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	result, err := r.calendarFeedsCollection.DeleteOne(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete calendar feed")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.DeletedCount == 0 {
+		logger.Warn("calendar feed not found for deletion")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("calendar feed not found"))
+	}
+
+	return nil
+}
+
+// SaveRoadmapLineage stores where the roadmap was copied from, replacing any
+// lineage recorded for it before.
+func (r *RoadmapMongoRepository) SaveRoadmapLineage(ctx context.Context, lineage *entities.RoadmapLineage) error {
+	const op = "RoadmapRepository.SaveRoadmapLineage"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":                op,
+		"roadmap_id":        lineage.RoadmapID.Hex(),
+		"source_roadmap_id": lineage.SourceRoadmapID.Hex(),
+		"kind":              lineage.Kind,
+	})
+
+	opts := options.Replace().SetUpsert(true)
+
+	if _, err := r.roadmapLineageCollection.ReplaceOne(ctx, bson.M{"roadmap_id": lineage.RoadmapID}, lineage, opts); err != nil {
+		logger.WithError(err).Error("failed to save roadmap lineage")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RoadmapMongoRepository) GetRoadmapLineage(ctx context.Context, roadmapID primitive.ObjectID) (*entities.RoadmapLineage, error) {
+	const op = "RoadmapRepository.GetRoadmapLineage"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	var lineage entities.RoadmapLineage
+	err := r.roadmapLineageCollection.FindOne(ctx, bson.M{"roadmap_id": roadmapID}).Decode(&lineage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.WithError(err).Error("failed to get roadmap lineage")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &lineage, nil
+}
+
+func (r *RoadmapMongoRepository) DeleteRoadmapLineage(ctx context.Context, roadmapID primitive.ObjectID) error {
+	const op = "RoadmapRepository.DeleteRoadmapLineage"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	if _, err := r.roadmapLineageCollection.DeleteMany(ctx, bson.M{"roadmap_id": roadmapID}); err != nil {
+		logger.WithError(err).Error("failed to delete roadmap lineage")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RemapNodeProgress moves every user's progress and progress history from the
+// old node IDs to the new ones. It returns the number of users whose progress
+// was moved.
+func (r *RoadmapMongoRepository) RemapNodeProgress(ctx context.Context, roadmapID primitive.ObjectID, nodeIDMap map[uuid.UUID]uuid.UUID) (int64, error) {
+	const op = "RoadmapRepository.RemapNodeProgress"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"nodes":      len(nodeIDMap),
+	})
+
+	if len(nodeIDMap) == 0 {
+		return 0, nil
+	}
+
+	rename := bson.M{}
+	exists := bson.A{}
+	for oldID, newID := range nodeIDMap {
+		field := fmt.Sprintf("progress.%s", oldID.String())
+		rename[field] = fmt.Sprintf("progress.%s", newID.String())
+		exists = append(exists, bson.M{field: bson.M{"$exists": true}})
+	}
+
+	filter := bson.M{
+		"roadmap_id": roadmapID,
+		"$or":        exists,
+	}
+
+	result, err := r.userProgressCollection.UpdateMany(ctx, filter, bson.M{"$rename": rename})
+	if err != nil {
+		logger.WithError(err).Error("failed to remap user progress")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for oldID, newID := range nodeIDMap {
+		_, err := r.progressEventsCollection.UpdateMany(ctx,
+			bson.M{"roadmap_id": roadmapID, "node_id": oldID},
+			bson.M{"$set": bson.M{"node_id": newID}},
+		)
+		if err != nil {
+			logger.WithError(err).Error("failed to remap progress events")
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return result.ModifiedCount, nil
+}
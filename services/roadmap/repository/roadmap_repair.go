@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+const (
+	repairJSONExtracted    = "json_extracted"
+	repairTrailingCommas   = "trailing_commas"
+	repairTruncatedJSON    = "truncated_json"
+	repairNodeIDNormalized = "node_id_normalized"
+	repairDuplicateNodeID  = "duplicate_node_id"
+	repairNodeDropped      = "node_dropped"
+	repairEdgeDropped      = "edge_dropped"
+	repairEdgeIDGenerated  = "edge_id_generated"
+)
+
+const (
+	parseOutcomeOK       = "ok"
+	parseOutcomeRepaired = "repaired"
+	parseOutcomeRetried  = "retried"
+	parseOutcomeFailed   = "failed"
+)
+
+const (
+	roadmapRepairMaxIssues  = 10
+	roadmapRepairFixMessage = "Твой предыдущий ответ не удалось разобрать. Ошибки:\n%s\n\nИсправь ответ и верни ТОЛЬКО валидный JSON в том же формате, без комментариев и разметки."
+)
+
+var (
+	codeFenceRegexp     = regexp.MustCompile("(?s)```(?:json|JSON)?\\s*(.*?)```")
+	trailingCommaRegexp = regexp.MustCompile(`,(\s*[}\]])`)
+)
+
+type roadmapRepairReport struct {
+	repairs map[string]int
+	issues  []string
+}
+
+func newRoadmapRepairReport() *roadmapRepairReport {
+	return &roadmapRepairReport{repairs: make(map[string]int)}
+}
+
+func (r *roadmapRepairReport) add(kind string, issue string) {
+	r.repairs[kind]++
+	if issue != "" && len(r.issues) < roadmapRepairMaxIssues {
+		r.issues = append(r.issues, issue)
+	}
+}
+
+func (r *roadmapRepairReport) repaired() bool {
+	return len(r.repairs) > 0
+}
+
+type rawRoadmap struct {
+	Nodes []rawNode `json:"nodes"`
+	Edges []rawEdge `json:"edges"`
+}
+
+type rawNode struct {
+	ID          rawID             `json:"id"`
+	Type        string            `json:"type"`
+	Position    entities.Position `json:"position"`
+	Data        entities.NodeData `json:"data"`
+	Measured    entities.Measured `json:"measured"`
+	Description string            `json:"description"`
+}
+
+type rawEdge struct {
+	ID     rawID `json:"id"`
+	Source rawID `json:"source"`
+	Target rawID `json:"target"`
+}
+
+// rawID accepts both string and numeric identifiers, which the model
+// occasionally emits instead of UUIDs.
+type rawID string
+
+func (id *rawID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = rawID(strings.TrimSpace(s))
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid identifier %s", string(data))
+	}
+	*id = rawID(n.String())
+	return nil
+}
+
+// repairRoadmapJSON turns a raw model response into a roadmap, fixing the
+// problems that can be fixed locally and reporting what was changed. An error
+// means the response is unusable and the model has to be asked again.
+// External IDs are nodes that already exist outside of the response and may
+// be referenced by its edges.
+func repairRoadmapJSON(text string, externalIDs ...uuid.UUID) (*entities.Roadmap, *roadmapRepairReport, error) {
+	report := newRoadmapRepairReport()
+
+	jsonData, err := extractJSONTolerant(text, report)
+	if err != nil {
+		return nil, report, err
+	}
+
+	var raw rawRoadmap
+	if err := json.Unmarshal([]byte(jsonData), &raw); err != nil {
+		return nil, report, fmt.Errorf("failed to unmarshal roadmap: %w", err)
+	}
+
+	roadmap := normalizeRoadmap(&raw, externalIDs, report)
+	if len(roadmap.Nodes) == 0 {
+		return nil, report, fmt.Errorf("roadmap contains no valid nodes")
+	}
+
+	return roadmap, report, nil
+}
+
+func extractJSONTolerant(text string, report *roadmapRepairReport) (string, error) {
+	candidate := strings.TrimSpace(text)
+	if gjson.Valid(candidate) && strings.HasPrefix(candidate, "{") {
+		return candidate, nil
+	}
+
+	if match := codeFenceRegexp.FindStringSubmatch(candidate); match != nil {
+		candidate = strings.TrimSpace(match[1])
+		report.add(repairJSONExtracted, "")
+	}
+
+	if start := strings.Index(candidate, "{"); start > 0 {
+		candidate = candidate[start:]
+		report.add(repairJSONExtracted, "")
+	} else if start < 0 {
+		return "", fmt.Errorf("valid JSON not found in response")
+	}
+
+	if gjson.Valid(candidate) {
+		return candidate, nil
+	}
+
+	if end := strings.LastIndex(candidate, "}"); end >= 0 && gjson.Valid(candidate[:end+1]) {
+		report.add(repairJSONExtracted, "")
+		return candidate[:end+1], nil
+	}
+
+	if fixed := trailingCommaRegexp.ReplaceAllString(candidate, "$1"); fixed != candidate {
+		candidate = fixed
+		report.add(repairTrailingCommas, "")
+		if gjson.Valid(candidate) {
+			return candidate, nil
+		}
+	}
+
+	if closed, ok := closeTruncatedJSON(candidate); ok && gjson.Valid(closed) {
+		report.add(repairTruncatedJSON, "response was truncated")
+		return closed, nil
+	}
+
+	return "", fmt.Errorf("valid JSON not found in response")
+}
+
+// closeTruncatedJSON cuts a truncated document back to the last complete
+// object or array and closes every bracket that is still open at that point.
+func closeTruncatedJSON(text string) (string, bool) {
+	var (
+		stack     []byte
+		inString  bool
+		escaped   bool
+		lastCut   = -1
+		lastStack []byte
+	)
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{':
+			stack = append(stack, '}')
+		case '[':
+			stack = append(stack, ']')
+		case '}', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return "", false
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				lastCut = i
+				lastStack = append(lastStack[:0], stack...)
+			}
+		}
+	}
+
+	if len(stack) == 0 || lastCut < 0 {
+		return "", false
+	}
+
+	var b strings.Builder
+	b.WriteString(text[:lastCut+1])
+	for i := len(lastStack) - 1; i >= 0; i-- {
+		b.WriteByte(lastStack[i])
+	}
+
+	return b.String(), true
+}
+
+func normalizeRoadmap(raw *rawRoadmap, externalIDs []uuid.UUID, report *roadmapRepairReport) *entities.Roadmap {
+	idMap := make(map[rawID]uuid.UUID, len(raw.Nodes)+len(externalIDs))
+	external := make(map[rawID]bool, len(externalIDs))
+	for _, id := range externalIDs {
+		idMap[rawID(id.String())] = id
+		external[rawID(id.String())] = true
+	}
+
+	roadmap := &entities.Roadmap{
+		Nodes: make([]entities.RoadmapNode, 0, len(raw.Nodes)),
+		Edges: make([]entities.RoadmapEdge, 0, len(raw.Edges)),
+	}
+
+	for i, node := range raw.Nodes {
+		if strings.TrimSpace(node.Data.Label) == "" {
+			report.add(repairNodeDropped, fmt.Sprintf("node #%d has an empty data.label", i+1))
+			continue
+		}
+
+		if external[node.ID] {
+			report.add(repairNodeDropped, fmt.Sprintf("node #%d repeats an existing node", i+1))
+			continue
+		}
+
+		id, err := uuid.Parse(string(node.ID))
+		if err != nil || id == uuid.Nil {
+			id = uuid.New()
+			report.add(repairNodeIDNormalized, fmt.Sprintf("node #%d has non-UUID id %s", i+1, strconv.Quote(string(node.ID))))
+		}
+
+		if _, exists := idMap[node.ID]; exists {
+			id = uuid.New()
+			report.add(repairDuplicateNodeID, fmt.Sprintf("node #%d reuses id %s", i+1, strconv.Quote(string(node.ID))))
+		} else if node.ID != "" {
+			idMap[node.ID] = id
+		}
+
+		roadmap.Nodes = append(roadmap.Nodes, entities.RoadmapNode{
+			ID:          id,
+			Type:        node.Type,
+			Position:    node.Position,
+			Data:        node.Data,
+			Measured:    node.Measured,
+			Description: node.Description,
+			Materials:   []entities.Material{},
+		})
+	}
+
+	seenEdges := make(map[[2]uuid.UUID]bool, len(raw.Edges))
+	for i, edge := range raw.Edges {
+		source, sourceOK := idMap[edge.Source]
+		target, targetOK := idMap[edge.Target]
+
+		switch {
+		case !sourceOK || !targetOK:
+			report.add(repairEdgeDropped, fmt.Sprintf("edge #%d references a missing node", i+1))
+			continue
+		case source == target:
+			report.add(repairEdgeDropped, fmt.Sprintf("edge #%d connects a node to itself", i+1))
+			continue
+		case seenEdges[[2]uuid.UUID{source, target}]:
+			report.add(repairEdgeDropped, "")
+			continue
+		}
+		seenEdges[[2]uuid.UUID{source, target}] = true
+
+		edgeID := string(edge.ID)
+		if edgeID == "" {
+			edgeID = uuid.New().String()
+			report.add(repairEdgeIDGenerated, "")
+		}
+
+		roadmap.Edges = append(roadmap.Edges, entities.RoadmapEdge{
+			ID:     edgeID,
+			Source: source.String(),
+			Target: target.String(),
+		})
+	}
+
+	return roadmap
+}
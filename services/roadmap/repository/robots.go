@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// robotsRules holds the allow and disallow path patterns of the robots.txt group
+// that applies to the link checker.
+type robotsRules struct {
+	allow    []string
+	disallow []string
+}
+
+type robotsGroup struct {
+	agents []string
+	rules  robotsRules
+}
+
+// parseRobots picks the group naming the agent product token and falls back to "*".
+func parseRobots(body io.Reader, userAgent string) *robotsRules {
+	token := strings.ToLower(userAgent)
+	if idx := strings.IndexAny(token, "/ "); idx >= 0 {
+		token = token[:idx]
+	}
+
+	var groups []*robotsGroup
+	var current *robotsGroup
+	inAgents := false
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.IndexByte(line, '#'); idx >= 0 {
+			line = line[:idx]
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if !inAgents {
+				current = &robotsGroup{}
+				groups = append(groups, current)
+				inAgents = true
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+		case "allow", "disallow":
+			inAgents = false
+			if current == nil || value == "" {
+				continue
+			}
+			if key == "allow" {
+				current.rules.allow = append(current.rules.allow, value)
+			} else {
+				current.rules.disallow = append(current.rules.disallow, value)
+			}
+		default:
+			inAgents = false
+		}
+	}
+
+	var fallback *robotsRules
+	for _, group := range groups {
+		for _, agent := range group.agents {
+			if agent == "*" {
+				if fallback == nil {
+					fallback = &group.rules
+				}
+				continue
+			}
+			if token != "" && strings.Contains(token, agent) {
+				return &group.rules
+			}
+		}
+	}
+
+	if fallback == nil {
+		return &robotsRules{}
+	}
+	return fallback
+}
+
+// allowed applies the longest matching rule, preferring allow on ties.
+func (r *robotsRules) allowed(path string) bool {
+	if r == nil {
+		return true
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	longestAllow := longestRobotsMatch(r.allow, path)
+	longestDisallow := longestRobotsMatch(r.disallow, path)
+
+	return longestDisallow < 0 || longestAllow >= longestDisallow
+}
+
+func longestRobotsMatch(patterns []string, path string) int {
+	longest := -1
+	for _, pattern := range patterns {
+		if len(pattern) > longest && robotsPatternMatches(pattern, path) {
+			longest = len(pattern)
+		}
+	}
+	return longest
+}
+
+// robotsPatternMatches supports the "*" wildcard and the "$" end anchor.
+func robotsPatternMatches(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	rest := path[len(parts[0]):]
+
+	if len(parts) == 1 {
+		return !anchored || rest == ""
+	}
+
+	last := len(parts) - 1
+	for _, part := range parts[1:last] {
+		idx := strings.Index(rest, part)
+		if idx < 0 {
+			return false
+		}
+		rest = rest[idx+len(part):]
+	}
+
+	if anchored {
+		return strings.HasSuffix(rest, parts[last])
+	}
+	return strings.Contains(rest, parts[last])
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+const (
+	repairMaterialDropped    = "material_dropped"
+	repairMaterialTypeFixed  = "material_type_fixed"
+	suggestionsMaxNameLength = 200
+)
+
+type rawNodeSuggestions struct {
+	Description string                  `json:"description"`
+	Materials   []rawMaterialSuggestion `json:"materials"`
+}
+
+type rawMaterialSuggestion struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+}
+
+// repairNodeSuggestionsJSON parses the suggestions returned by the model,
+// dropping materials without a usable absolute http(s) link and duplicates.
+func repairNodeSuggestionsJSON(text string, maxMaterials int) (*entities.NodeSuggestions, *roadmapRepairReport, error) {
+	report := newRoadmapRepairReport()
+
+	jsonData, err := extractJSONTolerant(text, report)
+	if err != nil {
+		return nil, report, err
+	}
+
+	var raw rawNodeSuggestions
+	if err := json.Unmarshal([]byte(jsonData), &raw); err != nil {
+		return nil, report, fmt.Errorf("failed to unmarshal suggestions: %w", err)
+	}
+
+	suggestions := &entities.NodeSuggestions{
+		Description: strings.TrimSpace(raw.Description),
+		Materials:   make([]entities.MaterialSuggestion, 0, len(raw.Materials)),
+	}
+
+	seen := make(map[string]bool, len(raw.Materials))
+	for i, material := range raw.Materials {
+		name := strings.TrimSpace(material.Name)
+		if name == "" {
+			name = strings.TrimSpace(material.Title)
+		}
+
+		link := strings.TrimSpace(material.URL)
+		parsed, err := url.Parse(link)
+		switch {
+		case name == "" || len(name) > suggestionsMaxNameLength:
+			report.add(repairMaterialDropped, fmt.Sprintf("material #%d has no valid name", i+1))
+			continue
+		case err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "":
+			report.add(repairMaterialDropped, fmt.Sprintf("material #%d has invalid url %q", i+1, link))
+			continue
+		case seen[parsed.String()]:
+			report.add(repairMaterialDropped, "")
+			continue
+		case len(suggestions.Materials) >= maxMaterials:
+			report.add(repairMaterialDropped, "")
+			continue
+		}
+		seen[parsed.String()] = true
+
+		materialType := entities.MaterialType(strings.ToLower(strings.TrimSpace(material.Type)))
+		if !materialType.IsValid() || materialType == entities.MaterialTypeFile {
+			materialType = entities.MaterialTypeArticle
+			report.add(repairMaterialTypeFixed, "")
+		}
+
+		suggestions.Materials = append(suggestions.Materials, entities.MaterialSuggestion{
+			Name: name,
+			Type: materialType,
+			URL:  parsed.String(),
+		})
+	}
+
+	if suggestions.Description == "" && len(suggestions.Materials) == 0 {
+		return nil, report, fmt.Errorf("suggestions contain neither description nor materials")
+	}
+
+	return suggestions, report, nil
+}
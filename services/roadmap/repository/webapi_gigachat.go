@@ -3,18 +3,18 @@ package repository
 import (
 	"context"
 	"embed"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"text/template"
 	"time"
 
-	"github.com/tidwall/gjson"
+	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/F0urward/proftwist-backend/internal/entities"
-	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient"
-	gigachatClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/gigachatclient/dto"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	llmClientDTO "github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient/dto"
+	"github.com/F0urward/proftwist-backend/internal/metrics"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
 	"github.com/F0urward/proftwist-backend/services/roadmap"
 	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
@@ -23,12 +23,26 @@ import (
 //go:embed prompts/*
 var roadmapPrompts embed.FS
 
+const roadmapSystemPrompt = "Ты - эксперт по созданию образовательных roadmap. Ты должен возвращать ТОЛЬКО валидный JSON без каких-либо дополнительных комментариев, текста или разметки. Все ID узлов должны быть в формате UUID v4."
+
+const suggestionsSystemPrompt = "Ты - наставник, который пишет описания тем и подбирает учебные материалы. Ты должен возвращать ТОЛЬКО валидный JSON без каких-либо дополнительных комментариев, текста или разметки."
+
+const quizSystemPrompt = "Ты - наставник, который составляет тесты для самопроверки. Ты должен возвращать ТОЛЬКО валидный JSON без каких-либо дополнительных комментариев, текста или разметки."
+
+const (
+	featureRoadmapGeneration   = llmclient.FeatureRoadmapGeneration
+	featureRoadmapRegeneration = llmclient.FeatureRoadmapRegeneration
+	featureNodeSuggestions     = llmclient.FeatureNodeSuggestions
+	featureNodeQuiz            = llmclient.FeatureNodeQuiz
+)
+
 type RoadmapGigaChatWebapi struct {
-	client *gigachatclient.Client
+	client  llmclient.Provider
+	metrics metrics.Metrics
 }
 
-func NewRoadmapGigaChatWebapi(client *gigachatclient.Client) roadmap.GigachatWebapi {
-	return &RoadmapGigaChatWebapi{client: client}
+func NewRoadmapGigaChatWebapi(client llmclient.Provider, mtrs metrics.Metrics) roadmap.GigachatWebapi {
+	return &RoadmapGigaChatWebapi{client: client, metrics: mtrs}
 }
 
 func (r *RoadmapGigaChatWebapi) GenerateRoadmapContent(ctx context.Context, req *dto.GenerateRoadmapDTO) (*entities.Roadmap, error) {
@@ -52,12 +66,11 @@ func (r *RoadmapGigaChatWebapi) GenerateRoadmapContent(ctx context.Context, req
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	chatReq := &gigachatClientDTO.ChatRequest{
-		Model: "GigaChat",
-		Messages: []gigachatClientDTO.Message{
+	chatReq := &llmClientDTO.ChatRequest{
+		Messages: []llmClientDTO.Message{
 			{
 				Role:    "system",
-				Content: "Ты - эксперт по созданию образовательных roadmap. Ты должен возвращать ТОЛЬКО валидный JSON без каких-либо дополнительных комментариев, текста или разметки. Все ID узлов должны быть в формате UUID v4.",
+				Content: systemPrompt(req.Prompt),
 			},
 			{
 				Role:    "user",
@@ -67,25 +80,13 @@ func (r *RoadmapGigaChatWebapi) GenerateRoadmapContent(ctx context.Context, req
 		Temperature:       float64Ptr(0.3),
 		MaxTokens:         int64Ptr(6000),
 		RepetitionPenalty: float64Ptr(1.1),
+		Feature:           featureRoadmapGeneration,
 	}
 
 	logger.Info("sending request to GigaChat")
-	chatResp, err := r.client.Chat(ctx, chatReq)
-	if err != nil {
-		logger.WithError(err).Error("failed to get response from GigaChat")
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	responseText := chatResp.Choices[0].Message.Content
-	jsonData, err := r.extractJSON(responseText)
-	if err != nil {
-		logger.WithError(err).Error("failed to extract JSON from response")
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	roadmapEntity, err := r.parseToRoadmap(jsonData)
+	roadmapEntity, err := r.requestRoadmap(ctx, chatReq, featureRoadmapGeneration)
 	if err != nil {
-		logger.WithError(err).Error("failed to parse JSON to roadmap")
+		logger.WithError(err).Error("failed to get roadmap from GigaChat")
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -101,6 +102,178 @@ func (r *RoadmapGigaChatWebapi) GenerateRoadmapContent(ctx context.Context, req
 	return roadmapEntity, nil
 }
 
+func (r *RoadmapGigaChatWebapi) RegenerateSubtreeContent(ctx context.Context, req *dto.RegenerateSubtreeDTO) (*entities.Roadmap, error) {
+	const op = "GigaChatWebapi.RegenerateSubtreeContent"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	logger.WithFields(map[string]interface{}{
+		"topic":   req.Topic,
+		"node_id": req.Node.ID,
+		"mode":    req.Mode,
+	}).Info("regenerating roadmap subtree with GigaChat")
+
+	prompt, err := r.buildRegenerationPrompt(req)
+	if err != nil {
+		logger.WithError(err).Error("failed to build regeneration prompt")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatReq := &llmClientDTO.ChatRequest{
+		Messages: []llmClientDTO.Message{
+			{
+				Role:    "system",
+				Content: systemPrompt(req.Prompt),
+			},
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		Temperature:       float64Ptr(0.3),
+		MaxTokens:         int64Ptr(4000),
+		RepetitionPenalty: float64Ptr(1.1),
+		Feature:           featureRoadmapRegeneration,
+	}
+
+	logger.Info("sending request to GigaChat")
+	subtree, err := r.requestRoadmap(ctx, chatReq, featureRoadmapRegeneration, req.Node.ID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get subtree from GigaChat")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"nodes_count": len(subtree.Nodes),
+		"edges_count": len(subtree.Edges),
+	}).Info("successfully regenerated subtree content")
+
+	return subtree, nil
+}
+
+func (r *RoadmapGigaChatWebapi) SuggestNodeContent(ctx context.Context, req *dto.NodeSuggestionsDTO) (*entities.NodeSuggestions, error) {
+	const op = "GigaChatWebapi.SuggestNodeContent"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"node_id": req.Node.ID,
+	})
+
+	logger.Info("suggesting node content with GigaChat")
+
+	prompt, err := r.buildSuggestionsPrompt(req)
+	if err != nil {
+		logger.WithError(err).Error("failed to build suggestions prompt")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	system := suggestionsSystemPrompt
+	if req.Prompt != nil && req.Prompt.SystemPrompt != "" {
+		system = req.Prompt.SystemPrompt
+	}
+
+	chatReq := &llmClientDTO.ChatRequest{
+		Messages: []llmClientDTO.Message{
+			{
+				Role:    "system",
+				Content: system,
+			},
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		Temperature:       float64Ptr(0.4),
+		MaxTokens:         int64Ptr(1500),
+		RepetitionPenalty: float64Ptr(1.1),
+		Feature:           featureNodeSuggestions,
+	}
+
+	responseText, err := r.chat(ctx, chatReq)
+	if err != nil {
+		logger.WithError(err).Error("failed to get suggestions from GigaChat")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	suggestions, report, err := repairNodeSuggestionsJSON(responseText, req.MaxMaterials)
+	r.recordRepairs(featureNodeSuggestions, report)
+	if err != nil {
+		r.metrics.IncLLMOutputParse(featureNodeSuggestions, parseOutcomeFailed)
+		logger.WithError(err).Error("failed to parse suggestions returned by GigaChat")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	outcome := parseOutcomeOK
+	if report.repaired() {
+		outcome = parseOutcomeRepaired
+	}
+	r.metrics.IncLLMOutputParse(featureNodeSuggestions, outcome)
+
+	logger.WithField("materials_count", len(suggestions.Materials)).Info("successfully suggested node content")
+
+	return suggestions, nil
+}
+
+func (r *RoadmapGigaChatWebapi) GenerateNodeQuiz(ctx context.Context, req *dto.NodeQuizDraftDTO) ([]entities.QuizQuestion, error) {
+	const op = "GigaChatWebapi.GenerateNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"node_id": req.Node.ID,
+	})
+
+	logger.Info("generating node quiz with GigaChat")
+
+	prompt, err := r.buildQuizPrompt(req)
+	if err != nil {
+		logger.WithError(err).Error("failed to build quiz prompt")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	system := quizSystemPrompt
+	if req.Prompt != nil && req.Prompt.SystemPrompt != "" {
+		system = req.Prompt.SystemPrompt
+	}
+
+	chatReq := &llmClientDTO.ChatRequest{
+		Messages: []llmClientDTO.Message{
+			{
+				Role:    "system",
+				Content: system,
+			},
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		Temperature:       float64Ptr(0.4),
+		MaxTokens:         int64Ptr(3000),
+		RepetitionPenalty: float64Ptr(1.1),
+		Feature:           featureNodeQuiz,
+	}
+
+	responseText, err := r.chat(ctx, chatReq)
+	if err != nil {
+		logger.WithError(err).Error("failed to get quiz from GigaChat")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	questions, report, err := repairNodeQuizJSON(responseText, req.QuestionsCount)
+	r.recordRepairs(featureNodeQuiz, report)
+	if err != nil {
+		r.metrics.IncLLMOutputParse(featureNodeQuiz, parseOutcomeFailed)
+		logger.WithError(err).Error("failed to parse quiz returned by GigaChat")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	outcome := parseOutcomeOK
+	if report.repaired() {
+		outcome = parseOutcomeRepaired
+	}
+	r.metrics.IncLLMOutputParse(featureNodeQuiz, outcome)
+
+	logger.WithField("questions_count", len(questions)).Info("successfully generated node quiz")
+
+	return questions, nil
+}
+
 func (r *RoadmapGigaChatWebapi) loadRoadmapExample() (string, error) {
 	example, err := roadmapPrompts.ReadFile("prompts/roadmap_example.json")
 	if err != nil {
@@ -110,12 +283,12 @@ func (r *RoadmapGigaChatWebapi) loadRoadmapExample() (string, error) {
 }
 
 func (r *RoadmapGigaChatWebapi) buildGenerationPrompt(req *dto.GenerateRoadmapDTO, example string) (string, error) {
-	promptTmpl, err := roadmapPrompts.ReadFile("prompts/generation_prompt.tmpl")
+	promptTmpl, err := promptBody(req.Prompt, "prompts/generation_prompt.tmpl")
 	if err != nil {
 		return "", fmt.Errorf("failed to read generation prompt template: %w", err)
 	}
 
-	tmpl, err := template.New("roadmapPrompt").Parse(string(promptTmpl))
+	tmpl, err := template.New("roadmapPrompt").Parse(promptTmpl)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse generation prompt template: %w", err)
 	}
@@ -145,22 +318,164 @@ func (r *RoadmapGigaChatWebapi) buildGenerationPrompt(req *dto.GenerateRoadmapDT
 	return buf.String(), nil
 }
 
-func (r *RoadmapGigaChatWebapi) extractJSON(text string) (string, error) {
-	result := gjson.Parse(text)
+func (r *RoadmapGigaChatWebapi) buildRegenerationPrompt(req *dto.RegenerateSubtreeDTO) (string, error) {
+	promptTmpl, err := promptBody(req.Prompt, "prompts/regeneration_prompt.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("failed to read regeneration prompt template: %w", err)
+	}
+
+	tmpl, err := template.New("regenerationPrompt").Parse(promptTmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse regeneration prompt template: %w", err)
+	}
+
+	buf := &strings.Builder{}
+	err = tmpl.Execute(buf, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute regeneration prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+func (r *RoadmapGigaChatWebapi) buildSuggestionsPrompt(req *dto.NodeSuggestionsDTO) (string, error) {
+	promptTmpl, err := promptBody(req.Prompt, "prompts/suggestions_prompt.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("failed to read suggestions prompt template: %w", err)
+	}
+
+	tmpl, err := template.New("suggestionsPrompt").Parse(promptTmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse suggestions prompt template: %w", err)
+	}
 
-	if result.Exists() && result.Type != gjson.Null {
-		return result.String(), nil
+	buf := &strings.Builder{}
+	err = tmpl.Execute(buf, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute suggestions prompt template: %w", err)
 	}
 
-	return "", fmt.Errorf("valid JSON not found in response")
+	return buf.String(), nil
+}
+
+func (r *RoadmapGigaChatWebapi) buildQuizPrompt(req *dto.NodeQuizDraftDTO) (string, error) {
+	promptTmpl, err := promptBody(req.Prompt, "prompts/quiz_prompt.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("failed to read quiz prompt template: %w", err)
+	}
+
+	tmpl, err := template.New("quizPrompt").Parse(promptTmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse quiz prompt template: %w", err)
+	}
+
+	buf := &strings.Builder{}
+	err = tmpl.Execute(buf, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute quiz prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// requestRoadmap sends the chat request and parses the answer into a roadmap,
+// repairing what it can locally. If the answer is still unusable, the model is
+// asked once more to fix its JSON with the parsing errors attached.
+func (r *RoadmapGigaChatWebapi) requestRoadmap(ctx context.Context, chatReq *llmClientDTO.ChatRequest, feature string, externalIDs ...uuid.UUID) (*entities.Roadmap, error) {
+	const op = "GigaChatWebapi.requestRoadmap"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"feature": feature,
+	})
+
+	responseText, err := r.chat(ctx, chatReq)
+	if err != nil {
+		return nil, err
+	}
+
+	result, report, parseErr := repairRoadmapJSON(responseText, externalIDs...)
+	r.recordRepairs(feature, report)
+	if parseErr == nil {
+		outcome := parseOutcomeOK
+		if report.repaired() {
+			outcome = parseOutcomeRepaired
+			logger.WithField("repairs", report.repairs).Info("repaired roadmap returned by GigaChat")
+		}
+		r.metrics.IncLLMOutputParse(feature, outcome)
+		return result, nil
+	}
+
+	logger.WithError(parseErr).Warn("roadmap returned by GigaChat is invalid, asking to fix it")
+
+	issues := append([]string{parseErr.Error()}, report.issues...)
+	retryReq := *chatReq
+	retryReq.Messages = append(append([]llmClientDTO.Message{}, chatReq.Messages...),
+		llmClientDTO.Message{
+			Role:    "assistant",
+			Content: responseText,
+		},
+		llmClientDTO.Message{
+			Role:    "user",
+			Content: fmt.Sprintf(roadmapRepairFixMessage, "- "+strings.Join(issues, "\n- ")),
+		},
+	)
+
+	responseText, err = r.chat(ctx, &retryReq)
+	if err != nil {
+		r.metrics.IncLLMOutputParse(feature, parseOutcomeFailed)
+		return nil, err
+	}
+
+	result, report, parseErr = repairRoadmapJSON(responseText, externalIDs...)
+	r.recordRepairs(feature, report)
+	if parseErr != nil {
+		r.metrics.IncLLMOutputParse(feature, parseOutcomeFailed)
+		return nil, fmt.Errorf("invalid roadmap returned by GigaChat after retry: %w", parseErr)
+	}
+
+	r.metrics.IncLLMOutputParse(feature, parseOutcomeRetried)
+	logger.Info("GigaChat fixed roadmap after retry")
+	return result, nil
+}
+
+func (r *RoadmapGigaChatWebapi) chat(ctx context.Context, chatReq *llmClientDTO.ChatRequest) (string, error) {
+	chatResp, err := r.client.Chat(ctx, chatReq)
+	if err != nil {
+		return "", err
+	}
+
+	if len(chatResp.Choices) == 0 {
+		return "", fmt.Errorf("empty response from GigaChat")
+	}
+
+	return chatResp.Choices[0].Message.Content, nil
+}
+
+func (r *RoadmapGigaChatWebapi) recordRepairs(feature string, report *roadmapRepairReport) {
+	for kind, count := range report.repairs {
+		r.metrics.AddLLMOutputRepairs(feature, kind, count)
+	}
+}
+
+// promptBody returns the body of the template version chosen for the request,
+// falling back to the template embedded into the binary.
+func promptBody(prompt *entities.PromptTemplate, file string) (string, error) {
+	if prompt != nil {
+		return prompt.Body, nil
+	}
+
+	body, err := roadmapPrompts.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
 }
 
-func (r *RoadmapGigaChatWebapi) parseToRoadmap(jsonData string) (*entities.Roadmap, error) {
-	var roadmap entities.Roadmap
-	if err := json.Unmarshal([]byte(jsonData), &roadmap); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal roadmap: %w", err)
+func systemPrompt(prompt *entities.PromptTemplate) string {
+	if prompt != nil && prompt.SystemPrompt != "" {
+		return prompt.SystemPrompt
 	}
-	return &roadmap, nil
+	return roadmapSystemPrompt
 }
 
 func float64Ptr(f float64) *float64 {
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/pkg/safehttp"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+const (
+	linkCheckBodyLimit   = 64 << 10
+	robotsBodyLimit      = 512 << 10
+	linkCheckHostsToKeep = 1024
+)
+
+var errTooManyRedirects = errors.New("too many redirects")
+
+type robotsCacheEntry struct {
+	rules     *robotsRules
+	expiresAt time.Time
+}
+
+type RoadmapLinkCheckWebapi struct {
+	cfg       config.LinkCheckConfig
+	transport http.RoundTripper
+
+	mu          sync.Mutex
+	nextRequest map[string]time.Time
+	robots      map[string]robotsCacheEntry
+}
+
+func NewRoadmapLinkCheckWebapi(cfg *config.Config) roadmap.LinkCheckWebapi {
+	return &RoadmapLinkCheckWebapi{
+		cfg:         cfg.Workers.LinkCheck,
+		transport:   safehttp.NewTransport(cfg.Workers.LinkCheck.RequestTimeout),
+		nextRequest: make(map[string]time.Time),
+		robots:      make(map[string]robotsCacheEntry),
+	}
+}
+
+func (r *RoadmapLinkCheckWebapi) CheckLink(ctx context.Context, rawURL string) *entities.MaterialLinkCheck {
+	const op = "LinkCheckWebapi.CheckLink"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":  op,
+		"url": rawURL,
+	})
+
+	check := &entities.MaterialLinkCheck{CheckedAt: time.Now()}
+
+	target, err := url.Parse(rawURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		check.Status = entities.MaterialLinkBroken
+		check.Error = "invalid url"
+		return check
+	}
+
+	if !r.allowedByRobots(ctx, target) {
+		logger.Debug("link check disallowed by robots.txt")
+		check.Status = entities.MaterialLinkBlocked
+		return check
+	}
+
+	resp, redirects, err := r.do(ctx, http.MethodHead, target)
+	if shouldRetryWithGet(ctx, resp, err) {
+		// Plenty of servers reject or mishandle HEAD, so confirm failures with GET.
+		resp, redirects, err = r.do(ctx, http.MethodGet, target)
+	}
+
+	check.CheckedAt = time.Now()
+	check.Redirects = redirects
+
+	if err != nil {
+		logger.WithError(err).Debug("link check request failed")
+		check.Status = entities.MaterialLinkBroken
+		check.Error = err.Error()
+		return check
+	}
+
+	check.StatusCode = resp.StatusCode
+	check.FinalURL = resp.Request.URL.String()
+	check.Status = classifyLinkStatus(resp.StatusCode, redirects)
+
+	return check
+}
+
+func (r *RoadmapLinkCheckWebapi) do(ctx context.Context, method string, target *url.URL) (*http.Response, []string, error) {
+	if err := r.waitForHost(ctx, target.Host); err != nil {
+		return nil, nil, err
+	}
+
+	var redirects []string
+	client := &http.Client{
+		Transport: r.transport,
+		Timeout:   r.cfg.RequestTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > r.cfg.MaxRedirects {
+				return errTooManyRedirects
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("unsupported redirect scheme: %s", req.URL.Scheme)
+			}
+			redirects = append(redirects, req.URL.String())
+			return nil
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", r.cfg.UserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, redirects, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, linkCheckBodyLimit))
+
+	return resp, redirects, nil
+}
+
+func (r *RoadmapLinkCheckWebapi) allowedByRobots(ctx context.Context, target *url.URL) bool {
+	key := target.Scheme + "://" + target.Host
+
+	r.mu.Lock()
+	entry, ok := r.robots[key]
+	r.mu.Unlock()
+
+	if !ok || time.Now().After(entry.expiresAt) {
+		entry = robotsCacheEntry{
+			rules:     r.fetchRobots(ctx, key),
+			expiresAt: time.Now().Add(r.cfg.RecheckAfter),
+		}
+
+		r.mu.Lock()
+		if len(r.robots) >= linkCheckHostsToKeep {
+			r.robots = make(map[string]robotsCacheEntry)
+		}
+		r.robots[key] = entry
+		r.mu.Unlock()
+	}
+
+	return entry.rules.allowed(target.EscapedPath())
+}
+
+// fetchRobots treats a missing or unreadable robots.txt as allowing everything.
+func (r *RoadmapLinkCheckWebapi) fetchRobots(ctx context.Context, origin string) *robotsRules {
+	logger := ctxutil.GetLogger(ctx).WithField("origin", origin)
+
+	robotsURL, err := url.Parse(origin + "/robots.txt")
+	if err != nil {
+		return &robotsRules{}
+	}
+
+	if err := r.waitForHost(ctx, robotsURL.Host); err != nil {
+		return &robotsRules{}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return &robotsRules{}
+	}
+	req.Header.Set("User-Agent", r.cfg.UserAgent)
+
+	client := &http.Client{Transport: r.transport, Timeout: r.cfg.RequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		logger.WithError(err).Debug("failed to fetch robots.txt")
+		return &robotsRules{}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &robotsRules{}
+	}
+
+	return parseRobots(io.LimitReader(resp.Body, robotsBodyLimit), r.cfg.UserAgent)
+}
+
+// waitForHost spaces requests to the same host by HostDelay.
+func (r *RoadmapLinkCheckWebapi) waitForHost(ctx context.Context, host string) error {
+	r.mu.Lock()
+	now := time.Now()
+	if len(r.nextRequest) >= linkCheckHostsToKeep {
+		for h, at := range r.nextRequest {
+			if at.Before(now) {
+				delete(r.nextRequest, h)
+			}
+		}
+	}
+
+	at := r.nextRequest[host]
+	if at.Before(now) {
+		at = now
+	}
+	r.nextRequest[host] = at.Add(r.cfg.HostDelay)
+	r.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// shouldRetryWithGet reports whether a failed HEAD request is worth repeating
+// as GET. Requests refused for targeting a non-public address are not.
+func shouldRetryWithGet(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil && !errors.Is(err, safehttp.ErrForbiddenAddress)
+	}
+	return resp.StatusCode >= http.StatusBadRequest
+}
+
+func classifyLinkStatus(statusCode int, redirects []string) entities.MaterialLinkStatus {
+	switch {
+	case statusCode == http.StatusUnauthorized,
+		statusCode == http.StatusForbidden,
+		statusCode == http.StatusTooManyRequests:
+		return entities.MaterialLinkRestricted
+	case statusCode >= http.StatusBadRequest:
+		return entities.MaterialLinkBroken
+	case len(redirects) > 0:
+		return entities.MaterialLinkRedirected
+	default:
+		return entities.MaterialLinkOK
+	}
+}
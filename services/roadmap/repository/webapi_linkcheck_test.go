@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+func testLinkCheckConfig() config.LinkCheckConfig {
+	return config.LinkCheckConfig{
+		RecheckAfter:   time.Hour,
+		RequestTimeout: 2 * time.Second,
+		MaxRedirects:   3,
+		UserAgent:      "ProftwistLinkChecker/1.0",
+	}
+}
+
+// newTestLinkCheckWebapi talks to local stand-in servers, which the
+// production transport refuses to reach.
+func newTestLinkCheckWebapi(cfg config.LinkCheckConfig) *RoadmapLinkCheckWebapi {
+	return &RoadmapLinkCheckWebapi{
+		cfg:         cfg,
+		transport:   &http.Transport{},
+		nextRequest: make(map[string]time.Time),
+		robots:      make(map[string]robotsCacheEntry),
+	}
+}
+
+type requestLog struct {
+	mu       sync.Mutex
+	requests []string
+	times    []time.Time
+}
+
+func (l *requestLog) add(r *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = append(l.requests, r.Method+" "+r.URL.Path)
+	l.times = append(l.times, time.Now())
+}
+
+func (l *requestLog) snapshot() ([]string, []time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.requests...), append([]time.Time(nil), l.times...)
+}
+
+func TestCheckLinkFallsBackToGetWhenHeadIsRejected(t *testing.T) {
+	log := &requestLog{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	check := newTestLinkCheckWebapi(testLinkCheckConfig()).CheckLink(context.Background(), server.URL+"/page")
+
+	if check.Status != entities.MaterialLinkOK || check.StatusCode != http.StatusOK {
+		t.Fatalf("got status %q (%d), want ok (200)", check.Status, check.StatusCode)
+	}
+
+	requests, _ := log.snapshot()
+	want := []string{"GET /robots.txt", "HEAD /page", "GET /page"}
+	if strings.Join(requests, ",") != strings.Join(want, ",") {
+		t.Fatalf("got requests %v, want %v", requests, want)
+	}
+}
+
+func TestCheckLinkFallsBackToGetWhenHeadFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodHead {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	check := newTestLinkCheckWebapi(testLinkCheckConfig()).CheckLink(context.Background(), server.URL+"/page")
+
+	if check.Status != entities.MaterialLinkOK {
+		t.Fatalf("got status %q (%s), want ok", check.Status, check.Error)
+	}
+}
+
+func TestCheckLinkFollowsRedirects(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	check := newTestLinkCheckWebapi(testLinkCheckConfig()).CheckLink(context.Background(), server.URL+"/old")
+
+	if check.Status != entities.MaterialLinkRedirected {
+		t.Fatalf("got status %q, want redirected", check.Status)
+	}
+	if check.FinalURL != server.URL+"/new" {
+		t.Fatalf("got final url %q, want %q", check.FinalURL, server.URL+"/new")
+	}
+	if len(check.Redirects) != 1 || check.Redirects[0] != server.URL+"/new" {
+		t.Fatalf("got redirects %v", check.Redirects)
+	}
+}
+
+func TestCheckLinkStopsAfterTooManyRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		http.Redirect(w, r, r.URL.Path+"x", http.StatusFound)
+	}))
+	defer server.Close()
+
+	check := newTestLinkCheckWebapi(testLinkCheckConfig()).CheckLink(context.Background(), server.URL+"/loop")
+
+	if check.Status != entities.MaterialLinkBroken || !strings.Contains(check.Error, errTooManyRedirects.Error()) {
+		t.Fatalf("got status %q (%s), want broken after too many redirects", check.Status, check.Error)
+	}
+}
+
+func TestCheckLinkRejectsRedirectToOtherSchemes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+	}))
+	defer server.Close()
+
+	check := newTestLinkCheckWebapi(testLinkCheckConfig()).CheckLink(context.Background(), server.URL+"/page")
+
+	if check.Status != entities.MaterialLinkBroken || !strings.Contains(check.Error, "unsupported redirect scheme") {
+		t.Fatalf("got status %q (%s), want broken with unsupported scheme", check.Status, check.Error)
+	}
+}
+
+func TestCheckLinkHonoursRobotsDisallow(t *testing.T) {
+	log := &requestLog{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		if r.URL.Path == "/robots.txt" {
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	webapi := newTestLinkCheckWebapi(testLinkCheckConfig())
+
+	if check := webapi.CheckLink(context.Background(), server.URL+"/private/page"); check.Status != entities.MaterialLinkBlocked {
+		t.Fatalf("got status %q, want blocked", check.Status)
+	}
+	if check := webapi.CheckLink(context.Background(), server.URL+"/public"); check.Status != entities.MaterialLinkOK {
+		t.Fatalf("got status %q, want ok", check.Status)
+	}
+
+	requests, _ := log.snapshot()
+	for _, request := range requests {
+		if strings.Contains(request, "/private") {
+			t.Fatalf("disallowed path was requested: %v", requests)
+		}
+	}
+	if strings.Count(strings.Join(requests, ","), "/robots.txt") != 1 {
+		t.Fatalf("robots.txt was not cached: %v", requests)
+	}
+}
+
+func TestCheckLinkSpacesRequestsToTheSameHost(t *testing.T) {
+	const hostDelay = 80 * time.Millisecond
+
+	log := &requestLog{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := testLinkCheckConfig()
+	cfg.HostDelay = hostDelay
+	webapi := newTestLinkCheckWebapi(cfg)
+
+	webapi.CheckLink(context.Background(), server.URL+"/a")
+	webapi.CheckLink(context.Background(), server.URL+"/b")
+
+	requests, times := log.snapshot()
+	if len(times) != 3 {
+		t.Fatalf("got requests %v, want robots.txt and two HEAD requests", requests)
+	}
+	for i := 1; i < len(times); i++ {
+		// Allow some slack for timer granularity.
+		if gap := times[i].Sub(times[i-1]); gap < hostDelay-10*time.Millisecond {
+			t.Fatalf("requests %d and %d were %v apart, want at least %v", i-1, i, gap, hostDelay)
+		}
+	}
+}
+
+func TestCheckLinkRejectsInvalidURLs(t *testing.T) {
+	webapi := newTestLinkCheckWebapi(testLinkCheckConfig())
+
+	for _, rawURL := range []string{"ftp://example.com/file", "not a url", "http://"} {
+		check := webapi.CheckLink(context.Background(), rawURL)
+		if check.Status != entities.MaterialLinkBroken || check.Error != "invalid url" {
+			t.Errorf("%q: got status %q (%s), want broken invalid url", rawURL, check.Status, check.Error)
+		}
+	}
+}
+
+func TestCheckLinkRefusesNonPublicAddresses(t *testing.T) {
+	var hits int
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits++
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{}
+	cfg.Workers.LinkCheck = testLinkCheckConfig()
+	webapi := NewRoadmapLinkCheckWebapi(cfg)
+
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	for _, rawURL := range []string{server.URL + "/page", "http://localhost:" + port + "/page"} {
+		check := webapi.CheckLink(context.Background(), rawURL)
+		if check.Status != entities.MaterialLinkBroken || !strings.Contains(check.Error, "forbidden address") {
+			t.Errorf("%q: got status %q (%s), want broken forbidden address", rawURL, check.Status, check.Error)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if hits != 0 {
+		t.Fatalf("internal server was reached %d times", hits)
+	}
+}
+
+func TestClassifyLinkStatus(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		redirects  []string
+		want       entities.MaterialLinkStatus
+	}{
+		{http.StatusOK, nil, entities.MaterialLinkOK},
+		{http.StatusNoContent, nil, entities.MaterialLinkOK},
+		{http.StatusOK, []string{"https://example.com/new"}, entities.MaterialLinkRedirected},
+		{http.StatusUnauthorized, nil, entities.MaterialLinkRestricted},
+		{http.StatusForbidden, nil, entities.MaterialLinkRestricted},
+		{http.StatusTooManyRequests, nil, entities.MaterialLinkRestricted},
+		{http.StatusNotFound, nil, entities.MaterialLinkBroken},
+		{http.StatusGone, []string{"https://example.com/new"}, entities.MaterialLinkBroken},
+		{http.StatusInternalServerError, nil, entities.MaterialLinkBroken},
+	}
+
+	for _, tt := range tests {
+		if got := classifyLinkStatus(tt.statusCode, tt.redirects); got != tt.want {
+			t.Errorf("classifyLinkStatus(%d, %v) = %q, want %q", tt.statusCode, tt.redirects, got, tt.want)
+		}
+	}
+}
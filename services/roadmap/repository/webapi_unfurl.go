@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/pkg/safehttp"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+const (
+	maxPreviewTitleLength       = 300
+	maxPreviewDescriptionLength = 1000
+	maxPreviewSiteNameLength    = 100
+)
+
+var errNotHTML = errors.New("response is not an html page")
+
+type RoadmapUnfurlWebapi struct {
+	cfg    config.UnfurlConfig
+	client *http.Client
+}
+
+func NewRoadmapUnfurlWebapi(cfg *config.Config) roadmap.UnfurlWebapi {
+	unfurlCfg := cfg.Unfurl
+
+	return &RoadmapUnfurlWebapi{
+		cfg: unfurlCfg,
+		client: &http.Client{
+			Transport: safehttp.NewTransport(unfurlCfg.Timeout),
+			Timeout:   unfurlCfg.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) > unfurlCfg.MaxRedirects {
+					return errTooManyRedirects
+				}
+				if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+					return fmt.Errorf("unsupported redirect scheme: %s", req.URL.Scheme)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func (r *RoadmapUnfurlWebapi) Unfurl(ctx context.Context, rawURL string) (*entities.MaterialPreview, error) {
+	const op = "UnfurlWebapi.Unfurl"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":  op,
+		"url": rawURL,
+	})
+
+	target, err := url.Parse(rawURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("%s: invalid url", op)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("User-Agent", r.cfg.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		logger.WithError(err).Debug("failed to fetch material page")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %d", op, resp.StatusCode)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, fmt.Errorf("%s: %w", op, errNotHTML)
+	}
+
+	preview := parsePreview(io.LimitReader(resp.Body, r.cfg.MaxBodySize), resp.Request.URL)
+	preview.FetchedAt = time.Now()
+
+	logger.WithField("title", preview.Title).Debug("unfurled material page")
+	return preview, nil
+}
+
+// parsePreview reads the document head, preferring Open Graph values over the
+// plain title and description.
+func parsePreview(body io.Reader, base *url.URL) *entities.MaterialPreview {
+	var (
+		title, description, siteName, favicon, image string
+		ogTitle, ogDescription, twitterImage         string
+		inTitle                                      bool
+	)
+
+	tokenizer := html.NewTokenizer(body)
+
+loop:
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			break loop
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = string(tokenizer.Text())
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			tag := atom.Lookup(name)
+
+			if tag == atom.Body {
+				break loop
+			}
+			if tag == atom.Title {
+				inTitle = true
+				continue
+			}
+			if !hasAttr || (tag != atom.Meta && tag != atom.Link) {
+				continue
+			}
+
+			attrs := readAttrs(tokenizer)
+
+			if tag == atom.Link {
+				if favicon == "" && isIconRel(attrs["rel"]) {
+					favicon = attrs["href"]
+				}
+				continue
+			}
+
+			key := strings.ToLower(attrs["property"])
+			if key == "" {
+				key = strings.ToLower(attrs["name"])
+			}
+
+			content := attrs["content"]
+			switch key {
+			case "og:title":
+				ogTitle = content
+			case "og:description":
+				ogDescription = content
+			case "og:site_name":
+				siteName = content
+			case "og:image", "og:image:url", "og:image:secure_url":
+				if image == "" {
+					image = content
+				}
+			case "twitter:image", "twitter:image:src":
+				twitterImage = content
+			case "description":
+				description = content
+			}
+		}
+	}
+
+	if ogTitle != "" {
+		title = ogTitle
+	}
+	if ogDescription != "" {
+		description = ogDescription
+	}
+	if image == "" {
+		image = twitterImage
+	}
+	if favicon == "" {
+		favicon = "/favicon.ico"
+	}
+
+	return &entities.MaterialPreview{
+		Title:       cleanPreviewText(title, maxPreviewTitleLength),
+		Description: cleanPreviewText(description, maxPreviewDescriptionLength),
+		SiteName:    cleanPreviewText(siteName, maxPreviewSiteNameLength),
+		FaviconURL:  resolvePreviewURL(base, favicon),
+		ImageURL:    resolvePreviewURL(base, image),
+	}
+}
+
+func readAttrs(tokenizer *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, value, more := tokenizer.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(value)
+		if !more {
+			return attrs
+		}
+	}
+}
+
+func isIconRel(rel string) bool {
+	for _, part := range strings.Fields(strings.ToLower(rel)) {
+		if part == "icon" {
+			return true
+		}
+	}
+	return false
+}
+
+func cleanPreviewText(text string, maxLength int) string {
+	text = strings.Join(strings.Fields(strings.ToValidUTF8(text, "")), " ")
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+	return string([]rune(text)[:maxLength])
+}
+
+// resolvePreviewURL makes page relative links absolute and drops anything that is not http(s).
+func resolvePreviewURL(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+
+	resolved := base.ResolveReference(parsed)
+	if resolved.Scheme != "http" && resolved.Scheme != "https" {
+		return ""
+	}
+
+	return resolved.String()
+}
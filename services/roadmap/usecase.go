@@ -2,23 +2,71 @@ package roadmap
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
 )
 
 type Usecase interface {
-	GetByIDWithProgress(ctx context.Context, roadmapID primitive.ObjectID, userID uuid.UUID) (*dto.GetByIDRoadmapWithProgressResponseDTO, error)
+	GetByIDWithProgress(ctx context.Context, roadmapID primitive.ObjectID, userID uuid.UUID, lang string) (*dto.GetByIDRoadmapWithProgressResponseDTO, error)
 	GetByIDWithMaterials(ctx context.Context, roadmapID primitive.ObjectID) (*dto.GetByIDRoadmapWithMaterialsResponseDTO, error)
+	ListIDs(ctx context.Context, createdBefore time.Time) ([]primitive.ObjectID, error)
 	Create(ctx context.Context, req *dto.CreateRoadmapRequestDTO) (*dto.CreateRoadmapResponseDTO, error)
 	Update(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, req *dto.UpdateRoadmapRequestDTO) error
 	Delete(context.Context, primitive.ObjectID) error
 	Generate(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, req *dto.GenerateRoadmapRequestDTO) (*dto.GenerateRoadmapResponseDTO, error)
-	RegenerateNodeIDs(roadmapDTO *dto.RoadmapWithMaterialsDTO) *dto.RoadmapWithMaterialsDTO
+	RegenerateNode(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.RegenerateNodeRequestDTO) (*dto.RegenerateNodeResponseDTO, error)
+	SuggestNodeContent(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.SuggestNodeContentRequestDTO) (*dto.SuggestNodeContentResponseDTO, error)
+	RegenerateNodeIDs(roadmapDTO *dto.RoadmapWithMaterialsDTO) (*dto.RoadmapWithMaterialsDTO, map[uuid.UUID]uuid.UUID)
+	RecordRoadmapLineage(ctx context.Context, sourceRoadmapID, roadmapID primitive.ObjectID, kind entities.RoadmapLineageKind, nodeIDMap map[uuid.UUID]uuid.UUID) error
+	MigrateProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.MigrateProgressResponseDTO, error)
+	ApplyDraft(ctx context.Context, userID uuid.UUID, draftRoadmapID, roadmapID primitive.ObjectID) (addedNodes, removedNodes int, err error)
 	CreateMaterial(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req dto.CreateMaterialRequestDTO) (*dto.EnrichedMaterialResponseDTO, error)
+	UploadMaterial(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.UploadMaterialRequestDTO) (*dto.EnrichedMaterialResponseDTO, error)
 	DeleteMaterial(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, userID uuid.UUID) error
-	GetMaterialsByNode(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*dto.MaterialListResponseDTO, error)
+	GetMaterialsByNode(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*dto.MaterialListResponseDTO, error)
+	VoteMaterial(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, req *dto.VoteMaterialRequestDTO) (*dto.VoteMaterialResponseDTO, error)
+	DeleteMaterialVote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID) (*dto.VoteMaterialResponseDTO, error)
 	UpdateNodeProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, req *dto.UpdateNodeProgressRequestDTO) error
+	GetProgressTimeline(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, lang string, limit, offset int) (*dto.ProgressTimelineResponseDTO, error)
+	GetProgressSummary(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, lang string) (*dto.ProgressSummaryDTO, error)
+	GetMyLearning(ctx context.Context, userID uuid.UUID, lang string, limit, offset int) (*dto.MyLearningResponseDTO, error)
+	GetLearningOrder(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, lang string) (*dto.LearningOrderResponseDTO, error)
+	GetBrokenLinks(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.BrokenLinksResponseDTO, error)
+	UpsertNodeNote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.UpsertNodeNoteRequestDTO) (*dto.NodeNoteResponseDTO, error)
+	DeleteNodeNote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error
+	SearchNodeNotes(ctx context.Context, userID uuid.UUID, query string, limit, offset int) (*dto.NodeNoteSearchResponseDTO, error)
+	ExportRoadmap(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.RoadmapExportDTO, error)
+	IssueCertificate(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.CertificateDTO, error)
+	GetMyCertificates(ctx context.Context, userID uuid.UUID) (*dto.CertificateListResponseDTO, error)
+	VerifyCertificate(ctx context.Context, certificateID uuid.UUID, signature string) (*dto.CertificateVerificationResponseDTO, error)
+	GetNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*dto.NodeQuizDTO, error)
+	UpsertNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.UpsertNodeQuizRequestDTO) (*dto.NodeQuizDTO, error)
+	DeleteNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error
+	GenerateNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.GenerateNodeQuizRequestDTO) (*dto.GenerateNodeQuizResponseDTO, error)
+	SubmitQuizAttempt(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.SubmitQuizAttemptRequestDTO) (*dto.QuizAttemptResultDTO, error)
+	GetQuizAttempts(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, limit, offset int) (*dto.QuizAttemptListResponseDTO, error)
+	UpsertLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, req *dto.UpsertLearningGoalRequestDTO) (*dto.LearningGoalDTO, error)
+	GetLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.LearningGoalDTO, error)
+	DeleteLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) error
+	GetMyLearningGoals(ctx context.Context, userID uuid.UUID) (*dto.LearningGoalListResponseDTO, error)
+	GetCalendarFeed(ctx context.Context, userID uuid.UUID) (*dto.CalendarFeedDTO, error)
+	RotateCalendarFeedToken(ctx context.Context, userID uuid.UUID) (*dto.CalendarFeedDTO, error)
+	DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error
+	ExportCalendarFeed(ctx context.Context, token string) (*dto.CalendarFeedFileDTO, error)
+	JoinEditing(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.EditingSnapshotDTO, error)
+	ApplyEditOperation(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, operation *dto.EditOperationDTO) (*dto.AppliedEditOperationDTO, error)
+	ReleaseEditing(roadmapID primitive.ObjectID)
+}
+
+type LinkCheckUsecase interface {
+	CheckMaterialLinks(ctx context.Context) error
+}
+
+type GoalReminderUsecase interface {
+	SendGoalReminders(ctx context.Context) error
 }
@@ -0,0 +1,45 @@
+package roadmap
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+// attributeEdits records the user as the last editor of the roadmap, as the
+// author of nodes added in after and as the last editor of nodes whose content
+// or placement changed.
+func attributeEdits(before, after *entities.Roadmap, userID uuid.UUID) {
+	if after == nil || userID == uuid.Nil {
+		return
+	}
+
+	previous := make(map[uuid.UUID]entities.RoadmapNode)
+	if before != nil {
+		for _, node := range before.Nodes {
+			previous[node.ID] = node
+		}
+	}
+
+	editor := userID
+	after.UpdatedBy = &editor
+	for i := range after.Nodes {
+		node := &after.Nodes[i]
+		old, exists := previous[node.ID]
+		if !exists {
+			node.CreatedBy = &editor
+			node.UpdatedBy = &editor
+			continue
+		}
+		if nodeEdited(old, *node) {
+			node.UpdatedBy = &editor
+		}
+	}
+}
+
+func nodeEdited(old, updated entities.RoadmapNode) bool {
+	return old.Type != updated.Type ||
+		old.Data != updated.Data ||
+		old.Description != updated.Description ||
+		old.Position != updated.Position
+}
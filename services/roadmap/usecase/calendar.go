@@ -0,0 +1,451 @@
+package roadmap
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/pkg/ical"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+const (
+	calendarTokenBytes  = 24
+	calendarProdID      = "-//ProfTwist//Study Plan//RU"
+	calendarName        = "ProfTwist: учебный план"
+	calendarFileName    = "proftwist.ics"
+	calendarUIDDomain   = "proftwist"
+	defaultRoadmapTitle = "Роадмап"
+)
+
+// calendarPlanItem is a roadmap the study plan covers, with the user's goal
+// for it when there is one.
+type calendarPlanItem struct {
+	roadmap  *entities.Roadmap
+	name     string
+	goal     *entities.LearningGoal
+	progress *entities.UserProgress
+}
+
+// planStudyCalendar schedules the unfinished nodes of every roadmap starting
+// tomorrow. Nodes in progress come first, then pending nodes in learning order.
+// A roadmap with a goal is studied at the pace needed to meet the deadline, or
+// at the planned weekly pace when that is higher; other roadmaps use the
+// default pace. Sessions of the same day are placed back to back, so roadmaps
+// never overlap. Goal deadlines are added as all-day events.
+func planStudyCalendar(userID uuid.UUID, items []calendarPlanItem, cfg config.CalendarConfig, loc *time.Location, now time.Time) []entities.CalendarEvent {
+	local := now.In(loc)
+	firstDay := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+	horizonDays := int(cfg.Horizon / (24 * time.Hour))
+	if horizonDays < 1 {
+		horizonDays = 1
+	}
+
+	sessionDuration := cfg.SessionDuration
+	if sessionDuration <= 0 {
+		sessionDuration = time.Hour
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		left, right := items[i].goal, items[j].goal
+		if left == nil || right == nil {
+			return left != nil && right == nil
+		}
+		return left.Deadline.Before(right.Deadline)
+	})
+
+	var events []entities.CalendarEvent
+	slots := make(map[int]int)
+
+	for _, item := range items {
+		remaining := remainingCalendarNodes(item.roadmap, item.progress)
+
+		if item.goal != nil && len(remaining) > 0 {
+			deadline := item.goal.Deadline.In(loc)
+			events = append(events, entities.CalendarEvent{
+				UID:         fmt.Sprintf("%s.%s.deadline@%s", userID, item.roadmap.ID.Hex(), calendarUIDDomain),
+				Kind:        entities.CalendarEventGoalDeadline,
+				RoadmapID:   item.roadmap.ID,
+				Summary:     fmt.Sprintf("Срок цели: %s", item.name),
+				Description: fmt.Sprintf("Осталось узлов: %d", len(remaining)),
+				Start:       time.Date(deadline.Year(), deadline.Month(), deadline.Day(), 0, 0, 0, 0, time.UTC),
+				End:         time.Date(deadline.Year(), deadline.Month(), deadline.Day(), 0, 0, 0, 0, time.UTC),
+				AllDay:      true,
+			})
+		}
+
+		pace := calendarPace(item.goal, len(remaining), cfg.DefaultWeeklyPace, firstDay)
+		for i, node := range remaining {
+			day := int(math.Floor(float64(i) * 7 / pace))
+			if day >= horizonDays || (cfg.MaxEvents > 0 && len(events) >= cfg.MaxEvents) {
+				break
+			}
+
+			start := time.Date(firstDay.Year(), firstDay.Month(), firstDay.Day()+day, cfg.SessionHour, 0, 0, 0, loc).
+				Add(time.Duration(slots[day]) * sessionDuration)
+			slots[day]++
+
+			nodeID := node.ID
+			events = append(events, entities.CalendarEvent{
+				UID:         fmt.Sprintf("%s.%s.%s@%s", userID, item.roadmap.ID.Hex(), node.ID, calendarUIDDomain),
+				Kind:        entities.CalendarEventStudySession,
+				RoadmapID:   item.roadmap.ID,
+				NodeID:      &nodeID,
+				Summary:     fmt.Sprintf("Изучить: %s", node.Data.Label),
+				Description: fmt.Sprintf("Роадмап: %s", item.name),
+				Start:       start.UTC(),
+				End:         start.Add(sessionDuration).UTC(),
+			})
+		}
+	}
+
+	if cfg.MaxEvents > 0 && len(events) > cfg.MaxEvents {
+		events = events[:cfg.MaxEvents]
+	}
+
+	return events
+}
+
+func remainingCalendarNodes(roadmap *entities.Roadmap, progress *entities.UserProgress) []entities.RoadmapNode {
+	var inProgress, pending []entities.RoadmapNode
+	for _, node := range learningOrder(roadmap) {
+		if nodeWeight(node) == 0 {
+			continue
+		}
+
+		switch status := nodeStatus(progress, node.ID); {
+		case status == entities.NodeProgressInProgress:
+			inProgress = append(inProgress, node)
+		case !isNodeFinished(status):
+			pending = append(pending, node)
+		}
+	}
+
+	return append(inProgress, pending...)
+}
+
+// calendarPace returns the number of sessions per week for a roadmap.
+func calendarPace(goal *entities.LearningGoal, remaining, defaultPace int, firstDay time.Time) float64 {
+	pace := float64(defaultPace)
+	if goal != nil {
+		if goal.WeeklyPace > 0 {
+			pace = float64(goal.WeeklyPace)
+		}
+		if left := goal.Deadline.Sub(firstDay); left > 0 {
+			pace = math.Max(pace, float64(remaining)/(float64(left)/float64(week)))
+		}
+	}
+	return math.Max(pace, 1)
+}
+
+func newCalendarToken() (string, error) {
+	buf := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func isValidCalendarToken(token string) bool {
+	if len(token) != base64.RawURLEncoding.EncodedLen(calendarTokenBytes) {
+		return false
+	}
+	_, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil
+}
+
+func (uc *RoadmapUsecase) calendarFeedURL(token string) string {
+	return strings.TrimRight(uc.cfg.Calendar.FeedBaseURL, "/") + "/" + token + ".ics"
+}
+
+func (uc *RoadmapUsecase) calendarLocation() *time.Location {
+	if uc.cfg.Calendar.Timezone != "" {
+		if loc, err := time.LoadLocation(uc.cfg.Calendar.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// refreshCalendarFeed rebuilds the study plan from the roadmaps the user is
+// subscribed to and the roadmaps they have goals for.
+func (uc *RoadmapUsecase) refreshCalendarFeed(ctx context.Context, userID uuid.UUID) ([]entities.CalendarEvent, time.Time, error) {
+	subscribed, err := uc.roadmapInfoClient.GetSubscribedRoadmaps(ctx, &roadmapinfoclient.GetSubscribedRoadmapsRequest{UserId: userID.String()})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get subscribed roadmaps: %w", err)
+	}
+	if subscribed.Error != "" {
+		return nil, time.Time{}, fmt.Errorf("failed to get subscribed roadmaps: %s", subscribed.Error)
+	}
+
+	goals, err := uc.mongoRepo.GetLearningGoalsByUser(ctx, userID)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get learning goals: %w", err)
+	}
+
+	var roadmapIDs []primitive.ObjectID
+	names := make(map[primitive.ObjectID]string)
+	for _, roadmapInfo := range subscribed.RoadmapInfos {
+		roadmapID, err := primitive.ObjectIDFromHex(roadmapInfo.RoadmapId)
+		if err != nil {
+			continue
+		}
+		if _, seen := names[roadmapID]; !seen {
+			roadmapIDs = append(roadmapIDs, roadmapID)
+		}
+		names[roadmapID] = roadmapInfo.Name
+	}
+
+	goalsByRoadmap := make(map[primitive.ObjectID]*entities.LearningGoal, len(goals))
+	for _, goal := range goals {
+		goalsByRoadmap[goal.RoadmapID] = goal
+		if _, seen := names[goal.RoadmapID]; !seen {
+			roadmapIDs = append(roadmapIDs, goal.RoadmapID)
+			names[goal.RoadmapID] = uc.calendarRoadmapName(ctx, goal.RoadmapID)
+		}
+	}
+
+	roadmaps, err := uc.mongoRepo.GetByIDs(ctx, roadmapIDs)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get roadmaps: %w", err)
+	}
+
+	items := make([]calendarPlanItem, 0, len(roadmapIDs))
+	for _, roadmapID := range roadmapIDs {
+		roadmap, ok := roadmaps[roadmapID]
+		if !ok {
+			continue
+		}
+
+		progress, err := uc.mongoRepo.GetUserProgress(ctx, userID, roadmapID)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed to get user progress: %w", err)
+		}
+
+		name := names[roadmapID]
+		if name == "" {
+			name = defaultRoadmapTitle
+		}
+
+		items = append(items, calendarPlanItem{
+			roadmap:  roadmap,
+			name:     name,
+			goal:     goalsByRoadmap[roadmapID],
+			progress: progress,
+		})
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	events := planStudyCalendar(userID, items, uc.cfg.Calendar, uc.calendarLocation(), now)
+
+	if err := uc.mongoRepo.UpdateCalendarFeedEvents(ctx, userID, events, now); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to save calendar feed events: %w", err)
+	}
+
+	return events, now, nil
+}
+
+func (uc *RoadmapUsecase) calendarRoadmapName(ctx context.Context, roadmapID primitive.ObjectID) string {
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex()})
+	if err != nil || roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		ctxutil.GetLogger(ctx).WithError(err).WithField("roadmap_id", roadmapID.Hex()).Warn("failed to get roadmap info")
+		return ""
+	}
+	return roadmapInfo.RoadmapInfo.Name
+}
+
+// syncCalendarFeed regenerates the user's feed after their progress or goals
+// change. Users without a feed are skipped, and failures only get logged, so
+// the change itself is never rejected because of the calendar.
+func (uc *RoadmapUsecase) syncCalendarFeed(ctx context.Context, userID uuid.UUID) {
+	logger := ctxutil.GetLogger(ctx).WithField("user_id", userID)
+
+	feed, err := uc.mongoRepo.GetCalendarFeedByUser(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get calendar feed")
+		return
+	}
+
+	if feed == nil {
+		return
+	}
+
+	if _, _, err := uc.refreshCalendarFeed(ctx, userID); err != nil {
+		logger.WithError(err).Warn("failed to refresh calendar feed")
+	}
+}
+
+func (uc *RoadmapUsecase) GetCalendarFeed(ctx context.Context, userID uuid.UUID) (*dto.CalendarFeedDTO, error) {
+	const op = "RoadmapUsecase.GetCalendarFeed"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	feed, err := uc.mongoRepo.GetCalendarFeedByUser(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get calendar feed")
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	if feed == nil {
+		token, err := newCalendarToken()
+		if err != nil {
+			logger.WithError(err).Error("failed to generate calendar token")
+			return nil, fmt.Errorf("failed to generate calendar token: %w", err)
+		}
+
+		now := time.Now().UTC()
+		feed, err = uc.mongoRepo.CreateCalendarFeed(ctx, &entities.CalendarFeed{
+			UserID:    userID,
+			Token:     token,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			logger.WithError(err).Error("failed to create calendar feed")
+			return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+		}
+	}
+
+	if feed.GeneratedAt == nil {
+		events, generatedAt, err := uc.refreshCalendarFeed(ctx, userID)
+		if err != nil {
+			logger.WithError(err).Warn("failed to generate calendar feed")
+		} else {
+			feed.Events = events
+			feed.GeneratedAt = &generatedAt
+		}
+	}
+
+	response := dto.CalendarFeedToDTO(feed, uc.calendarFeedURL(feed.Token))
+
+	logger.WithField("events", len(feed.Events)).Info("successfully retrieved calendar feed")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) RotateCalendarFeedToken(ctx context.Context, userID uuid.UUID) (*dto.CalendarFeedDTO, error) {
+	const op = "RoadmapUsecase.RotateCalendarFeedToken"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	token, err := newCalendarToken()
+	if err != nil {
+		logger.WithError(err).Error("failed to generate calendar token")
+		return nil, fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+
+	feed, err := uc.mongoRepo.UpdateCalendarFeedToken(ctx, userID, token, time.Now().UTC())
+	if err != nil {
+		logger.WithError(err).Error("failed to rotate calendar token")
+		return nil, fmt.Errorf("failed to rotate calendar token: %w", err)
+	}
+
+	if feed == nil {
+		logger.Warn("calendar feed not found")
+		return nil, errs.ErrNotFound
+	}
+
+	response := dto.CalendarFeedToDTO(feed, uc.calendarFeedURL(feed.Token))
+
+	logger.Info("successfully rotated calendar token")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error {
+	const op = "RoadmapUsecase.DeleteCalendarFeed"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	if err := uc.mongoRepo.DeleteCalendarFeed(ctx, userID); err != nil {
+		logger.WithError(err).Error("failed to delete calendar feed")
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	logger.Info("successfully deleted calendar feed")
+	return nil
+}
+
+// ExportCalendarFeed renders the feed behind a secret token. Feeds that have
+// not been regenerated for a while are refreshed first, which picks up new
+// subscriptions and moves missed sessions forward. When the refresh fails, the
+// last generated plan is served.
+func (uc *RoadmapUsecase) ExportCalendarFeed(ctx context.Context, token string) (*dto.CalendarFeedFileDTO, error) {
+	const op = "RoadmapUsecase.ExportCalendarFeed"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	if !isValidCalendarToken(token) {
+		logger.Warn("malformed calendar token")
+		return nil, errs.ErrNotFound
+	}
+
+	feed, err := uc.mongoRepo.GetCalendarFeedByToken(ctx, token)
+	if err != nil {
+		logger.WithError(err).Error("failed to get calendar feed")
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	if feed == nil {
+		logger.Warn("calendar feed not found")
+		return nil, errs.ErrNotFound
+	}
+
+	logger = logger.WithField("user_id", feed.UserID)
+
+	if feed.GeneratedAt == nil || time.Since(*feed.GeneratedAt) > uc.cfg.Calendar.RefreshAfter {
+		events, generatedAt, err := uc.refreshCalendarFeed(ctx, feed.UserID)
+		if err != nil {
+			logger.WithError(err).Warn("failed to refresh calendar feed, serving the last plan")
+		} else {
+			feed.Events = events
+			feed.GeneratedAt = &generatedAt
+		}
+	}
+
+	modifiedAt := feed.UpdatedAt
+	if feed.GeneratedAt != nil && feed.GeneratedAt.After(modifiedAt) {
+		modifiedAt = *feed.GeneratedAt
+	}
+
+	calendar := ical.Calendar{
+		ProdID:          calendarProdID,
+		Name:            calendarName,
+		RefreshInterval: uc.cfg.Calendar.RefreshAfter,
+		Events:          make([]ical.Event, 0, len(feed.Events)),
+	}
+	for _, event := range feed.Events {
+		calendar.Events = append(calendar.Events, ical.Event{
+			UID:         event.UID,
+			Summary:     event.Summary,
+			Description: event.Description,
+			Start:       event.Start,
+			End:         event.End,
+			AllDay:      event.AllDay,
+			Stamp:       modifiedAt,
+		})
+	}
+
+	logger.WithField("events", len(feed.Events)).Info("successfully exported calendar feed")
+	return &dto.CalendarFeedFileDTO{
+		FileName:   calendarFileName,
+		Content:    ical.Render(calendar),
+		ModifiedAt: modifiedAt,
+	}, nil
+}
@@ -0,0 +1,268 @@
+package roadmap
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/pkg/certificate"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+const roadmapVersionLength = 12
+
+// isRoadmapCompleted reports whether the user finished every tracked node of the
+// roadmap. Secondary nodes may be skipped, primary milestones have to be done.
+func isRoadmapCompleted(roadmap *entities.Roadmap, progress *entities.UserProgress) bool {
+	if progress == nil {
+		return false
+	}
+
+	tracked := 0
+	for _, node := range roadmap.Nodes {
+		if nodeWeight(node) == 0 {
+			continue
+		}
+		tracked++
+
+		status := progress.Progress[node.ID].Status
+		if status == entities.NodeProgressDone {
+			continue
+		}
+		if status == entities.NodeProgressSkipped && node.Data.Type != "primary" {
+			continue
+		}
+		return false
+	}
+
+	return tracked > 0
+}
+
+// roadmapVersion fingerprints the set of tracked nodes, so a certificate names
+// the exact roadmap content it was earned for. Moving nodes around or editing
+// labels keeps the version, adding or removing nodes changes it.
+func roadmapVersion(roadmap *entities.Roadmap) string {
+	ids := make([]string, 0, len(roadmap.Nodes))
+	for _, node := range roadmap.Nodes {
+		if nodeWeight(node) > 0 {
+			ids = append(ids, node.ID.String())
+		}
+	}
+	sort.Strings(ids)
+
+	hash := sha256.New()
+	for _, id := range ids {
+		hash.Write([]byte(id))
+		hash.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:roadmapVersionLength]
+}
+
+func (uc *RoadmapUsecase) IssueCertificate(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.CertificateDTO, error) {
+	const op = "RoadmapUsecase.IssueCertificate"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	userProgress, err := uc.getUserProgress(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user progress")
+		return nil, fmt.Errorf("failed to get user progress: %w", err)
+	}
+
+	if !isRoadmapCompleted(roadmap, userProgress) {
+		logger.Warn("attempt to get certificate for unfinished roadmap")
+		return nil, fmt.Errorf("invalid certificate request: roadmap is not completed")
+	}
+
+	issued, err := uc.issueCertificate(ctx, userID, roadmap)
+	if err != nil {
+		logger.WithError(err).Error("failed to issue certificate")
+		return nil, fmt.Errorf("failed to issue certificate: %w", err)
+	}
+
+	response := dto.CertificateToDTO(issued)
+
+	logger.WithField("certificate_id", issued.ID.String()).Info("successfully issued certificate")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) GetMyCertificates(ctx context.Context, userID uuid.UUID) (*dto.CertificateListResponseDTO, error) {
+	const op = "RoadmapUsecase.GetMyCertificates"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	certificates, err := uc.mongoRepo.GetCertificatesByUser(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get certificates")
+		return nil, fmt.Errorf("failed to get certificates: %w", err)
+	}
+
+	logger.WithField("count", len(certificates)).Info("successfully retrieved certificates")
+	return &dto.CertificateListResponseDTO{
+		Certificates: dto.CertificatesToDTO(certificates),
+	}, nil
+}
+
+// VerifyCertificate checks the stored certificate against its signature. When
+// the caller passes the signature printed on a certificate, it has to match the
+// issued one as well.
+func (uc *RoadmapUsecase) VerifyCertificate(ctx context.Context, certificateID uuid.UUID, signature string) (*dto.CertificateVerificationResponseDTO, error) {
+	const op = "RoadmapUsecase.VerifyCertificate"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":             op,
+		"certificate_id": certificateID,
+	})
+
+	issued, err := uc.mongoRepo.GetCertificateByID(ctx, certificateID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get certificate")
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+
+	if issued == nil {
+		logger.Warn("certificate not found")
+		return nil, errs.ErrNotFound
+	}
+
+	valid := uc.cfg.Certificate.SigningKey != "" &&
+		certificate.Verify([]byte(uc.cfg.Certificate.SigningKey), issued.SigningPayload(), issued.Signature)
+	if valid && signature != "" {
+		valid = hmac.Equal([]byte(signature), []byte(issued.Signature))
+	}
+
+	certificateDTO := dto.CertificateToDTO(issued)
+
+	logger.WithField("valid", valid).Info("certificate verified")
+	return &dto.CertificateVerificationResponseDTO{
+		Valid:       valid,
+		Certificate: &certificateDTO,
+	}, nil
+}
+
+// issueCertificate returns the user's certificate for the current version of the
+// roadmap, creating it together with its image on the first call.
+func (uc *RoadmapUsecase) issueCertificate(ctx context.Context, userID uuid.UUID, roadmap *entities.Roadmap) (*entities.Certificate, error) {
+	if uc.cfg.Certificate.SigningKey == "" {
+		return nil, fmt.Errorf("certificate signing key is not configured")
+	}
+
+	version := roadmapVersion(roadmap)
+
+	existing, err := uc.mongoRepo.GetCertificate(ctx, userID, roadmap.ID, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmap.ID.Hex()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		return nil, errs.ErrNotFound
+	}
+
+	userResp, err := uc.authClient.GetUserByID(ctx, &authclient.GetUserByIDRequest{UserId: userID.String()})
+	if err != nil || userResp == nil || userResp.User == nil {
+		return nil, fmt.Errorf("failed to fetch user data: %w", err)
+	}
+
+	issued := &entities.Certificate{
+		ID:             uuid.New(),
+		UserID:         userID,
+		UserName:       userResp.User.Username,
+		RoadmapID:      roadmap.ID,
+		RoadmapName:    roadmapInfo.RoadmapInfo.Name,
+		RoadmapVersion: version,
+		IssuedAt:       time.Now().UTC().Truncate(time.Second),
+	}
+	issued.Signature = certificate.Sign([]byte(uc.cfg.Certificate.SigningKey), issued.SigningPayload())
+
+	image, err := certificate.RenderPNG(certificate.Content{
+		ID:             issued.ID.String(),
+		UserName:       issued.UserName,
+		RoadmapName:    issued.RoadmapName,
+		RoadmapVersion: issued.RoadmapVersion,
+		IssuedAt:       issued.IssuedAt,
+		Signature:      issued.Signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render certificate: %w", err)
+	}
+
+	bucket := uc.cfg.AWS.CertificateBucketName
+	fileName := fmt.Sprintf("certificate-%s.png", issued.ID)
+
+	uploadInfo, err := uc.awsRepo.PutObject(ctx, entities.UploadInput{
+		File:        bytes.NewReader(image),
+		Name:        fileName,
+		Size:        int64(len(image)),
+		ContentType: certificate.ContentType,
+		BucketName:  bucket,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload certificate: %w", err)
+	}
+
+	issued.URL = uc.generateAWSMinioURL(bucket, uploadInfo.Key)
+	issued.File = &entities.MaterialFile{
+		Bucket:      bucket,
+		Key:         uploadInfo.Key,
+		Name:        fileName,
+		Size:        int64(len(image)),
+		ContentType: certificate.ContentType,
+	}
+
+	stored, err := uc.mongoRepo.CreateCertificate(ctx, issued)
+	if err != nil {
+		uc.removeCertificateFile(ctx, bucket, uploadInfo.Key)
+		return nil, fmt.Errorf("failed to save certificate: %w", err)
+	}
+
+	// A concurrent completion may have issued the certificate first; keep
+	// that one and drop the image rendered here.
+	if stored.ID != issued.ID {
+		uc.removeCertificateFile(ctx, bucket, uploadInfo.Key)
+	}
+
+	return stored, nil
+}
+
+func (uc *RoadmapUsecase) removeCertificateFile(ctx context.Context, bucket, key string) {
+	if err := uc.awsRepo.RemoveObject(ctx, bucket, key); err != nil {
+		ctxutil.GetLogger(ctx).WithError(err).Warn("failed to remove orphaned certificate file")
+	}
+}
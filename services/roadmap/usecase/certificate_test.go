@@ -0,0 +1,104 @@
+package roadmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type certificateRepoStub struct {
+	roadmap.MongoRepository
+
+	stored *entities.Certificate
+}
+
+func (r *certificateRepoStub) GetCertificate(context.Context, uuid.UUID, primitive.ObjectID, string) (*entities.Certificate, error) {
+	// Simulates a concurrent issue that has not been stored yet when checked.
+	return nil, nil
+}
+
+func (r *certificateRepoStub) CreateCertificate(_ context.Context, certificate *entities.Certificate) (*entities.Certificate, error) {
+	if r.stored == nil {
+		r.stored = certificate
+	}
+	return r.stored, nil
+}
+
+type certificateAWSStub struct {
+	uploaded []string
+	removed  []string
+}
+
+func (a *certificateAWSStub) PutObject(_ context.Context, input entities.UploadInput) (*minio.UploadInfo, error) {
+	a.uploaded = append(a.uploaded, input.Name)
+	return &minio.UploadInfo{Key: input.Name}, nil
+}
+
+func (a *certificateAWSStub) RemoveObject(_ context.Context, _ string, fileName string) error {
+	a.removed = append(a.removed, fileName)
+	return nil
+}
+
+type certificateRoadmapInfoStub struct {
+	roadmapinfoclient.RoadmapInfoServiceClient
+}
+
+func (c *certificateRoadmapInfoStub) GetByRoadmapID(context.Context, *roadmapinfoclient.GetByRoadmapIDRequest, ...grpc.CallOption) (*roadmapinfoclient.GetByRoadmapIDResponse, error) {
+	return &roadmapinfoclient.GetByRoadmapIDResponse{RoadmapInfo: &roadmapinfoclient.RoadmapInfo{Name: "Go"}}, nil
+}
+
+type certificateAuthStub struct {
+	authclient.AuthServiceClient
+}
+
+func (c *certificateAuthStub) GetUserByID(context.Context, *authclient.GetUserByIDRequest, ...grpc.CallOption) (*authclient.GetUserByIDResponse, error) {
+	return &authclient.GetUserByIDResponse{User: &authclient.User{Username: "learner"}}, nil
+}
+
+func TestIssueCertificateKeepsConcurrentlyIssuedCertificate(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Certificate.SigningKey = "secret"
+	cfg.AWS.CertificateBucketName = "certificates"
+
+	repo := &certificateRepoStub{}
+	aws := &certificateAWSStub{}
+	uc := &RoadmapUsecase{
+		cfg:               cfg,
+		mongoRepo:         repo,
+		awsRepo:           aws,
+		roadmapInfoClient: &certificateRoadmapInfoStub{},
+		authClient:        &certificateAuthStub{},
+	}
+
+	userID := uuid.New()
+	rm := &entities.Roadmap{
+		ID:    primitive.NewObjectID(),
+		Nodes: []entities.RoadmapNode{{ID: uuid.New(), Type: "custom"}},
+	}
+
+	first, err := uc.issueCertificate(context.Background(), userID, rm)
+	if err != nil {
+		t.Fatalf("first issue returned error: %v", err)
+	}
+	second, err := uc.issueCertificate(context.Background(), userID, rm)
+	if err != nil {
+		t.Fatalf("second issue returned error: %v", err)
+	}
+
+	if second.ID != first.ID {
+		t.Fatalf("got certificate %s, want the stored %s", second.ID, first.ID)
+	}
+	if len(aws.uploaded) != 2 || len(aws.removed) != 1 || aws.removed[0] != aws.uploaded[1] {
+		t.Fatalf("got uploaded %v and removed %v, want the second image removed", aws.uploaded, aws.removed)
+	}
+}
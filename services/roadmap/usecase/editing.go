@@ -0,0 +1,408 @@
+package roadmap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+const (
+	maxEditingLogSize      = 256
+	maxEditingSaveAttempts = 3
+	maxEditOperationIDLen  = 64
+)
+
+// editingSession orders the operations applied to one roadmap and remembers
+// the most recent ones, so a retried operation is answered without being
+// applied twice.
+type editingSession struct {
+	mu      sync.Mutex
+	applied []*dto.AppliedEditOperationDTO
+}
+
+func (s *editingSession) find(userID uuid.UUID, operationID string) *dto.AppliedEditOperationDTO {
+	if operationID == "" {
+		return nil
+	}
+	for i := len(s.applied) - 1; i >= 0; i-- {
+		if s.applied[i].UserID == userID && s.applied[i].Operation.ID == operationID {
+			return s.applied[i]
+		}
+	}
+	return nil
+}
+
+func (s *editingSession) record(applied *dto.AppliedEditOperationDTO) {
+	s.applied = append(s.applied, applied)
+	if len(s.applied) > maxEditingLogSize {
+		s.applied = s.applied[len(s.applied)-maxEditingLogSize:]
+	}
+}
+
+type editingSessions struct {
+	mu       sync.Mutex
+	sessions map[primitive.ObjectID]*editingSession
+}
+
+func newEditingSessions() *editingSessions {
+	return &editingSessions{sessions: make(map[primitive.ObjectID]*editingSession)}
+}
+
+func (s *editingSessions) get(roadmapID primitive.ObjectID) *editingSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[roadmapID]
+	if !ok {
+		session = &editingSession{}
+		s.sessions[roadmapID] = session
+	}
+	return session
+}
+
+func (s *editingSessions) release(roadmapID primitive.ObjectID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, roadmapID)
+}
+
+func (uc *RoadmapUsecase) editingAccess(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*roadmapinfoclient.GetByRoadmapIDResponse, entities.CollaboratorRole, error) {
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		return nil, "", errs.ErrNotFound
+	}
+
+	return roadmapInfo, entities.CollaboratorRole(roadmapInfo.Role), nil
+}
+
+func (uc *RoadmapUsecase) JoinEditing(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.EditingSnapshotDTO, error) {
+	const op = "RoadmapUsecase.JoinEditing"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	roadmapInfo, role, err := uc.editingAccess(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to resolve roadmap access")
+		return nil, err
+	}
+
+	if !roadmapInfo.RoadmapInfo.IsPublic && !role.CanView() {
+		logger.Warn("user has no access to private roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	roadmapEntity, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmapEntity == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	canEdit := !roadmapInfo.RoadmapInfo.IsPublic && role.CanEdit()
+
+	logger.WithField("can_edit", canEdit).Info("user joined roadmap editing")
+	return dto.EntityToEditingSnapshotDTO(roadmapEntity, canEdit), nil
+}
+
+func (uc *RoadmapUsecase) ReleaseEditing(roadmapID primitive.ObjectID) {
+	uc.editing.release(roadmapID)
+}
+
+// ApplyEditOperation applies a single editing operation to the latest saved
+// state of the roadmap. Operations on a roadmap are applied one at a time in
+// the order they arrive, and every applied operation bumps the roadmap
+// version. Concurrent changes to different fields of a node are merged, while
+// changes to the same field resolve to the last writer. An operation that
+// refers to a node or edge removed by an earlier one is rejected, and the
+// client is expected to drop it.
+func (uc *RoadmapUsecase) ApplyEditOperation(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, operation *dto.EditOperationDTO) (*dto.AppliedEditOperationDTO, error) {
+	const op = "RoadmapUsecase.ApplyEditOperation"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	if err := validateEditOperation(operation); err != nil {
+		logger.WithError(err).Warn("invalid edit operation")
+		return nil, err
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"operation_id":   operation.ID,
+		"operation_type": operation.Type,
+	})
+
+	roadmapInfo, role, err := uc.editingAccess(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to resolve roadmap access")
+		return nil, err
+	}
+
+	if roadmapInfo.RoadmapInfo.IsPublic {
+		logger.Warn("attempt to edit public roadmap")
+		return nil, fmt.Errorf("attempt to edit public roadmap")
+	}
+
+	if !role.CanEdit() {
+		logger.Warn("user cannot edit the roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	session := uc.editing.get(roadmapID)
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if applied := session.find(userID, operation.ID); applied != nil {
+		logger.Info("edit operation already applied")
+		return applied, nil
+	}
+
+	for attempt := 0; attempt < maxEditingSaveAttempts; attempt++ {
+		current, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+		if err != nil {
+			logger.WithError(err).Error("failed to get roadmap")
+			return nil, fmt.Errorf("failed to get roadmap: %w", err)
+		}
+
+		if current == nil {
+			logger.Warn("roadmap not found")
+			return nil, errs.ErrNotFound
+		}
+
+		if operation.BaseVersion > current.Version {
+			logger.WithField("version", current.Version).Warn("edit operation is ahead of the roadmap")
+			return nil, fmt.Errorf("invalid operation: base version %d is ahead of roadmap version %d", operation.BaseVersion, current.Version)
+		}
+
+		updated, touched, err := applyEditOperation(current, operation)
+		if err != nil {
+			logger.WithError(err).Warn("edit operation conflicts with roadmap state")
+			return nil, err
+		}
+
+		if touched != nil {
+			if err := uc.moderateRoadmap(ctx, &entities.Roadmap{Nodes: []entities.RoadmapNode{*touched}}); err != nil {
+				logger.WithError(err).Warn("edit operation rejected due to moderation")
+				return nil, fmt.Errorf("moderation check failed: %w", err)
+			}
+		}
+
+		if operation.Type == dto.EditOperationAddEdge {
+			if err := validatePrerequisites(updated); err != nil {
+				logger.WithError(err).Warn("invalid prerequisite edges")
+				return nil, err
+			}
+		}
+
+		attributeEdits(current, updated, userID)
+
+		saved, err := uc.mongoRepo.UpdateIfVersion(ctx, updated, current.Version)
+		if err != nil {
+			logger.WithError(err).Error("failed to save roadmap")
+			return nil, fmt.Errorf("failed to save roadmap: %w", err)
+		}
+
+		if !saved {
+			logger.WithField("attempt", attempt+1).Info("roadmap changed while applying edit operation, retrying")
+			continue
+		}
+
+		applied := &dto.AppliedEditOperationDTO{
+			RoadmapID: roadmapID.Hex(),
+			Version:   updated.Version,
+			UserID:    userID,
+			Operation: *operation,
+			AppliedAt: updated.UpdatedAt,
+		}
+		session.record(applied)
+
+		logger.WithField("version", applied.Version).Info("edit operation applied")
+		return applied, nil
+	}
+
+	logger.Warn("gave up applying edit operation after concurrent changes")
+	return nil, fmt.Errorf("failed to apply operation: roadmap is being modified concurrently")
+}
+
+func validateEditOperation(operation *dto.EditOperationDTO) error {
+	if operation == nil {
+		return fmt.Errorf("invalid operation: operation is required")
+	}
+
+	if len(operation.ID) > maxEditOperationIDLen {
+		return fmt.Errorf("invalid operation: id must be at most %d characters", maxEditOperationIDLen)
+	}
+
+	if !operation.Type.IsValid() {
+		return fmt.Errorf("invalid operation type: %s", operation.Type)
+	}
+
+	if operation.BaseVersion < 0 {
+		return fmt.Errorf("invalid operation: base version must not be negative")
+	}
+
+	switch operation.Type {
+	case dto.EditOperationAddNode:
+		if operation.Node == nil || operation.Node.ID == uuid.Nil {
+			return fmt.Errorf("invalid operation: node with id is required")
+		}
+		if strings.TrimSpace(operation.Node.Data.Label) == "" {
+			return fmt.Errorf("invalid operation: node label is required")
+		}
+	case dto.EditOperationUpdateNode:
+		if operation.NodeID == nil || operation.Changes == nil {
+			return fmt.Errorf("invalid operation: node_id and changes are required")
+		}
+		if operation.Changes.Label != nil && strings.TrimSpace(*operation.Changes.Label) == "" {
+			return fmt.Errorf("invalid operation: node label must not be empty")
+		}
+	case dto.EditOperationMoveNode:
+		if operation.NodeID == nil || operation.Position == nil {
+			return fmt.Errorf("invalid operation: node_id and position are required")
+		}
+	case dto.EditOperationDeleteNode:
+		if operation.NodeID == nil {
+			return fmt.Errorf("invalid operation: node_id is required")
+		}
+	case dto.EditOperationAddEdge:
+		edge := operation.Edge
+		if edge == nil || edge.ID == "" || edge.Source == "" || edge.Target == "" {
+			return fmt.Errorf("invalid operation: edge with id, source and target is required")
+		}
+		if edge.Source == edge.Target {
+			return fmt.Errorf("invalid operation: edge must connect two different nodes")
+		}
+	case dto.EditOperationDeleteEdge:
+		if operation.EdgeID == "" {
+			return fmt.Errorf("invalid operation: edge_id is required")
+		}
+	}
+
+	return nil
+}
+
+// applyEditOperation returns a copy of the roadmap with the operation applied,
+// along with the node whose text changed, if any, for moderation.
+func applyEditOperation(current *entities.Roadmap, operation *dto.EditOperationDTO) (*entities.Roadmap, *entities.RoadmapNode, error) {
+	updated := *current
+	updated.Nodes = append([]entities.RoadmapNode(nil), current.Nodes...)
+	updated.Edges = append([]entities.RoadmapEdge(nil), current.Edges...)
+	updated.UpdatedAt = time.Now()
+
+	nodeIndex := func(id uuid.UUID) int {
+		for i := range updated.Nodes {
+			if updated.Nodes[i].ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var touched *entities.RoadmapNode
+
+	switch operation.Type {
+	case dto.EditOperationAddNode:
+		if nodeIndex(operation.Node.ID) >= 0 {
+			return nil, nil, fmt.Errorf("invalid operation: node %s already exists", operation.Node.ID)
+		}
+		node := dto.DTOToNodes([]dto.NodeDTO{*operation.Node})[0]
+		updated.Nodes = append(updated.Nodes, node)
+		touched = &node
+
+	case dto.EditOperationUpdateNode:
+		i := nodeIndex(*operation.NodeID)
+		if i < 0 {
+			return nil, nil, fmt.Errorf("invalid operation: node %s no longer exists", *operation.NodeID)
+		}
+		changes := operation.Changes
+		if changes.Label != nil {
+			updated.Nodes[i].Data.Label = *changes.Label
+		}
+		if changes.Type != nil {
+			updated.Nodes[i].Data.Type = *changes.Type
+		}
+		if changes.Description != nil {
+			updated.Nodes[i].Description = *changes.Description
+		}
+		node := updated.Nodes[i]
+		touched = &node
+
+	case dto.EditOperationMoveNode:
+		i := nodeIndex(*operation.NodeID)
+		if i < 0 {
+			return nil, nil, fmt.Errorf("invalid operation: node %s no longer exists", *operation.NodeID)
+		}
+		updated.Nodes[i].Position = entities.Position{X: operation.Position.X, Y: operation.Position.Y}
+
+	case dto.EditOperationDeleteNode:
+		i := nodeIndex(*operation.NodeID)
+		if i < 0 {
+			return nil, nil, fmt.Errorf("invalid operation: node %s no longer exists", *operation.NodeID)
+		}
+		updated.Nodes = append(updated.Nodes[:i], updated.Nodes[i+1:]...)
+
+		nodeID := operation.NodeID.String()
+		edges := updated.Edges[:0]
+		for _, edge := range updated.Edges {
+			if edge.Source != nodeID && edge.Target != nodeID {
+				edges = append(edges, edge)
+			}
+		}
+		updated.Edges = edges
+
+	case dto.EditOperationAddEdge:
+		edge := operation.Edge
+		for _, existing := range updated.Edges {
+			if existing.ID == edge.ID {
+				return nil, nil, fmt.Errorf("invalid operation: edge %s already exists", edge.ID)
+			}
+		}
+		for _, endpoint := range []string{edge.Source, edge.Target} {
+			id, err := uuid.Parse(endpoint)
+			if err != nil || nodeIndex(id) < 0 {
+				return nil, nil, fmt.Errorf("invalid operation: node %s no longer exists", endpoint)
+			}
+		}
+		updated.Edges = append(updated.Edges, dto.DTOToEdges([]dto.EdgeDTO{*edge})...)
+
+	case dto.EditOperationDeleteEdge:
+		removed := false
+		edges := updated.Edges[:0]
+		for _, edge := range updated.Edges {
+			if edge.ID == operation.EdgeID {
+				removed = true
+				continue
+			}
+			edges = append(edges, edge)
+		}
+		if !removed {
+			return nil, nil, fmt.Errorf("invalid operation: edge %s no longer exists", operation.EdgeID)
+		}
+		updated.Edges = edges
+	}
+
+	return &updated, touched, nil
+}
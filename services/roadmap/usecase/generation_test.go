@@ -0,0 +1,185 @@
+package roadmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/llmclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/internal/metrics"
+	"github.com/F0urward/proftwist-backend/services/prompt"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+	"github.com/F0urward/proftwist-backend/services/roadmap/repository"
+)
+
+type generationRepoStub struct {
+	roadmap.MongoRepository
+
+	roadmap *entities.Roadmap
+	saves   int
+}
+
+func (r *generationRepoStub) GetByID(context.Context, primitive.ObjectID) (*entities.Roadmap, error) {
+	stored := *r.roadmap
+	return &stored, nil
+}
+
+func (r *generationRepoStub) UpdateIfVersion(_ context.Context, rm *entities.Roadmap, version int64) (bool, error) {
+	if version != r.roadmap.Version {
+		return false, nil
+	}
+	rm.Version = version + 1
+	r.roadmap = rm
+	r.saves++
+	return true, nil
+}
+
+func (r *generationRepoStub) RemapNodeProgress(context.Context, primitive.ObjectID, map[uuid.UUID]uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func (r *generationRepoStub) GetRoadmapLineage(context.Context, primitive.ObjectID) (*entities.RoadmapLineage, error) {
+	return nil, nil
+}
+
+type generationRoadmapInfoStub struct {
+	roadmapinfoclient.RoadmapInfoServiceClient
+}
+
+func (c *generationRoadmapInfoStub) GetByRoadmapID(context.Context, *roadmapinfoclient.GetByRoadmapIDRequest, ...grpc.CallOption) (*roadmapinfoclient.GetByRoadmapIDResponse, error) {
+	return &roadmapinfoclient.GetByRoadmapIDResponse{
+		RoadmapInfo: &roadmapinfoclient.RoadmapInfo{
+			Name:        "Go",
+			Description: "Бэкенд на Go",
+			Author:      &roadmapinfoclient.Author{},
+		},
+		Role: string(entities.CollaboratorRoleOwner),
+	}, nil
+}
+
+type generationModerationStub struct {
+	moderationclient.ModerationServiceClient
+}
+
+func (c *generationModerationStub) ModerateContent(context.Context, *moderationclient.ModerateContentRequest, ...grpc.CallOption) (*moderationclient.ModerateContentResponse, error) {
+	return &moderationclient.ModerateContentResponse{Result: &moderationclient.ModerationResult{Allowed: true}}, nil
+}
+
+type generationPromptStub struct {
+	prompt.Usecase
+
+	outcomes []string
+}
+
+func (p *generationPromptStub) Resolve(context.Context, string, string) (*entities.PromptTemplate, error) {
+	return nil, nil
+}
+
+func (p *generationPromptStub) RecordUsage(_ context.Context, _ entities.PromptUsage, _ string, outcome string) {
+	p.outcomes = append(p.outcomes, outcome)
+}
+
+type generationMetricsStub struct {
+	metrics.Metrics
+}
+
+func (m *generationMetricsStub) IncLLMOutputParse(string, string) {}
+
+func (m *generationMetricsStub) AddLLMOutputRepairs(string, string, int) {}
+
+func newFakeGenerationUsecase(repo *generationRepoStub) (*RoadmapUsecase, *llmclient.FakeProvider, *generationPromptStub) {
+	provider := llmclient.NewFakeProvider(llmclient.DefaultFakeScript())
+	prompts := &generationPromptStub{}
+
+	uc := NewRoadmapUsecase(
+		&config.Config{},
+		repo,
+		nil,
+		repository.NewRoadmapGigaChatWebapi(provider, &generationMetricsStub{}),
+		nil,
+		&generationRoadmapInfoStub{},
+		nil,
+		nil,
+		&generationModerationStub{},
+		prompts,
+		nil,
+	).(*RoadmapUsecase)
+
+	return uc, provider, prompts
+}
+
+func TestGenerateWithFakeProvider(t *testing.T) {
+	repo := &generationRepoStub{roadmap: &entities.Roadmap{ID: primitive.NewObjectID(), Version: 3}}
+	uc, provider, prompts := newFakeGenerationUsecase(repo)
+
+	resp, err := uc.Generate(context.Background(), uuid.New(), repo.roadmap.ID, &dto.GenerateRoadmapRequestDTO{Complexity: "beginner"})
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	if resp.RoadmapID != repo.roadmap.ID || repo.saves != 1 {
+		t.Fatalf("generated roadmap was not saved: %+v", resp)
+	}
+	if len(repo.roadmap.Nodes) != 4 || len(repo.roadmap.Edges) != 3 {
+		t.Fatalf("got %d nodes and %d edges, want the fake roadmap", len(repo.roadmap.Nodes), len(repo.roadmap.Edges))
+	}
+	if repo.roadmap.Version != 4 {
+		t.Fatalf("got version %d, want 4", repo.roadmap.Version)
+	}
+	if len(prompts.outcomes) != 1 || prompts.outcomes[0] != entities.PromptOutcomeGenerated {
+		t.Fatalf("got prompt outcomes %v", prompts.outcomes)
+	}
+
+	calls := provider.Calls()
+	if len(calls) != 1 || calls[0].Feature != llmclient.FeatureRoadmapGeneration {
+		t.Fatalf("got provider calls %+v, want one generation request", calls)
+	}
+}
+
+func TestRegenerateNodeWithFakeProvider(t *testing.T) {
+	root := entities.RoadmapNode{ID: uuid.New(), Type: "custom", Data: entities.NodeData{Label: "Go", Type: "root"}}
+	repo := &generationRepoStub{roadmap: &entities.Roadmap{ID: primitive.NewObjectID(), Nodes: []entities.RoadmapNode{root}}}
+	uc, provider, _ := newFakeGenerationUsecase(repo)
+
+	resp, err := uc.RegenerateNode(context.Background(), uuid.New(), repo.roadmap.ID, root.ID, &dto.RegenerateNodeRequestDTO{Mode: dto.RegenerationModeExpand})
+	if err != nil {
+		t.Fatalf("RegenerateNode returned error: %v", err)
+	}
+
+	if resp.AddedNodes != 4 || len(repo.roadmap.Nodes) != 5 {
+		t.Fatalf("got %d added nodes and %d in total, want the fake subtree grafted under the root", resp.AddedNodes, len(repo.roadmap.Nodes))
+	}
+
+	calls := provider.Calls()
+	if len(calls) != 1 || calls[0].Feature != llmclient.FeatureRoadmapRegeneration {
+		t.Fatalf("got provider calls %+v, want one regeneration request", calls)
+	}
+}
+
+func TestGenerateNodeQuizWithFakeProvider(t *testing.T) {
+	node := entities.RoadmapNode{ID: uuid.New(), Type: "custom", Data: entities.NodeData{Label: "Основы", Type: "primary"}}
+	repo := &generationRepoStub{roadmap: &entities.Roadmap{ID: primitive.NewObjectID(), Nodes: []entities.RoadmapNode{node}}}
+	uc, provider, _ := newFakeGenerationUsecase(repo)
+
+	resp, err := uc.GenerateNodeQuiz(context.Background(), uuid.New(), repo.roadmap.ID, node.ID, &dto.GenerateNodeQuizRequestDTO{})
+	if err != nil {
+		t.Fatalf("GenerateNodeQuiz returned error: %v", err)
+	}
+
+	if len(resp.Questions) != 3 {
+		t.Fatalf("got %d questions, want the fake quiz", len(resp.Questions))
+	}
+
+	calls := provider.Calls()
+	if len(calls) != 1 || calls[0].Feature != llmclient.FeatureNodeQuiz {
+		t.Fatalf("got provider calls %+v, want one quiz request", calls)
+	}
+}
@@ -0,0 +1,290 @@
+package roadmap
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+const (
+	week           = 7 * 24 * time.Hour
+	goalPaceWindow = 4 * week
+	maxGoalHorizon = 5 * 365 * 24 * time.Hour
+	maxWeeklyPace  = 100
+)
+
+// projectLearningGoal compares the goal with the user's progress. The actual
+// pace is the number of nodes finished during the pace window, and the
+// projected completion assumes the user keeps that pace. A goal is behind when
+// the actual pace is below both the pace needed to meet the deadline and the
+// pace the user planned.
+func projectLearningGoal(goal *entities.LearningGoal, summary *entities.ProgressSummary, recentlyFinished int, now time.Time) entities.LearningGoalProjection {
+	finished := summary.DoneCount + summary.SkippedCount
+	remaining := summary.TotalNodes - finished
+
+	projection := entities.LearningGoalProjection{
+		TotalNodes:     summary.TotalNodes,
+		FinishedNodes:  finished,
+		RemainingNodes: remaining,
+	}
+
+	actualPace := float64(recentlyFinished) / (float64(goalPaceWindow) / float64(week))
+	projection.ActualPace = roundPace(actualPace)
+
+	if remaining <= 0 {
+		projection.RemainingNodes = 0
+		projection.Status = entities.LearningGoalCompleted
+		return projection
+	}
+
+	if actualPace > 0 {
+		projectedAt := now.Add(time.Duration(float64(remaining) / actualPace * float64(week))).Truncate(time.Second)
+		projection.ProjectedCompletionAt = &projectedAt
+	}
+
+	if !now.Before(goal.Deadline) {
+		projection.Status = entities.LearningGoalOverdue
+		return projection
+	}
+
+	requiredPace := float64(remaining) / (float64(goal.Deadline.Sub(now)) / float64(week))
+	projection.RequiredPace = roundPace(requiredPace)
+
+	targetPace := math.Max(requiredPace, float64(goal.WeeklyPace))
+	if actualPace < targetPace {
+		projection.Status = entities.LearningGoalBehind
+	} else {
+		projection.Status = entities.LearningGoalOnTrack
+	}
+
+	return projection
+}
+
+func roundPace(pace float64) float64 {
+	return math.Round(pace*10) / 10
+}
+
+// loadLearningGoalProjection reads the user's progress and recent completions
+// and projects the goal on top of them.
+func loadLearningGoalProjection(ctx context.Context, mongoRepo roadmap.MongoRepository, goal *entities.LearningGoal, roadmap *entities.Roadmap, now time.Time) (entities.LearningGoalProjection, error) {
+	progress, err := mongoRepo.GetUserProgress(ctx, goal.UserID, goal.RoadmapID)
+	if err != nil {
+		return entities.LearningGoalProjection{}, fmt.Errorf("failed to get user progress: %w", err)
+	}
+
+	recentlyFinished, err := mongoRepo.CountCompletedNodesSince(ctx, goal.UserID, goal.RoadmapID, now.Add(-goalPaceWindow))
+	if err != nil {
+		return entities.LearningGoalProjection{}, fmt.Errorf("failed to count completed nodes: %w", err)
+	}
+
+	return projectLearningGoal(goal, summarizeProgress(roadmap, progress), recentlyFinished, now), nil
+}
+
+func validateLearningGoal(req *dto.UpsertLearningGoalRequestDTO, now time.Time) error {
+	if req.Deadline.IsZero() {
+		return fmt.Errorf("invalid goal: deadline is required")
+	}
+
+	if !req.Deadline.After(now) {
+		return fmt.Errorf("invalid goal: deadline must be in the future")
+	}
+
+	if req.Deadline.After(now.Add(maxGoalHorizon)) {
+		return fmt.Errorf("invalid goal: deadline is too far in the future")
+	}
+
+	if req.WeeklyPace < 0 || req.WeeklyPace > maxWeeklyPace {
+		return fmt.Errorf("invalid goal: weekly pace must be between 0 and %d", maxWeeklyPace)
+	}
+
+	return nil
+}
+
+func (uc *RoadmapUsecase) UpsertLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, req *dto.UpsertLearningGoalRequestDTO) (*dto.LearningGoalDTO, error) {
+	const op = "RoadmapUsecase.UpsertLearningGoal"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	now := time.Now().UTC()
+
+	if err := validateLearningGoal(req, now); err != nil {
+		logger.WithError(err).Warn("invalid learning goal")
+		return nil, err
+	}
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	remindersEnabled := true
+	if req.RemindersEnabled != nil {
+		remindersEnabled = *req.RemindersEnabled
+	}
+
+	goal, err := uc.mongoRepo.UpsertLearningGoal(ctx, &entities.LearningGoal{
+		UserID:           userID,
+		RoadmapID:        roadmapID,
+		Deadline:         req.Deadline.UTC().Truncate(time.Second),
+		WeeklyPace:       req.WeeklyPace,
+		RemindersEnabled: remindersEnabled,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to save learning goal")
+		return nil, fmt.Errorf("failed to save learning goal: %w", err)
+	}
+
+	projection, err := loadLearningGoalProjection(ctx, uc.mongoRepo, goal, roadmap, now)
+	if err != nil {
+		logger.WithError(err).Error("failed to project learning goal")
+		return nil, fmt.Errorf("failed to project learning goal: %w", err)
+	}
+
+	uc.syncCalendarFeed(ctx, userID)
+
+	response := dto.LearningGoalToDTO(goal, projection)
+
+	logger.WithField("status", projection.Status).Info("successfully saved learning goal")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) GetLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.LearningGoalDTO, error) {
+	const op = "RoadmapUsecase.GetLearningGoal"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	goal, err := uc.mongoRepo.GetLearningGoal(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goal")
+		return nil, fmt.Errorf("failed to get learning goal: %w", err)
+	}
+
+	if goal == nil {
+		logger.Warn("learning goal not found")
+		return nil, errs.ErrNotFound
+	}
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	projection, err := loadLearningGoalProjection(ctx, uc.mongoRepo, goal, roadmap, time.Now().UTC())
+	if err != nil {
+		logger.WithError(err).Error("failed to project learning goal")
+		return nil, fmt.Errorf("failed to project learning goal: %w", err)
+	}
+
+	response := dto.LearningGoalToDTO(goal, projection)
+
+	logger.WithField("status", projection.Status).Info("successfully retrieved learning goal")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) DeleteLearningGoal(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) error {
+	const op = "RoadmapUsecase.DeleteLearningGoal"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	if err := uc.mongoRepo.DeleteLearningGoal(ctx, userID, roadmapID); err != nil {
+		logger.WithError(err).Error("failed to delete learning goal")
+		return fmt.Errorf("failed to delete learning goal: %w", err)
+	}
+
+	uc.syncCalendarFeed(ctx, userID)
+
+	logger.Info("successfully deleted learning goal")
+	return nil
+}
+
+func (uc *RoadmapUsecase) GetMyLearningGoals(ctx context.Context, userID uuid.UUID) (*dto.LearningGoalListResponseDTO, error) {
+	const op = "RoadmapUsecase.GetMyLearningGoals"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+	})
+
+	goals, err := uc.mongoRepo.GetLearningGoalsByUser(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goals")
+		return nil, fmt.Errorf("failed to get learning goals: %w", err)
+	}
+
+	roadmapIDs := make([]primitive.ObjectID, 0, len(goals))
+	for _, goal := range goals {
+		roadmapIDs = append(roadmapIDs, goal.RoadmapID)
+	}
+
+	roadmaps, err := uc.mongoRepo.GetByIDs(ctx, roadmapIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmaps")
+		return nil, fmt.Errorf("failed to get roadmaps: %w", err)
+	}
+
+	now := time.Now().UTC()
+	items := make([]dto.LearningGoalDTO, 0, len(goals))
+	for _, goal := range goals {
+		roadmap, ok := roadmaps[goal.RoadmapID]
+		if !ok {
+			logger.WithField("roadmap_id", goal.RoadmapID.Hex()).Debug("skipping goal of deleted roadmap")
+			continue
+		}
+
+		projection, err := loadLearningGoalProjection(ctx, uc.mongoRepo, goal, roadmap, now)
+		if err != nil {
+			logger.WithError(err).Error("failed to project learning goal")
+			return nil, fmt.Errorf("failed to project learning goal: %w", err)
+		}
+
+		item := dto.LearningGoalToDTO(goal, projection)
+
+		roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: goal.RoadmapID.Hex()})
+		if err != nil {
+			logger.WithError(err).WithField("roadmap_id", goal.RoadmapID.Hex()).Warn("failed to get roadmap info")
+		} else if roadmapInfo != nil && roadmapInfo.RoadmapInfo != nil {
+			item.RoadmapInfoID = roadmapInfo.RoadmapInfo.Id
+			item.Name = roadmapInfo.RoadmapInfo.Name
+		}
+
+		items = append(items, item)
+	}
+
+	logger.WithField("count", len(items)).Info("successfully retrieved learning goals")
+	return &dto.LearningGoalListResponseDTO{
+		Goals: items,
+	}, nil
+}
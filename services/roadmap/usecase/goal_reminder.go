@@ -0,0 +1,141 @@
+package roadmap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type GoalReminderUsecase struct {
+	cfg                   config.GoalReminderConfig
+	mongoRepo             roadmap.MongoRepository
+	roadmapInfoClient     roadmapinfoclient.RoadmapInfoServiceClient
+	notificationPublisher roadmap.NotificationPublisher
+}
+
+func NewGoalReminderUsecase(
+	cfg *config.Config,
+	mongoRepo roadmap.MongoRepository,
+	roadmapInfoClient roadmapinfoclient.RoadmapInfoServiceClient,
+	notificationPublisher roadmap.NotificationPublisher,
+) roadmap.GoalReminderUsecase {
+	return &GoalReminderUsecase{
+		cfg:                   cfg.Workers.GoalReminder,
+		mongoRepo:             mongoRepo,
+		roadmapInfoClient:     roadmapInfoClient,
+		notificationPublisher: notificationPublisher,
+	}
+}
+
+// needsReminder reports whether the user should hear about the goal. Goals
+// that fall behind are reminded about on every check, an overdue goal only
+// once after its deadline has passed.
+func needsReminder(goal *entities.LearningGoal, projection entities.LearningGoalProjection) bool {
+	switch projection.Status {
+	case entities.LearningGoalBehind:
+		return true
+	case entities.LearningGoalOverdue:
+		return goal.LastRemindedAt == nil || goal.LastRemindedAt.Before(goal.Deadline)
+	default:
+		return false
+	}
+}
+
+// SendGoalReminders checks the goals that are due for a check and publishes a
+// reminder for those the user is falling behind on. Every goal is claimed
+// before it is checked, so running several workers does not send duplicates.
+func (uc *GoalReminderUsecase) SendGoalReminders(ctx context.Context) error {
+	const op = "GoalReminderUsecase.SendGoalReminders"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	now := time.Now().UTC()
+
+	goals, err := uc.mongoRepo.GetLearningGoalsForCheck(ctx, now.Add(-uc.cfg.RemindEvery), now.Add(-uc.cfg.GracePeriod), uc.cfg.BatchSize)
+	if err != nil {
+		logger.WithError(err).Error("failed to get learning goals for check")
+		return fmt.Errorf("failed to get learning goals for check: %w", err)
+	}
+
+	roadmaps := make(map[primitive.ObjectID]*entities.Roadmap)
+	checked, reminded := 0, 0
+	for _, goal := range goals {
+		if ctx.Err() != nil {
+			break
+		}
+
+		goalLogger := logger.WithFields(map[string]interface{}{
+			"goal_id":    goal.ID.Hex(),
+			"user_id":    goal.UserID,
+			"roadmap_id": goal.RoadmapID.Hex(),
+		})
+
+		claimed, err := uc.mongoRepo.ClaimLearningGoalCheck(ctx, goal.ID, goal.CheckedAt, now)
+		if err != nil {
+			goalLogger.WithError(err).Warn("failed to claim learning goal")
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		checked++
+
+		roadmap, ok := roadmaps[goal.RoadmapID]
+		if !ok {
+			roadmap, err = uc.mongoRepo.GetByID(ctx, goal.RoadmapID)
+			if err != nil {
+				goalLogger.WithError(err).Warn("failed to get roadmap")
+				continue
+			}
+			roadmaps[goal.RoadmapID] = roadmap
+		}
+		if roadmap == nil {
+			goalLogger.Debug("skipping goal of deleted roadmap")
+			continue
+		}
+
+		projection, err := loadLearningGoalProjection(ctx, uc.mongoRepo, goal, roadmap, now)
+		if err != nil {
+			goalLogger.WithError(err).Warn("failed to project learning goal")
+			continue
+		}
+
+		if !needsReminder(goal, projection) {
+			continue
+		}
+
+		if err := uc.notificationPublisher.NotifyGoalReminder(ctx, goal, uc.roadmapName(ctx, goal.RoadmapID), projection); err != nil {
+			goalLogger.WithError(err).Warn("failed to publish goal reminder")
+			continue
+		}
+
+		if err := uc.mongoRepo.MarkLearningGoalReminded(ctx, goal.ID, now); err != nil {
+			goalLogger.WithError(err).Warn("failed to mark learning goal reminded")
+		}
+		reminded++
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"candidates": len(goals),
+		"checked":    checked,
+		"reminded":   reminded,
+	}).Info("finished learning goal check")
+
+	return nil
+}
+
+func (uc *GoalReminderUsecase) roadmapName(ctx context.Context, roadmapID primitive.ObjectID) string {
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex()})
+	if err != nil || roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		ctxutil.GetLogger(ctx).WithError(err).WithField("roadmap_id", roadmapID.Hex()).Warn("failed to get roadmap info")
+		return ""
+	}
+	return roadmapInfo.RoadmapInfo.Name
+}
@@ -0,0 +1,367 @@
+package roadmap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+func (uc *RoadmapUsecase) RecordRoadmapLineage(ctx context.Context, sourceRoadmapID, roadmapID primitive.ObjectID, kind entities.RoadmapLineageKind, nodeIDMap map[uuid.UUID]uuid.UUID) error {
+	const op = "RoadmapUsecase.RecordRoadmapLineage"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":                op,
+		"roadmap_id":        roadmapID.Hex(),
+		"source_roadmap_id": sourceRoadmapID.Hex(),
+		"kind":              kind,
+	})
+
+	if !kind.IsValid() {
+		logger.Warn("invalid lineage kind")
+		return fmt.Errorf("invalid lineage kind: %q", kind)
+	}
+
+	if sourceRoadmapID.IsZero() || roadmapID.IsZero() {
+		logger.Warn("lineage without roadmap IDs")
+		return fmt.Errorf("invalid lineage: source and target roadmap IDs are required")
+	}
+
+	// A retried fork or publish regenerates node IDs again. Once the copy
+	// exists it keeps the IDs of the first attempt, and so must its lineage.
+	existing, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get target roadmap")
+		return fmt.Errorf("failed to get target roadmap: %w", err)
+	}
+
+	if existing != nil {
+		logger.Info("target roadmap already exists, keeping its lineage")
+		return nil
+	}
+
+	err = uc.mongoRepo.SaveRoadmapLineage(ctx, &entities.RoadmapLineage{
+		RoadmapID:       roadmapID,
+		SourceRoadmapID: sourceRoadmapID,
+		Kind:            kind,
+		NodeIDMap:       nodeIDMap,
+		CreatedAt:       time.Now().UTC(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to save roadmap lineage")
+		return fmt.Errorf("failed to save roadmap lineage: %w", err)
+	}
+
+	logger.WithField("nodes", len(nodeIDMap)).Info("successfully recorded roadmap lineage")
+	return nil
+}
+
+// MigrateProgress copies the user's progress from the roadmap this one was
+// forked or published from. Nodes the user already has progress on in this
+// roadmap are left as they are, so the migration can safely be repeated.
+func (uc *RoadmapUsecase) MigrateProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.MigrateProgressResponseDTO, error) {
+	const op = "RoadmapUsecase.MigrateProgress"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	lineage, err := uc.mongoRepo.GetRoadmapLineage(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap lineage")
+		return nil, fmt.Errorf("failed to get roadmap lineage: %w", err)
+	}
+
+	if lineage == nil {
+		logger.Warn("roadmap has no lineage")
+		return nil, errs.ErrNotFound
+	}
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	sourceProgress, err := uc.getUserProgress(ctx, userID, lineage.SourceRoadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get source progress")
+		return nil, fmt.Errorf("failed to get source progress: %w", err)
+	}
+
+	targetProgress, err := uc.getUserProgress(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user progress")
+		return nil, fmt.Errorf("failed to get user progress: %w", err)
+	}
+
+	migrated := 0
+	for sourceNodeID, nodeProgress := range sourceProgress.Progress {
+		nodeID, ok := lineage.NodeIDMap[sourceNodeID]
+		if !ok || !roadmapHasNode(roadmap, nodeID) || nodeProgress.IsEmpty() {
+			continue
+		}
+
+		if _, exists := targetProgress.Progress[nodeID]; exists {
+			continue
+		}
+
+		if err := uc.mongoRepo.UpsertUserProgress(ctx, userID, roadmapID, nodeID, nodeProgress); err != nil {
+			logger.WithError(err).Error("failed to migrate node progress")
+			return nil, fmt.Errorf("failed to migrate node progress: %w", err)
+		}
+		migrated++
+	}
+
+	if migrated > 0 {
+		uc.syncCalendarFeed(ctx, userID)
+	}
+
+	logger.WithField("migrated_nodes", migrated).Info("successfully migrated progress")
+	return &dto.MigrateProgressResponseDTO{
+		SourceRoadmapID: lineage.SourceRoadmapID.Hex(),
+		Kind:            lineage.Kind,
+		MigratedNodes:   migrated,
+	}, nil
+}
+
+// ApplyDraft replaces the content of a published roadmap with its draft.
+// Draft nodes copied from the roadmap get their original IDs back, so
+// progress, chats and subscriptions on unchanged nodes stay where they are;
+// nodes added in the draft keep their IDs. Chats of the added nodes are
+// created by the release saga that calls this.
+func (uc *RoadmapUsecase) ApplyDraft(ctx context.Context, userID uuid.UUID, draftRoadmapID, roadmapID primitive.ObjectID) (int, int, error) {
+	const op = "RoadmapUsecase.ApplyDraft"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":               op,
+		"user_id":          userID,
+		"roadmap_id":       roadmapID.Hex(),
+		"draft_roadmap_id": draftRoadmapID.Hex(),
+	})
+
+	lineage, err := uc.mongoRepo.GetRoadmapLineage(ctx, draftRoadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get draft lineage")
+		return 0, 0, fmt.Errorf("failed to get draft lineage: %w", err)
+	}
+
+	if lineage == nil || lineage.Kind != entities.RoadmapLineageDraft || lineage.SourceRoadmapID != roadmapID {
+		logger.Warn("roadmap is not a draft of the target roadmap")
+		return 0, 0, fmt.Errorf("invalid draft: roadmap is not a draft of this roadmap")
+	}
+
+	draft, err := uc.mongoRepo.GetByID(ctx, draftRoadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get draft roadmap")
+		return 0, 0, fmt.Errorf("failed to get draft roadmap: %w", err)
+	}
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return 0, 0, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if draft == nil || roadmap == nil {
+		logger.Warn("draft or roadmap not found")
+		return 0, 0, errs.ErrNotFound
+	}
+
+	originalIDs := make(map[uuid.UUID]uuid.UUID, len(lineage.NodeIDMap))
+	for sourceNodeID, draftNodeID := range lineage.NodeIDMap {
+		if roadmapHasNode(roadmap, sourceNodeID) {
+			originalIDs[draftNodeID] = sourceNodeID
+		}
+	}
+
+	released := *roadmap
+	released.Nodes = make([]entities.RoadmapNode, 0, len(draft.Nodes))
+	released.Edges = make([]entities.RoadmapEdge, 0, len(draft.Edges))
+	released.EnforcePrerequisites = draft.EnforcePrerequisites
+	released.UpdatedAt = time.Now()
+
+	var addedNodes []entities.RoadmapNode
+	for _, node := range draft.Nodes {
+		if originalID, ok := originalIDs[node.ID]; ok {
+			node.ID = originalID
+		} else if !roadmapHasNode(roadmap, node.ID) {
+			addedNodes = append(addedNodes, node)
+		}
+		released.Nodes = append(released.Nodes, node)
+	}
+
+	for _, edge := range draft.Edges {
+		if id, err := uuid.Parse(edge.Source); err == nil {
+			if originalID, ok := originalIDs[id]; ok {
+				edge.Source = originalID.String()
+			}
+		}
+		if id, err := uuid.Parse(edge.Target); err == nil {
+			if originalID, ok := originalIDs[id]; ok {
+				edge.Target = originalID.String()
+			}
+		}
+		released.Edges = append(released.Edges, edge)
+	}
+
+	removedNodes := 0
+	for _, node := range roadmap.Nodes {
+		if !roadmapHasNode(&released, node.ID) {
+			removedNodes++
+		}
+	}
+
+	saved, err := uc.mongoRepo.UpdateIfVersion(ctx, &released, roadmap.Version)
+	if err != nil {
+		logger.WithError(err).Error("failed to apply draft")
+		return 0, 0, fmt.Errorf("failed to apply draft: %w", err)
+	}
+
+	if !saved {
+		logger.Warn("roadmap was modified concurrently")
+		return 0, 0, fmt.Errorf("attempt to update roadmap that was modified concurrently")
+	}
+
+	uc.remapRestructuredProgress(ctx, roadmap, &released, nil)
+
+	logger.WithFields(map[string]interface{}{
+		"added_nodes":   len(addedNodes),
+		"removed_nodes": removedNodes,
+	}).Info("successfully applied draft")
+	return len(addedNodes), removedNodes, nil
+}
+
+// matchRestructuredNodes pairs nodes that disappeared in a restructure with
+// the nodes that replaced them. Pairs given by the author win; the remaining
+// nodes are paired when exactly one removed and one added node share a label.
+func matchRestructuredNodes(before, after *entities.Roadmap, explicit map[uuid.UUID]uuid.UUID) map[uuid.UUID]uuid.UUID {
+	kept := make(map[uuid.UUID]bool, len(after.Nodes))
+	for _, node := range after.Nodes {
+		kept[node.ID] = true
+	}
+
+	removed := make(map[uuid.UUID]entities.RoadmapNode)
+	for _, node := range before.Nodes {
+		if !kept[node.ID] {
+			removed[node.ID] = node
+		}
+	}
+
+	existed := make(map[uuid.UUID]bool, len(before.Nodes))
+	for _, node := range before.Nodes {
+		existed[node.ID] = true
+	}
+
+	added := make(map[uuid.UUID]entities.RoadmapNode)
+	for _, node := range after.Nodes {
+		if !existed[node.ID] {
+			added[node.ID] = node
+		}
+	}
+
+	mapping := make(map[uuid.UUID]uuid.UUID)
+	for oldID, newID := range explicit {
+		if _, ok := removed[oldID]; !ok {
+			continue
+		}
+		if _, ok := added[newID]; !ok {
+			continue
+		}
+		mapping[oldID] = newID
+		delete(removed, oldID)
+		delete(added, newID)
+	}
+
+	removedByLabel := make(map[string][]uuid.UUID)
+	for id, node := range removed {
+		if label := normalizeNodeLabel(node.Data.Label); label != "" {
+			removedByLabel[label] = append(removedByLabel[label], id)
+		}
+	}
+
+	addedByLabel := make(map[string][]uuid.UUID)
+	for id, node := range added {
+		if label := normalizeNodeLabel(node.Data.Label); label != "" {
+			addedByLabel[label] = append(addedByLabel[label], id)
+		}
+	}
+
+	for label, oldIDs := range removedByLabel {
+		newIDs := addedByLabel[label]
+		if len(oldIDs) == 1 && len(newIDs) == 1 {
+			mapping[oldIDs[0]] = newIDs[0]
+		}
+	}
+
+	return mapping
+}
+
+func normalizeNodeLabel(label string) string {
+	return strings.ToLower(strings.Join(strings.Fields(label), " "))
+}
+
+// remapRestructuredProgress carries progress over to the nodes that replaced
+// removed ones, and keeps the roadmap's lineage pointing at the new nodes.
+// Failures are logged; the restructure itself has already been saved.
+func (uc *RoadmapUsecase) remapRestructuredProgress(ctx context.Context, before, after *entities.Roadmap, explicit map[uuid.UUID]uuid.UUID) {
+	logger := ctxutil.GetLogger(ctx).WithField("roadmap_id", after.ID.Hex())
+
+	mapping := matchRestructuredNodes(before, after, explicit)
+	if len(mapping) == 0 {
+		return
+	}
+
+	users, err := uc.mongoRepo.RemapNodeProgress(ctx, after.ID, mapping)
+	if err != nil {
+		logger.WithError(err).Warn("failed to remap progress of restructured nodes")
+		return
+	}
+
+	lineage, err := uc.mongoRepo.GetRoadmapLineage(ctx, after.ID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get roadmap lineage")
+	} else if lineage != nil {
+		for sourceNodeID, nodeID := range lineage.NodeIDMap {
+			if newID, ok := mapping[nodeID]; ok {
+				lineage.NodeIDMap[sourceNodeID] = newID
+			}
+		}
+		if err := uc.mongoRepo.SaveRoadmapLineage(ctx, lineage); err != nil {
+			logger.WithError(err).Warn("failed to update roadmap lineage")
+		}
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"remapped_nodes": len(mapping),
+		"users":          users,
+	}).Info("remapped progress of restructured nodes")
+}
+
+func parseNodeIDMap(raw map[string]string) (map[uuid.UUID]uuid.UUID, error) {
+	mapping := make(map[uuid.UUID]uuid.UUID, len(raw))
+	for oldID, newID := range raw {
+		parsedOldID, err := uuid.Parse(oldID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id map: %q is not a node ID", oldID)
+		}
+		parsedNewID, err := uuid.Parse(newID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id map: %q is not a node ID", newID)
+		}
+		mapping[parsedOldID] = parsedNewID
+	}
+	return mapping, nil
+}
@@ -0,0 +1,110 @@
+package roadmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type draftRepoStub struct {
+	roadmap.MongoRepository
+
+	roadmaps map[primitive.ObjectID]*entities.Roadmap
+	lineage  *entities.RoadmapLineage
+	// concurrentEdit bumps the version of the stored roadmap before the
+	// release is saved, as an edit landing in between would.
+	concurrentEdit bool
+	saves          int
+}
+
+func (r *draftRepoStub) GetByID(_ context.Context, id primitive.ObjectID) (*entities.Roadmap, error) {
+	stored := *r.roadmaps[id]
+	return &stored, nil
+}
+
+func (r *draftRepoStub) GetRoadmapLineage(context.Context, primitive.ObjectID) (*entities.RoadmapLineage, error) {
+	return r.lineage, nil
+}
+
+func (r *draftRepoStub) UpdateIfVersion(_ context.Context, rm *entities.Roadmap, version int64) (bool, error) {
+	stored := r.roadmaps[rm.ID]
+	if r.concurrentEdit {
+		stored.Version++
+	}
+	if stored.Version != version {
+		return false, nil
+	}
+
+	rm.Version = version + 1
+	r.roadmaps[rm.ID] = rm
+	r.saves++
+	return true, nil
+}
+
+func (r *draftRepoStub) RemapNodeProgress(context.Context, primitive.ObjectID, map[uuid.UUID]uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func newDraftRepoStub() (*draftRepoStub, primitive.ObjectID, primitive.ObjectID) {
+	nodeID, draftNodeID := uuid.New(), uuid.New()
+	published := &entities.Roadmap{
+		ID:      primitive.NewObjectID(),
+		Version: 2,
+		Nodes:   []entities.RoadmapNode{{ID: nodeID, Type: "custom", Data: entities.NodeData{Label: "Основы"}}},
+	}
+	draft := &entities.Roadmap{
+		ID:    primitive.NewObjectID(),
+		Nodes: []entities.RoadmapNode{{ID: draftNodeID, Type: "custom", Data: entities.NodeData{Label: "Основы Go"}}},
+	}
+
+	return &draftRepoStub{
+		roadmaps: map[primitive.ObjectID]*entities.Roadmap{published.ID: published, draft.ID: draft},
+		lineage: &entities.RoadmapLineage{
+			RoadmapID:       draft.ID,
+			SourceRoadmapID: published.ID,
+			Kind:            entities.RoadmapLineageDraft,
+			NodeIDMap:       map[uuid.UUID]uuid.UUID{nodeID: draftNodeID},
+		},
+	}, published.ID, draft.ID
+}
+
+func TestApplyDraftKeepsOriginalNodeIDs(t *testing.T) {
+	repo, roadmapID, draftID := newDraftRepoStub()
+	uc := &RoadmapUsecase{mongoRepo: repo}
+
+	added, removed, err := uc.ApplyDraft(context.Background(), uuid.New(), draftID, roadmapID)
+	if err != nil {
+		t.Fatalf("ApplyDraft returned error: %v", err)
+	}
+	if added != 0 || removed != 0 {
+		t.Fatalf("got %d added and %d removed nodes, want the node kept", added, removed)
+	}
+
+	released := repo.roadmaps[roadmapID]
+	if released.Version != 3 {
+		t.Fatalf("got version %d, want 3", released.Version)
+	}
+	for sourceNodeID := range repo.lineage.NodeIDMap {
+		if released.Nodes[0].ID != sourceNodeID || released.Nodes[0].Data.Label != "Основы Go" {
+			t.Fatalf("got node %+v, want the draft content under the original ID", released.Nodes[0])
+		}
+	}
+}
+
+func TestApplyDraftRejectsConcurrentlyModifiedRoadmap(t *testing.T) {
+	repo, roadmapID, draftID := newDraftRepoStub()
+	repo.concurrentEdit = true
+	uc := &RoadmapUsecase{mongoRepo: repo}
+
+	if _, _, err := uc.ApplyDraft(context.Background(), uuid.New(), draftID, roadmapID); err == nil {
+		t.Fatal("ApplyDraft overwrote a roadmap modified after it was loaded")
+	}
+	if repo.saves != 0 || repo.roadmaps[roadmapID].Nodes[0].Data.Label != "Основы" {
+		t.Fatalf("roadmap was overwritten: %+v", repo.roadmaps[roadmapID])
+	}
+}
@@ -0,0 +1,88 @@
+package roadmap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/metrics"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type LinkCheckUsecase struct {
+	cfg             config.LinkCheckConfig
+	mongoRepo       roadmap.MongoRepository
+	linkCheckWebapi roadmap.LinkCheckWebapi
+	metrics         metrics.Metrics
+}
+
+func NewLinkCheckUsecase(
+	cfg *config.Config,
+	mongoRepo roadmap.MongoRepository,
+	linkCheckWebapi roadmap.LinkCheckWebapi,
+	mtrs metrics.Metrics,
+) roadmap.LinkCheckUsecase {
+	return &LinkCheckUsecase{
+		cfg:             cfg.Workers.LinkCheck,
+		mongoRepo:       mongoRepo,
+		linkCheckWebapi: linkCheckWebapi,
+		metrics:         mtrs,
+	}
+}
+
+func (uc *LinkCheckUsecase) CheckMaterialLinks(ctx context.Context) error {
+	const op = "LinkCheckUsecase.CheckMaterialLinks"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	targets, err := uc.mongoRepo.GetMaterialsForLinkCheck(ctx, time.Now().Add(-uc.cfg.RecheckAfter), uc.cfg.BatchSize)
+	if err != nil {
+		logger.WithError(err).Error("failed to get materials for link check")
+		return fmt.Errorf("failed to get materials for link check: %w", err)
+	}
+
+	checked, broken := 0, 0
+	for _, target := range targets {
+		if ctx.Err() != nil {
+			break
+		}
+
+		check := uc.linkCheckWebapi.CheckLink(ctx, target.Material.URL)
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := uc.mongoRepo.UpdateMaterialLinkCheck(ctx, target.RoadmapID, target.NodeID, target.Material.ID, check); err != nil {
+			logger.WithError(err).WithField("material_id", target.Material.ID).Warn("failed to store link check result")
+			continue
+		}
+
+		checked++
+		if check.Status == entities.MaterialLinkBroken {
+			broken++
+		}
+	}
+
+	counts, err := uc.mongoRepo.CountBrokenLinks(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to count broken links")
+		return fmt.Errorf("failed to count broken links: %w", err)
+	}
+
+	countsByRoadmap := make(map[string]int, len(counts))
+	for roadmapID, count := range counts {
+		countsByRoadmap[roadmapID.Hex()] = count
+	}
+	uc.metrics.SetBrokenLinks(countsByRoadmap)
+
+	logger.WithFields(map[string]interface{}{
+		"candidates":        len(targets),
+		"checked":           checked,
+		"broken":            broken,
+		"roadmaps_affected": len(counts),
+	}).Info("finished material link check")
+
+	return nil
+}
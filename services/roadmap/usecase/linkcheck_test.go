@@ -0,0 +1,150 @@
+package roadmap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/metrics"
+	"github.com/F0urward/proftwist-backend/services/roadmap"
+)
+
+type linkCheckRepoStub struct {
+	roadmap.MongoRepository
+
+	targets       []*entities.MaterialLinkTarget
+	checkedBefore time.Time
+	limit         int
+	failFor       uuid.UUID
+	stored        map[uuid.UUID]*entities.MaterialLinkCheck
+	brokenCounts  map[primitive.ObjectID]int
+}
+
+func (r *linkCheckRepoStub) GetMaterialsForLinkCheck(_ context.Context, checkedBefore time.Time, limit int) ([]*entities.MaterialLinkTarget, error) {
+	r.checkedBefore = checkedBefore
+	r.limit = limit
+	return r.targets, nil
+}
+
+func (r *linkCheckRepoStub) UpdateMaterialLinkCheck(_ context.Context, _ primitive.ObjectID, _ uuid.UUID, materialID uuid.UUID, check *entities.MaterialLinkCheck) error {
+	if materialID == r.failFor {
+		return errors.New("write failed")
+	}
+	r.stored[materialID] = check
+	return nil
+}
+
+func (r *linkCheckRepoStub) CountBrokenLinks(context.Context) (map[primitive.ObjectID]int, error) {
+	return r.brokenCounts, nil
+}
+
+type linkCheckWebapiStub struct {
+	statuses map[string]entities.MaterialLinkStatus
+	checked  []string
+}
+
+func (w *linkCheckWebapiStub) CheckLink(_ context.Context, rawURL string) *entities.MaterialLinkCheck {
+	w.checked = append(w.checked, rawURL)
+	return &entities.MaterialLinkCheck{Status: w.statuses[rawURL], CheckedAt: time.Now()}
+}
+
+type linkCheckMetricsStub struct {
+	metrics.Metrics
+	brokenLinks map[string]int
+}
+
+func (m *linkCheckMetricsStub) SetBrokenLinks(countsByRoadmap map[string]int) {
+	m.brokenLinks = countsByRoadmap
+}
+
+func linkTarget(roadmapID primitive.ObjectID, url string) *entities.MaterialLinkTarget {
+	return &entities.MaterialLinkTarget{
+		RoadmapID: roadmapID,
+		NodeID:    uuid.New(),
+		Material:  entities.Material{ID: uuid.New(), URL: url},
+	}
+}
+
+func TestCheckMaterialLinksStoresResultsAndPublishesMetrics(t *testing.T) {
+	roadmapID := primitive.NewObjectID()
+	ok := linkTarget(roadmapID, "https://example.com/ok")
+	broken := linkTarget(roadmapID, "https://example.com/broken")
+	unstored := linkTarget(roadmapID, "https://example.com/unstored")
+
+	repo := &linkCheckRepoStub{
+		targets:      []*entities.MaterialLinkTarget{ok, broken, unstored},
+		failFor:      unstored.Material.ID,
+		stored:       make(map[uuid.UUID]*entities.MaterialLinkCheck),
+		brokenCounts: map[primitive.ObjectID]int{roadmapID: 1},
+	}
+	webapi := &linkCheckWebapiStub{statuses: map[string]entities.MaterialLinkStatus{
+		ok.Material.URL:       entities.MaterialLinkOK,
+		broken.Material.URL:   entities.MaterialLinkBroken,
+		unstored.Material.URL: entities.MaterialLinkBroken,
+	}}
+	mtrs := &linkCheckMetricsStub{}
+
+	cfg := &config.Config{}
+	cfg.Workers.LinkCheck = config.LinkCheckConfig{RecheckAfter: 24 * time.Hour, BatchSize: 50}
+	uc := NewLinkCheckUsecase(cfg, repo, webapi, mtrs)
+
+	started := time.Now()
+	if err := uc.CheckMaterialLinks(context.Background()); err != nil {
+		t.Fatalf("CheckMaterialLinks returned error: %v", err)
+	}
+
+	if repo.limit != 50 {
+		t.Errorf("got batch size %d, want 50", repo.limit)
+	}
+	if want := started.Add(-24 * time.Hour); repo.checkedBefore.Before(want.Add(-time.Minute)) || repo.checkedBefore.After(want.Add(time.Minute)) {
+		t.Errorf("got recheck cutoff %v, want about %v", repo.checkedBefore, want)
+	}
+
+	if len(webapi.checked) != 3 {
+		t.Fatalf("got %d links checked, want 3", len(webapi.checked))
+	}
+	if got := repo.stored[ok.Material.ID]; got == nil || got.Status != entities.MaterialLinkOK {
+		t.Errorf("ok material stored as %+v", got)
+	}
+	if got := repo.stored[broken.Material.ID]; got == nil || got.Status != entities.MaterialLinkBroken {
+		t.Errorf("broken material stored as %+v", got)
+	}
+	if _, stored := repo.stored[unstored.Material.ID]; stored {
+		t.Errorf("material with failed write should not be stored")
+	}
+
+	if got := mtrs.brokenLinks[roadmapID.Hex()]; got != 1 {
+		t.Errorf("got %d broken links in metrics, want 1", got)
+	}
+}
+
+func TestCheckMaterialLinksStopsWhenCancelled(t *testing.T) {
+	roadmapID := primitive.NewObjectID()
+	repo := &linkCheckRepoStub{
+		targets: []*entities.MaterialLinkTarget{
+			linkTarget(roadmapID, "https://example.com/a"),
+			linkTarget(roadmapID, "https://example.com/b"),
+		},
+		stored: make(map[uuid.UUID]*entities.MaterialLinkCheck),
+	}
+	webapi := &linkCheckWebapiStub{statuses: map[string]entities.MaterialLinkStatus{}}
+
+	cfg := &config.Config{}
+	uc := NewLinkCheckUsecase(cfg, repo, webapi, &linkCheckMetricsStub{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := uc.CheckMaterialLinks(ctx); err != nil {
+		t.Fatalf("CheckMaterialLinks returned error: %v", err)
+	}
+	if len(webapi.checked) != 0 || len(repo.stored) != 0 {
+		t.Fatalf("links were checked after cancellation: %v", webapi.checked)
+	}
+}
@@ -0,0 +1,163 @@
+package roadmap
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+var errPrerequisiteCycle = errors.New("invalid prerequisites: edges form a cycle")
+
+// prerequisitesOf maps every node to the nodes it requires. Only edges marked
+// as prerequisites between existing nodes are taken into account.
+func prerequisitesOf(roadmap *entities.Roadmap) map[string][]string {
+	graph := newRoadmapGraph(roadmap)
+	result := make(map[string][]string)
+
+	for _, edge := range roadmap.Edges {
+		if !edge.Prerequisite || edge.Source == edge.Target {
+			continue
+		}
+		if _, ok := graph.nodes[edge.Source]; !ok {
+			continue
+		}
+		if _, ok := graph.nodes[edge.Target]; !ok {
+			continue
+		}
+		result[edge.Target] = append(result[edge.Target], edge.Source)
+	}
+
+	return result
+}
+
+// validatePrerequisites rejects prerequisite edges that would make a node depend on itself.
+func validatePrerequisites(roadmap *entities.Roadmap) error {
+	prerequisites := prerequisitesOf(roadmap)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(roadmap.Nodes))
+
+	var visit func(id string) bool
+	visit = func(id string) bool {
+		switch state[id] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+
+		state[id] = visiting
+		for _, requiredID := range prerequisites[id] {
+			if !visit(requiredID) {
+				return false
+			}
+		}
+		state[id] = visited
+		return true
+	}
+
+	for _, node := range roadmap.Nodes {
+		if !visit(node.ID.String()) {
+			return errPrerequisiteCycle
+		}
+	}
+
+	return nil
+}
+
+func isNodeFinished(status entities.NodeProgressStatus) bool {
+	return status == entities.NodeProgressDone || status == entities.NodeProgressSkipped
+}
+
+func nodeStatus(progress *entities.UserProgress, nodeID uuid.UUID) entities.NodeProgressStatus {
+	if progress != nil {
+		if nodeProgress, ok := progress.Progress[nodeID]; ok && nodeProgress.Status != "" {
+			return nodeProgress.Status
+		}
+	}
+	return entities.NodeProgressPending
+}
+
+// lockedNodes returns, for every locked node, the prerequisites the user has
+// not finished yet.
+func lockedNodes(roadmap *entities.Roadmap, progress *entities.UserProgress) map[uuid.UUID][]uuid.UUID {
+	graph := newRoadmapGraph(roadmap)
+	locked := make(map[uuid.UUID][]uuid.UUID)
+
+	for targetID, requiredIDs := range prerequisitesOf(roadmap) {
+		target := graph.nodes[targetID]
+		for _, requiredID := range requiredIDs {
+			required := graph.nodes[requiredID]
+			if nodeWeight(required) == 0 || isNodeFinished(nodeStatus(progress, required.ID)) {
+				continue
+			}
+			locked[target.ID] = append(locked[target.ID], required.ID)
+		}
+	}
+
+	return locked
+}
+
+// learningOrder sorts the tracked nodes topologically over all edges, so
+// parents and prerequisites always come before the nodes that follow them.
+// Ties keep the order in which the author placed the nodes. Nodes caught in a
+// cycle of plain edges are appended in their original order.
+func learningOrder(roadmap *entities.Roadmap) []entities.RoadmapNode {
+	graph := newRoadmapGraph(roadmap)
+
+	position := make(map[string]int, len(graph.order))
+	for i, id := range graph.order {
+		position[id] = i
+	}
+
+	inDegree := make(map[string]int, len(graph.order))
+	for _, id := range graph.order {
+		inDegree[id] = len(graph.parents[id])
+	}
+
+	var ready []string
+	for _, id := range graph.order {
+		if inDegree[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	emitted := make(map[string]bool, len(graph.order))
+	result := make([]entities.RoadmapNode, 0, len(graph.order))
+
+	for len(ready) > 0 {
+		sort.SliceStable(ready, func(i, j int) bool {
+			return position[ready[i]] < position[ready[j]]
+		})
+
+		id := ready[0]
+		ready = ready[1:]
+		emitted[id] = true
+
+		if node := graph.nodes[id]; nodeWeight(node) > 0 {
+			result = append(result, node)
+		}
+
+		for _, childID := range graph.children[id] {
+			inDegree[childID]--
+			if inDegree[childID] == 0 {
+				ready = append(ready, childID)
+			}
+		}
+	}
+
+	for _, id := range graph.order {
+		if node := graph.nodes[id]; !emitted[id] && nodeWeight(node) > 0 {
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,537 @@
+package roadmap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
+)
+
+const (
+	maxQuizQuestions          = 50
+	maxQuizOptions            = 10
+	maxQuizAcceptedAnswers    = 10
+	maxQuizTextLength         = 2000
+	maxQuizPassingScore       = 100
+	defaultGeneratedQuestions = 5
+	maxGeneratedQuestions     = 10
+)
+
+// validateQuizQuestions checks that every question can be answered correctly
+// and gives new questions and options their IDs.
+func validateQuizQuestions(questions []entities.QuizQuestion) error {
+	if len(questions) == 0 {
+		return fmt.Errorf("invalid quiz: at least one question is required")
+	}
+	if len(questions) > maxQuizQuestions {
+		return fmt.Errorf("invalid quiz: at most %d questions are allowed", maxQuizQuestions)
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	assignID := func(id *uuid.UUID) error {
+		if *id == uuid.Nil {
+			*id = uuid.New()
+		}
+		if seen[*id] {
+			return fmt.Errorf("invalid quiz: duplicate id %s", id.String())
+		}
+		seen[*id] = true
+		return nil
+	}
+
+	for i := range questions {
+		question := &questions[i]
+		number := i + 1
+
+		if err := assignID(&question.ID); err != nil {
+			return err
+		}
+		if !question.Type.IsValid() {
+			return fmt.Errorf("invalid quiz: question %d has unknown type %q", number, question.Type)
+		}
+		if question.Text == "" || utf8.RuneCountInString(question.Text) > maxQuizTextLength {
+			return fmt.Errorf("invalid quiz: question %d text must be 1-%d characters", number, maxQuizTextLength)
+		}
+		if utf8.RuneCountInString(question.Explanation) > maxQuizTextLength {
+			return fmt.Errorf("invalid quiz: question %d explanation is too long", number)
+		}
+
+		if !question.Type.IsChoice() {
+			question.Options = nil
+			if len(question.AcceptedAnswers) == 0 || len(question.AcceptedAnswers) > maxQuizAcceptedAnswers {
+				return fmt.Errorf("invalid quiz: question %d needs 1-%d accepted answers", number, maxQuizAcceptedAnswers)
+			}
+			continue
+		}
+
+		question.AcceptedAnswers = nil
+		if len(question.Options) < 2 || len(question.Options) > maxQuizOptions {
+			return fmt.Errorf("invalid quiz: question %d needs 2-%d options", number, maxQuizOptions)
+		}
+
+		correct := 0
+		for j := range question.Options {
+			option := &question.Options[j]
+			if err := assignID(&option.ID); err != nil {
+				return err
+			}
+			if option.Text == "" || utf8.RuneCountInString(option.Text) > maxQuizTextLength {
+				return fmt.Errorf("invalid quiz: question %d has an empty or too long option", number)
+			}
+			if option.Correct {
+				correct++
+			}
+		}
+
+		if correct == 0 {
+			return fmt.Errorf("invalid quiz: question %d has no correct option", number)
+		}
+		if question.Type == entities.QuizQuestionSingleChoice && correct > 1 {
+			return fmt.Errorf("invalid quiz: single choice question %d has several correct options", number)
+		}
+	}
+
+	return nil
+}
+
+// scoreQuizAttempt grades the answers against the quiz. A choice question is
+// answered correctly only when exactly the correct options are selected, a
+// short answer when it matches one of the accepted answers. Unanswered
+// questions count as wrong.
+func scoreQuizAttempt(quiz *entities.NodeQuiz, answers []entities.QuizAnswer) *entities.QuizAttempt {
+	byQuestion := make(map[uuid.UUID]entities.QuizAnswer, len(answers))
+	for _, answer := range answers {
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	attempt := &entities.QuizAttempt{
+		RoadmapID:  quiz.RoadmapID,
+		NodeID:     quiz.NodeID,
+		Answers:    make([]entities.QuizAnswer, 0, len(quiz.Questions)),
+		TotalCount: len(quiz.Questions),
+	}
+
+	for _, question := range quiz.Questions {
+		answer := byQuestion[question.ID]
+		answer.QuestionID = question.ID
+		answer.Correct = isQuizAnswerCorrect(question, answer)
+		if answer.Correct {
+			attempt.CorrectCount++
+		}
+		attempt.Answers = append(attempt.Answers, answer)
+	}
+
+	if attempt.TotalCount > 0 {
+		attempt.Score = int(math.Round(float64(attempt.CorrectCount) / float64(attempt.TotalCount) * 100))
+	}
+	attempt.Passed = quiz.PassingScore > 0 && attempt.Score >= quiz.PassingScore
+
+	return attempt
+}
+
+func isQuizAnswerCorrect(question entities.QuizQuestion, answer entities.QuizAnswer) bool {
+	if !question.Type.IsChoice() {
+		given := entities.NormalizeQuizAnswer(answer.Text)
+		if given == "" {
+			return false
+		}
+		for _, accepted := range question.AcceptedAnswers {
+			if entities.NormalizeQuizAnswer(accepted) == given {
+				return true
+			}
+		}
+		return false
+	}
+
+	selected := make(map[uuid.UUID]bool, len(answer.OptionIDs))
+	for _, id := range answer.OptionIDs {
+		selected[id] = true
+	}
+
+	for _, option := range question.Options {
+		if option.Correct != selected[option.ID] {
+			return false
+		}
+		delete(selected, option.ID)
+	}
+
+	return len(selected) == 0
+}
+
+func quizModerationContent(questions []entities.QuizQuestion) string {
+	var parts []string
+	for _, question := range questions {
+		parts = append(parts, question.Text)
+		for _, option := range question.Options {
+			parts = append(parts, option.Text)
+		}
+		parts = append(parts, question.AcceptedAnswers...)
+		if question.Explanation != "" {
+			parts = append(parts, question.Explanation)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// roadmapAccess returns the roadmap info when the user may view the roadmap,
+// together with whether the user may edit it.
+func (uc *RoadmapUsecase) roadmapAccess(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*roadmapinfoclient.RoadmapInfo, bool, error) {
+	req := &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex()}
+	if userID != uuid.Nil {
+		req.UserId = userID.String()
+	}
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, req)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		return nil, false, errs.ErrNotFound
+	}
+
+	role := entities.CollaboratorRole(roadmapInfo.Role)
+	if !roadmapInfo.RoadmapInfo.IsPublic && !role.CanView() {
+		return nil, false, errs.ErrForbidden
+	}
+
+	return roadmapInfo.RoadmapInfo, role.CanEdit(), nil
+}
+
+func (uc *RoadmapUsecase) GetNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*dto.NodeQuizDTO, error) {
+	const op = "RoadmapUsecase.GetNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	_, isOwner, err := uc.roadmapAccess(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to check roadmap access")
+		return nil, err
+	}
+
+	quiz, err := uc.mongoRepo.GetNodeQuiz(ctx, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get node quiz")
+		return nil, fmt.Errorf("failed to get node quiz: %w", err)
+	}
+
+	if quiz == nil {
+		logger.Warn("node quiz not found")
+		return nil, errs.ErrNotFound
+	}
+
+	response := dto.NodeQuizToDTO(quiz, isOwner)
+
+	if userID != uuid.Nil {
+		attempts, err := uc.mongoRepo.GetQuizAttempts(ctx, userID, roadmapID, nodeID, 1, 0)
+		if err != nil {
+			logger.WithError(err).Warn("failed to get last quiz attempt")
+		} else if len(attempts) > 0 {
+			lastAttempt := dto.QuizAttemptToDTO(attempts[0])
+			response.LastAttempt = &lastAttempt
+		}
+	}
+
+	logger.WithField("questions", len(response.Questions)).Info("successfully retrieved node quiz")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) UpsertNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.UpsertNodeQuizRequestDTO) (*dto.NodeQuizDTO, error) {
+	const op = "RoadmapUsecase.UpsertNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	_, isOwner, err := uc.roadmapAccess(ctx, userID, roadmapID)
+	if err != nil && !errs.IsForbiddenError(err) {
+		logger.WithError(err).Warn("failed to check roadmap access")
+		return nil, err
+	}
+
+	if !isOwner {
+		logger.Warn("user is not author of the roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	if err := uc.ensureNodeExists(ctx, roadmapID, nodeID); err != nil {
+		logger.WithError(err).Warn("node not found")
+		return nil, err
+	}
+
+	if req.PassingScore < 0 || req.PassingScore > maxQuizPassingScore {
+		logger.Warn("invalid passing score provided")
+		return nil, fmt.Errorf("invalid quiz: passing score must be between 0 and %d", maxQuizPassingScore)
+	}
+
+	if req.AutoComplete && req.PassingScore == 0 {
+		logger.Warn("auto completion requested without passing score")
+		return nil, fmt.Errorf("invalid quiz: auto completion requires a passing score")
+	}
+
+	questions := dto.QuizQuestionsFromDTO(req.Questions)
+	if err := validateQuizQuestions(questions); err != nil {
+		logger.WithError(err).Warn("invalid quiz provided")
+		return nil, err
+	}
+
+	err = uc.moderateRoadmap(ctx, &entities.Roadmap{Nodes: []entities.RoadmapNode{{Description: quizModerationContent(questions)}}})
+	if errors.Is(err, errModerationRejected) {
+		logger.WithError(err).Warn("quiz rejected due to moderation")
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to moderate quiz: %w", err)
+	}
+
+	now := time.Now()
+	quiz, err := uc.mongoRepo.UpsertNodeQuiz(ctx, &entities.NodeQuiz{
+		RoadmapID:    roadmapID,
+		NodeID:       nodeID,
+		Questions:    questions,
+		PassingScore: req.PassingScore,
+		AutoComplete: req.AutoComplete,
+		AuthorID:     userID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to save node quiz")
+		return nil, fmt.Errorf("failed to save node quiz: %w", err)
+	}
+
+	response := dto.NodeQuizToDTO(quiz, true)
+
+	logger.WithField("questions", len(questions)).Info("successfully saved node quiz")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) DeleteNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	const op = "RoadmapUsecase.DeleteNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	_, isOwner, err := uc.roadmapAccess(ctx, userID, roadmapID)
+	if err != nil && !errs.IsForbiddenError(err) {
+		logger.WithError(err).Warn("failed to check roadmap access")
+		return err
+	}
+
+	if !isOwner {
+		logger.Warn("user is not author of the roadmap")
+		return errs.ErrForbidden
+	}
+
+	if err := uc.mongoRepo.DeleteNodeQuiz(ctx, roadmapID, nodeID); err != nil {
+		logger.WithError(err).Error("failed to delete node quiz")
+		return fmt.Errorf("failed to delete node quiz: %w", err)
+	}
+
+	logger.Info("successfully deleted node quiz")
+	return nil
+}
+
+// GenerateNodeQuiz drafts quiz questions for the node with the LLM. The draft
+// is returned to the author for review and is not saved.
+func (uc *RoadmapUsecase) GenerateNodeQuiz(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.GenerateNodeQuizRequestDTO) (*dto.GenerateNodeQuizResponseDTO, error) {
+	const op = "RoadmapUsecase.GenerateNodeQuiz"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	roadmapInfo, isOwner, err := uc.roadmapAccess(ctx, userID, roadmapID)
+	if err != nil && !errs.IsForbiddenError(err) {
+		logger.WithError(err).Warn("failed to check roadmap access")
+		return nil, err
+	}
+
+	if !isOwner {
+		logger.Warn("user is not author of the roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	questionsCount := req.QuestionsCount
+	if questionsCount == 0 {
+		questionsCount = defaultGeneratedQuestions
+	}
+	if questionsCount < 1 || questionsCount > maxGeneratedQuestions {
+		logger.Warn("invalid questions count provided")
+		return nil, fmt.Errorf("invalid quiz: questions count must be between 1 and %d", maxGeneratedQuestions)
+	}
+
+	existingRoadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get existing roadmap")
+		return nil, fmt.Errorf("failed to get existing roadmap: %w", err)
+	}
+
+	if existingRoadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	graph := newRoadmapGraph(existingRoadmap)
+	targetNode, exists := graph.nodes[nodeID.String()]
+	if !exists {
+		logger.Warn("node not found in roadmap")
+		return nil, errs.ErrNotFound
+	}
+
+	draftDTO := dto.NodeQuizDraftDTO{
+		Topic:          roadmapInfo.Name,
+		Description:    roadmapInfo.Description,
+		Complexity:     req.Complexity,
+		Instructions:   req.Instructions,
+		QuestionsCount: questionsCount,
+		Node:           dto.NodesToDTO([]entities.RoadmapNode{targetNode})[0],
+		Ancestors:      dto.NodesToDTO(graph.ancestors(nodeID.String())),
+		Prompt:         uc.resolvePrompt(ctx, entities.PromptNodeQuiz, roadmapID.Hex()),
+	}
+	promptUsage := entities.NewPromptUsage(entities.PromptNodeQuiz, draftDTO.Prompt)
+
+	logger.WithField("prompt_version", promptUsage.Version).Info("generating node quiz with AI")
+	questions, err := uc.gigachatWebapi.GenerateNodeQuiz(ctx, &draftDTO)
+	if err != nil {
+		logger.WithError(err).Error("failed to generate node quiz with AI")
+		uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeFailed)
+		return nil, fmt.Errorf("failed to generate quiz: %w", err)
+	}
+	uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeGenerated)
+
+	err = uc.moderateRoadmap(ctx, &entities.Roadmap{Nodes: []entities.RoadmapNode{{Description: quizModerationContent(questions)}}})
+	if errors.Is(err, errModerationRejected) {
+		logger.WithError(err).Warn("generated quiz rejected due to moderation")
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to moderate quiz: %w", err)
+	}
+
+	logger.WithField("questions", len(questions)).Info("successfully generated node quiz")
+	return &dto.GenerateNodeQuizResponseDTO{
+		RoadmapID: roadmapID.Hex(),
+		NodeID:    nodeID,
+		Questions: dto.QuizQuestionsToDTO(questions, true),
+	}, nil
+}
+
+func (uc *RoadmapUsecase) SubmitQuizAttempt(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.SubmitQuizAttemptRequestDTO) (*dto.QuizAttemptResultDTO, error) {
+	const op = "RoadmapUsecase.SubmitQuizAttempt"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	if _, _, err := uc.roadmapAccess(ctx, userID, roadmapID); err != nil {
+		logger.WithError(err).Warn("failed to check roadmap access")
+		return nil, err
+	}
+
+	quiz, err := uc.mongoRepo.GetNodeQuiz(ctx, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get node quiz")
+		return nil, fmt.Errorf("failed to get node quiz: %w", err)
+	}
+
+	if quiz == nil {
+		logger.Warn("node quiz not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if len(req.Answers) == 0 {
+		logger.Warn("empty quiz attempt provided")
+		return nil, fmt.Errorf("invalid quiz attempt: answers are required")
+	}
+
+	attempt := scoreQuizAttempt(quiz, dto.QuizAnswersFromDTO(req.Answers))
+	attempt.UserID = userID
+	attempt.CreatedAt = time.Now()
+
+	if err := uc.mongoRepo.CreateQuizAttempt(ctx, attempt); err != nil {
+		logger.WithError(err).Error("failed to save quiz attempt")
+		return nil, fmt.Errorf("failed to save quiz attempt: %w", err)
+	}
+
+	if attempt.Passed {
+		if err := uc.gamificationPublisher.QuizPassed(ctx, userID.String(), roadmapID.Hex(), nodeID.String()); err != nil {
+			logger.WithError(err).Warn("failed to publish quiz passed event")
+		}
+	}
+
+	nodeCompleted := false
+	if attempt.Passed && quiz.AutoComplete {
+		err := uc.UpdateNodeProgress(ctx, userID, roadmapID, &dto.UpdateNodeProgressRequestDTO{
+			NodeID: nodeID,
+			Status: dto.NodeProgressStatus(entities.NodeProgressDone),
+		})
+		if err != nil {
+			logger.WithError(err).Warn("failed to mark node done after passed quiz")
+		} else {
+			nodeCompleted = true
+		}
+	}
+
+	response := dto.QuizAttemptResultToDTO(quiz, attempt, nodeCompleted)
+
+	logger.WithFields(map[string]interface{}{
+		"score":          attempt.Score,
+		"passed":         attempt.Passed,
+		"node_completed": nodeCompleted,
+	}).Info("successfully submitted quiz attempt")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) GetQuizAttempts(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, limit, offset int) (*dto.QuizAttemptListResponseDTO, error) {
+	const op = "RoadmapUsecase.GetQuizAttempts"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+	})
+
+	attempts, err := uc.mongoRepo.GetQuizAttempts(ctx, userID, roadmapID, nodeID, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get quiz attempts")
+		return nil, fmt.Errorf("failed to get quiz attempts: %w", err)
+	}
+
+	total, err := uc.mongoRepo.CountQuizAttempts(ctx, userID, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Error("failed to count quiz attempts")
+		return nil, fmt.Errorf("failed to count quiz attempts: %w", err)
+	}
+
+	logger.WithField("count", len(attempts)).Info("successfully retrieved quiz attempts")
+	return &dto.QuizAttemptListResponseDTO{
+		Attempts: dto.QuizAttemptsToDTO(attempts),
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
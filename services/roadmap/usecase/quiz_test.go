@@ -0,0 +1,30 @@
+package roadmap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+func TestValidateQuizQuestionsCountsCharactersNotBytes(t *testing.T) {
+	text := strings.Repeat("я", maxQuizTextLength)
+
+	questions := []entities.QuizQuestion{{
+		Type:        entities.QuizQuestionSingleChoice,
+		Text:        text,
+		Explanation: text,
+		Options: []entities.QuizOption{
+			{Text: text, Correct: true},
+			{Text: "нет"},
+		},
+	}}
+	if err := validateQuizQuestions(questions); err != nil {
+		t.Fatalf("quiz with %d Cyrillic characters rejected: %v", maxQuizTextLength, err)
+	}
+
+	questions[0].Text += "я"
+	if err := validateQuizQuestions(questions); err == nil {
+		t.Fatalf("quiz with %d characters accepted", maxQuizTextLength+1)
+	}
+}
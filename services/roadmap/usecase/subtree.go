@@ -0,0 +1,197 @@
+package roadmap
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type roadmapGraph struct {
+	nodes    map[string]entities.RoadmapNode
+	order    []string
+	children map[string][]string
+	parents  map[string][]string
+}
+
+func newRoadmapGraph(roadmap *entities.Roadmap) *roadmapGraph {
+	g := &roadmapGraph{
+		nodes:    make(map[string]entities.RoadmapNode, len(roadmap.Nodes)),
+		order:    make([]string, 0, len(roadmap.Nodes)),
+		children: make(map[string][]string),
+		parents:  make(map[string][]string),
+	}
+
+	for _, node := range roadmap.Nodes {
+		id := node.ID.String()
+		g.nodes[id] = node
+		g.order = append(g.order, id)
+	}
+
+	for _, edge := range roadmap.Edges {
+		if _, ok := g.nodes[edge.Source]; !ok {
+			continue
+		}
+		if _, ok := g.nodes[edge.Target]; !ok {
+			continue
+		}
+		g.children[edge.Source] = append(g.children[edge.Source], edge.Target)
+		g.parents[edge.Target] = append(g.parents[edge.Target], edge.Source)
+	}
+
+	return g
+}
+
+// subtree returns the descendants of rootID that are reachable only through
+// rootID, so nodes shared with other branches of the roadmap are left alone.
+func (g *roadmapGraph) subtree(rootID string) map[string]bool {
+	reachable := make(map[string]bool)
+	stack := append([]string{}, g.children[rootID]...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == rootID || reachable[id] {
+			continue
+		}
+		reachable[id] = true
+		stack = append(stack, g.children[id]...)
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for id := range reachable {
+			for _, parentID := range g.parents[id] {
+				if parentID != rootID && !reachable[parentID] {
+					delete(reachable, id)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return reachable
+}
+
+func (g *roadmapGraph) ancestors(nodeID string) []entities.RoadmapNode {
+	var path []entities.RoadmapNode
+	visited := map[string]bool{nodeID: true}
+
+	current := nodeID
+	for {
+		parents := g.parents[current]
+		if len(parents) == 0 || visited[parents[0]] {
+			break
+		}
+		current = parents[0]
+		visited[current] = true
+		path = append(path, g.nodes[current])
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+func (g *roadmapGraph) siblings(nodeID string) []entities.RoadmapNode {
+	var result []entities.RoadmapNode
+	seen := map[string]bool{nodeID: true}
+
+	for _, parentID := range g.parents[nodeID] {
+		for _, childID := range g.children[parentID] {
+			if seen[childID] {
+				continue
+			}
+			seen[childID] = true
+			result = append(result, g.nodes[childID])
+		}
+	}
+
+	return result
+}
+
+func (g *roadmapGraph) nodesByIDs(ids map[string]bool) []entities.RoadmapNode {
+	result := make([]entities.RoadmapNode, 0, len(ids))
+	for _, id := range g.order {
+		if ids[id] {
+			result = append(result, g.nodes[id])
+		}
+	}
+	return result
+}
+
+// graftSubtree attaches generated nodes under parent, giving them fresh IDs,
+// positioning them relative to the parent and dropping edges that point
+// outside of the generated set.
+func graftSubtree(parent entities.RoadmapNode, generated *entities.Roadmap) ([]entities.RoadmapNode, []entities.RoadmapEdge) {
+	parentID := parent.ID.String()
+	idMap := make(map[string]string, len(generated.Nodes))
+
+	nodes := make([]entities.RoadmapNode, 0, len(generated.Nodes))
+	for _, node := range generated.Nodes {
+		oldID := node.ID.String()
+		if node.ID == uuid.Nil || oldID == parentID {
+			continue
+		}
+		if _, duplicate := idMap[oldID]; duplicate {
+			continue
+		}
+
+		newID := uuid.New()
+		idMap[oldID] = newID.String()
+
+		node.ID = newID
+		node.Position.X += parent.Position.X
+		node.Position.Y += parent.Position.Y
+		node.Selected = false
+		node.Dragging = false
+		node.Materials = []entities.Material{}
+		if node.Data.Type == "root" {
+			node.Data.Type = "primary"
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	hasParent := make(map[string]bool, len(nodes))
+	edges := make([]entities.RoadmapEdge, 0, len(generated.Edges)+len(nodes))
+	for _, edge := range generated.Edges {
+		target, ok := idMap[edge.Target]
+		if !ok {
+			continue
+		}
+
+		source := parentID
+		if edge.Source != parentID {
+			if source, ok = idMap[edge.Source]; !ok {
+				continue
+			}
+		}
+
+		if source == target {
+			continue
+		}
+
+		edges = append(edges, entities.RoadmapEdge{
+			ID:     uuid.New().String(),
+			Source: source,
+			Target: target,
+		})
+		hasParent[target] = true
+	}
+
+	for _, node := range nodes {
+		id := node.ID.String()
+		if hasParent[id] {
+			continue
+		}
+		edges = append(edges, entities.RoadmapEdge{
+			ID:     uuid.New().String(),
+			Source: parentID,
+			Target: id,
+		})
+	}
+
+	return nodes, edges
+}
@@ -0,0 +1,143 @@
+package roadmap
+
+import (
+	"math"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+const maxNextNodes = 3
+
+// Node weights used for the weighted completion. Primary nodes are the main
+// milestones of a roadmap. The root only names the roadmap and text nodes are
+// plain captions, so neither is tracked.
+var nodeTypeWeights = map[string]float64{
+	"root":      0,
+	"primary":   2,
+	"secondary": 1,
+	"text":      0,
+}
+
+func nodeWeight(node entities.RoadmapNode) float64 {
+	if node.Type == "text" {
+		return 0
+	}
+	if weight, ok := nodeTypeWeights[node.Data.Type]; ok {
+		return weight
+	}
+	return 1
+}
+
+// summarizeProgress counts node statuses and computes the weighted completion.
+// Skipped nodes are left out of the completion entirely and nodes in progress
+// count for half of their weight.
+func summarizeProgress(roadmap *entities.Roadmap, progress *entities.UserProgress) *entities.ProgressSummary {
+	summary := &entities.ProgressSummary{}
+	statuses := make(map[string]entities.NodeProgressStatus, len(roadmap.Nodes))
+
+	var earned, total float64
+	for _, node := range roadmap.Nodes {
+		weight := nodeWeight(node)
+		if weight == 0 {
+			continue
+		}
+
+		nodeProgress := entities.NodeProgress{Status: entities.NodeProgressPending}
+		if progress != nil {
+			if p, ok := progress.Progress[node.ID]; ok && p.Status != "" {
+				nodeProgress = p
+			}
+		}
+		statuses[node.ID.String()] = nodeProgress.Status
+
+		summary.TotalNodes++
+		summary.TimeSpentMinutes += nodeProgress.TimeSpentMinutes
+
+		switch nodeProgress.Status {
+		case entities.NodeProgressDone:
+			summary.DoneCount++
+			earned += weight
+			total += weight
+		case entities.NodeProgressInProgress:
+			summary.InProgressCount++
+			earned += weight / 2
+			total += weight
+		case entities.NodeProgressSkipped:
+			summary.SkippedCount++
+		default:
+			summary.PendingCount++
+			total += weight
+		}
+	}
+
+	if total > 0 {
+		summary.CompletionPercent = math.Round(earned/total*1000) / 10
+	} else if summary.TotalNodes > 0 {
+		summary.CompletionPercent = 100
+	}
+
+	if progress != nil && len(progress.Progress) > 0 {
+		updatedAt := progress.UpdatedAt
+		summary.LastActivityAt = &updatedAt
+	}
+
+	summary.NextNodes = recommendNextNodes(roadmap, progress, statuses)
+
+	return summary
+}
+
+// recommendNextNodes suggests the nodes to work on next: the ones already in
+// progress first, then pending nodes in learning order whose parents are all
+// finished and which are not locked by prerequisites. Untracked parents such as
+// text captions never block a node.
+func recommendNextNodes(roadmap *entities.Roadmap, progress *entities.UserProgress, statuses map[string]entities.NodeProgressStatus) []entities.ProgressSummaryNode {
+	graph := newRoadmapGraph(roadmap)
+	locked := lockedNodes(roadmap, progress)
+
+	isFinished := func(id string) bool {
+		status, tracked := statuses[id]
+		return !tracked || isNodeFinished(status)
+	}
+
+	var inProgress, available []entities.ProgressSummaryNode
+	for _, node := range learningOrder(roadmap) {
+		id := node.ID.String()
+		status, tracked := statuses[id]
+		if !tracked {
+			continue
+		}
+
+		candidate := entities.ProgressSummaryNode{
+			ID:     node.ID,
+			Label:  node.Data.Label,
+			Status: status,
+		}
+
+		switch status {
+		case entities.NodeProgressInProgress:
+			inProgress = append(inProgress, candidate)
+		case entities.NodeProgressPending:
+			if len(locked[node.ID]) > 0 {
+				continue
+			}
+
+			unlocked := true
+			for _, parentID := range graph.parents[id] {
+				if !isFinished(parentID) {
+					unlocked = false
+					break
+				}
+			}
+			if unlocked {
+				available = append(available, candidate)
+			}
+		}
+	}
+
+	next := append(inProgress, available...)
+	if len(next) > maxNextNodes {
+		next = next[:maxNextNodes]
+	}
+
+	return next
+}
@@ -2,13 +2,18 @@ package roadmap
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/F0urward/proftwist-backend/config"
 	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/internal/entities/errs"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
@@ -16,38 +21,69 @@ import (
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapinfoclient"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/prompt"
 	"github.com/F0urward/proftwist-backend/services/roadmap"
 	"github.com/F0urward/proftwist-backend/services/roadmap/dto"
 )
 
+const (
+	maxSuggestedMaterials      = 6
+	maxMaterialDurationMinutes = 100000
+	maxMaterialLanguageLength  = 16
+	maxNodeNoteLength          = 20000
+	maxNoteSearchQueryLength   = 200
+	maxLoggedMinutes           = 24 * 60
+	minConfidence              = 1
+	maxConfidence              = 5
+)
+
+var errModerationRejected = errors.New("content violates moderation rules")
+
 type RoadmapUsecase struct {
-	mongoRepo         roadmap.MongoRepository
-	gigachatWebapi    roadmap.GigachatWebapi
-	roadmapInfoClient roadmapinfoclient.RoadmapInfoServiceClient
-	chatClient        chatclient.ChatServiceClient
-	authClient        authclient.AuthServiceClient
-	moderationClient  moderationclient.ModerationServiceClient
+	cfg                   *config.Config
+	mongoRepo             roadmap.MongoRepository
+	awsRepo               roadmap.AWSRepository
+	gigachatWebapi        roadmap.GigachatWebapi
+	unfurlWebapi          roadmap.UnfurlWebapi
+	roadmapInfoClient     roadmapinfoclient.RoadmapInfoServiceClient
+	chatClient            chatclient.ChatServiceClient
+	authClient            authclient.AuthServiceClient
+	moderationClient      moderationclient.ModerationServiceClient
+	promptUsecase         prompt.Usecase
+	gamificationPublisher roadmap.GamificationPublisher
+	editing               *editingSessions
 }
 
 func NewRoadmapUsecase(
+	cfg *config.Config,
 	mongoRepo roadmap.MongoRepository,
+	awsRepo roadmap.AWSRepository,
 	gigichatWebapi roadmap.GigachatWebapi,
+	unfurlWebapi roadmap.UnfurlWebapi,
 	roadmapInfoClient roadmapinfoclient.RoadmapInfoServiceClient,
 	chatClient chatclient.ChatServiceClient,
 	authClient authclient.AuthServiceClient,
 	moderationClient moderationclient.ModerationServiceClient,
+	promptUsecase prompt.Usecase,
+	gamificationPublisher roadmap.GamificationPublisher,
 ) roadmap.Usecase {
 	return &RoadmapUsecase{
-		mongoRepo:         mongoRepo,
-		gigachatWebapi:    gigichatWebapi,
-		roadmapInfoClient: roadmapInfoClient,
-		chatClient:        chatClient,
-		authClient:        authClient,
-		moderationClient:  moderationClient,
+		cfg:                   cfg,
+		mongoRepo:             mongoRepo,
+		awsRepo:               awsRepo,
+		gigachatWebapi:        gigichatWebapi,
+		unfurlWebapi:          unfurlWebapi,
+		roadmapInfoClient:     roadmapInfoClient,
+		chatClient:            chatClient,
+		authClient:            authClient,
+		moderationClient:      moderationClient,
+		promptUsecase:         promptUsecase,
+		gamificationPublisher: gamificationPublisher,
+		editing:               newEditingSessions(),
 	}
 }
 
-func (uc *RoadmapUsecase) GetByIDWithProgress(ctx context.Context, roadmapID primitive.ObjectID, userID uuid.UUID) (*dto.GetByIDRoadmapWithProgressResponseDTO, error) {
+func (uc *RoadmapUsecase) GetByIDWithProgress(ctx context.Context, roadmapID primitive.ObjectID, userID uuid.UUID, lang string) (*dto.GetByIDRoadmapWithProgressResponseDTO, error) {
 	const op = "RoadmapUsecase.GetByIDWithProgress"
 
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -83,7 +119,17 @@ func (uc *RoadmapUsecase) GetByIDWithProgress(ctx context.Context, roadmapID pri
 		logger.WithError(err).Warn("failed to get user progress")
 	}
 
-	roadmapWithProgressDTO := dto.EntityToDTOWithProgress(roadmap, userProgress)
+	roadmapWithProgressDTO := dto.EntityToDTOWithProgress(roadmap, userProgress, lang)
+	dto.ApplyNodeLocks(roadmapWithProgressDTO.Nodes, lockedNodes(roadmap, userProgress))
+
+	if userID != uuid.Nil {
+		notes, err := uc.mongoRepo.GetNodeNotes(ctx, userID, roadmapID)
+		if err != nil {
+			logger.WithError(err).Warn("failed to get node notes")
+		} else {
+			dto.ApplyNodeNotes(roadmapWithProgressDTO.Nodes, notes)
+		}
+	}
 
 	logger.WithFields(map[string]interface{}{
 		"roadmap_id":       roadmapWithProgressDTO.ID.Hex(),
@@ -158,6 +204,19 @@ func (uc *RoadmapUsecase) GetByIDWithMaterials(ctx context.Context, roadmapID pr
 	return &dto.GetByIDRoadmapWithMaterialsResponseDTO{RoadmapWithMaterials: roadmapWithMaterialsDTO}, nil
 }
 
+func (uc *RoadmapUsecase) ListIDs(ctx context.Context, createdBefore time.Time) ([]primitive.ObjectID, error) {
+	const op = "RoadmapUsecase.ListIDs"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	ids, err := uc.mongoRepo.GetIDs(ctx, createdBefore)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap IDs")
+		return nil, fmt.Errorf("failed to get roadmap IDs: %w", err)
+	}
+
+	return ids, nil
+}
+
 func (uc *RoadmapUsecase) Create(ctx context.Context, req *dto.CreateRoadmapRequestDTO) (*dto.CreateRoadmapResponseDTO, error) {
 	const op = "RoadmapUsecase.Create"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -173,6 +232,21 @@ func (uc *RoadmapUsecase) Create(ctx context.Context, req *dto.CreateRoadmapRequ
 		return nil, fmt.Errorf("invalid request data")
 	}
 
+	// A caller that picks the roadmap ID itself may retry the create, so an
+	// existing roadmap with that ID is returned instead of failing.
+	if !roadmapEntity.ID.IsZero() {
+		existing, err := uc.mongoRepo.GetByID(ctx, roadmapEntity.ID)
+		if err != nil {
+			logger.WithError(err).Error("failed to check existing roadmap")
+			return nil, fmt.Errorf("failed to create roadmap: %w", err)
+		}
+
+		if existing != nil {
+			logger.WithField("roadmap_id", existing.ID.Hex()).Info("roadmap already exists")
+			return &dto.CreateRoadmapResponseDTO{RoadmapWithMaterials: dto.EntityToWithMaterialsDTO(existing)}, nil
+		}
+	}
+
 	err := uc.mongoRepo.Create(ctx, roadmapEntity)
 	if err != nil {
 		logger.WithError(err).Error("failed to create roadmap")
@@ -185,23 +259,6 @@ func (uc *RoadmapUsecase) Create(ctx context.Context, req *dto.CreateRoadmapRequ
 		return nil, fmt.Errorf("failed to create roadmap")
 	}
 
-	if req.IsPublic {
-		nodes := make([]dto.NodeDTO, len(req.Roadmap.NodesWithMaterials))
-		for i, node := range req.Roadmap.NodesWithMaterials {
-			nodes[i] = dto.NodeDTO{
-				ID:          node.ID,
-				Type:        node.Type,
-				Description: node.Description,
-				Position:    node.Position,
-				Data:        node.Data,
-				Measured:    node.Measured,
-				Selected:    node.Selected,
-				Dragging:    node.Dragging,
-			}
-		}
-		go uc.createNodeChats(context.Background(), req.AuthorID, dto.DTOToNodes(nodes))
-	}
-
 	logger.WithField("roadmap_id", roadmapDTO.ID.Hex()).Info("successfully created roadmap")
 	return &dto.CreateRoadmapResponseDTO{RoadmapWithMaterials: roadmapDTO}, nil
 }
@@ -214,7 +271,7 @@ func (uc *RoadmapUsecase) Update(ctx context.Context, userID uuid.UUID, roadmapI
 		"roadmap_id": roadmapID.Hex(),
 	})
 
-	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex()})
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
 	if err != nil {
 		logger.WithError(err).Error("failed to get roadmap info for authorization check")
 		return fmt.Errorf("failed to get roadmap info: %w", err)
@@ -230,11 +287,11 @@ func (uc *RoadmapUsecase) Update(ctx context.Context, userID uuid.UUID, roadmapI
 		return fmt.Errorf("attempt to update public roadmap")
 	}
 
-	if !uc.isUserOwner(roadmapInfo.RoadmapInfo, userID.String()) {
+	if !entities.CollaboratorRole(roadmapInfo.Role).CanEdit() {
 		logger.WithFields(map[string]interface{}{
 			"request_user_id": userID,
 			"author_id":       roadmapInfo.RoadmapInfo.Author.UserId,
-		}).Warn("user is not author of the roadmap")
+		}).Warn("user cannot edit the roadmap")
 		return errs.ErrForbidden
 	}
 
@@ -249,24 +306,44 @@ func (uc *RoadmapUsecase) Update(ctx context.Context, userID uuid.UUID, roadmapI
 		return errs.ErrNotFound
 	}
 
+	nodeIDMap, err := parseNodeIDMap(req.NodeIDMap)
+	if err != nil {
+		logger.WithError(err).Warn("invalid node id map")
+		return err
+	}
+
 	updatedEntity := dto.UpdateRequestToEntityWithMaterials(existingEntity, req)
 	if updatedEntity == nil {
 		logger.Warn("failed to apply updates to roadmap")
 		return fmt.Errorf("invalid update data")
 	}
 
+	if err := validatePrerequisites(updatedEntity); err != nil {
+		logger.WithError(err).Warn("invalid prerequisite edges")
+		return err
+	}
+
+	attributeEdits(existingEntity, updatedEntity, userID)
+
 	err = uc.moderateRoadmap(ctx, updatedEntity)
 	if err != nil {
 		logger.WithError(err).Warn("roadmap update rejected due to moderation")
 		return fmt.Errorf("moderation check failed: %w", err)
 	}
 
-	err = uc.mongoRepo.Update(ctx, updatedEntity)
+	saved, err := uc.mongoRepo.UpdateIfVersion(ctx, updatedEntity, existingEntity.Version)
 	if err != nil {
 		logger.WithError(err).Error("failed to update roadmap")
 		return fmt.Errorf("failed to update roadmap: %w", err)
 	}
 
+	if !saved {
+		logger.Warn("roadmap was modified concurrently")
+		return fmt.Errorf("attempt to update roadmap that was modified concurrently")
+	}
+
+	uc.remapRestructuredProgress(ctx, existingEntity, updatedEntity, nodeIDMap)
+
 	logger.Info("successfully updated roadmap")
 	return nil
 }
@@ -295,12 +372,12 @@ func (uc *RoadmapUsecase) Delete(ctx context.Context, roadmapID primitive.Object
 		return fmt.Errorf("failed to get roadmap info: %w", err)
 	}
 
+	// A roadmap without roadmap info is orphaned, e.g. by a failed fork. It is
+	// still deleted, and since it may have been public its node chats go too.
 	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
-		logger.Error("roadmap info connected with roadmap doesn't exist")
-		return errs.ErrNotFound
-	}
-
-	if roadmapInfo.RoadmapInfo.IsPublic {
+		logger.Warn("deleting roadmap without roadmap info")
+		go uc.deleteNodeChats(context.Background(), existing.Nodes)
+	} else if roadmapInfo.RoadmapInfo.IsPublic {
 		go uc.deleteNodeChats(context.Background(), existing.Nodes)
 	}
 
@@ -310,6 +387,10 @@ func (uc *RoadmapUsecase) Delete(ctx context.Context, roadmapID primitive.Object
 		return fmt.Errorf("failed to delete roadmap: %w", err)
 	}
 
+	if err := uc.mongoRepo.DeleteRoadmapLineage(ctx, roadmapID); err != nil {
+		logger.WithError(err).Warn("failed to delete roadmap lineage")
+	}
+
 	logger.Info("successfully deleted roadmap")
 	return nil
 }
@@ -324,7 +405,7 @@ func (uc *RoadmapUsecase) Generate(ctx context.Context, userID uuid.UUID, roadma
 
 	logger.Info("starting roadmap generation")
 
-	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex()})
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
 	if err != nil {
 		logger.WithError(err).Error("failed to get roadmap info for authorization check")
 		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
@@ -335,11 +416,11 @@ func (uc *RoadmapUsecase) Generate(ctx context.Context, userID uuid.UUID, roadma
 		return nil, errs.ErrNotFound
 	}
 
-	if !uc.isUserOwner(roadmapInfo.RoadmapInfo, userID.String()) {
+	if !entities.CollaboratorRole(roadmapInfo.Role).CanEdit() {
 		logger.WithFields(map[string]interface{}{
 			"request_user_id": userID,
 			"author_id":       roadmapInfo.RoadmapInfo.Author.UserId,
-		}).Warn("user is not author of the roadmap")
+		}).Warn("user cannot edit the roadmap")
 		return nil, errs.ErrForbidden
 	}
 
@@ -359,12 +440,15 @@ func (uc *RoadmapUsecase) Generate(ctx context.Context, userID uuid.UUID, roadma
 		Description: roadmapInfo.RoadmapInfo.Description,
 		Content:     req.Content,
 		Complexity:  req.Complexity,
+		Prompt:      uc.resolvePrompt(ctx, entities.PromptRoadmapGeneration, roadmapID.Hex()),
 	}
+	promptUsage := entities.NewPromptUsage(entities.PromptRoadmapGeneration, roadmapDTO.Prompt)
 
-	logger.Info("generating roadmap content with AI")
+	logger.WithField("prompt_version", promptUsage.Version).Info("generating roadmap content with AI")
 	generatedRoadmap, err := uc.gigachatWebapi.GenerateRoadmapContent(ctx, &roadmapDTO)
 	if err != nil {
 		logger.WithError(err).Error("failed to generate roadmap content with AI")
+		uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeFailed)
 		return nil, fmt.Errorf("failed to generate content: %w", err)
 	}
 
@@ -374,11 +458,15 @@ func (uc *RoadmapUsecase) Generate(ctx context.Context, userID uuid.UUID, roadma
 	}
 
 	updatedRoadmap := &entities.Roadmap{
-		ID:        existingRoadmap.ID,
-		Nodes:     generatedRoadmap.Nodes,
-		Edges:     generatedRoadmap.Edges,
-		CreatedAt: existingRoadmap.CreatedAt,
-		UpdatedAt: time.Now(),
+		ID:                   existingRoadmap.ID,
+		Nodes:                generatedRoadmap.Nodes,
+		Edges:                generatedRoadmap.Edges,
+		Prompt:               &promptUsage,
+		EnforcePrerequisites: existingRoadmap.EnforcePrerequisites,
+		UpdatedBy:            existingRoadmap.UpdatedBy,
+		Version:              existingRoadmap.Version,
+		CreatedAt:            existingRoadmap.CreatedAt,
+		UpdatedAt:            time.Now(),
 	}
 
 	if updatedRoadmap.Nodes == nil {
@@ -389,12 +477,21 @@ func (uc *RoadmapUsecase) Generate(ctx context.Context, userID uuid.UUID, roadma
 	}
 
 	logger.Info("saving generated roadmap to database")
-	err = uc.mongoRepo.Update(ctx, updatedRoadmap)
+	saved, err := uc.mongoRepo.UpdateIfVersion(ctx, updatedRoadmap, existingRoadmap.Version)
 	if err != nil {
 		logger.WithError(err).Error("failed to save generated roadmap")
 		return nil, fmt.Errorf("failed to save roadmap: %w", err)
 	}
 
+	if !saved {
+		logger.Warn("roadmap was modified concurrently")
+		return nil, fmt.Errorf("attempt to update roadmap that was modified concurrently")
+	}
+
+	uc.remapRestructuredProgress(ctx, existingRoadmap, updatedRoadmap, nil)
+
+	uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeGenerated)
+
 	if roadmapInfo.RoadmapInfo.IsPublic {
 		go uc.createNodeChats(context.Background(), userID, generatedRoadmap.Nodes)
 	}
@@ -411,18 +508,302 @@ func (uc *RoadmapUsecase) Generate(ctx context.Context, userID uuid.UUID, roadma
 	return response, nil
 }
 
-func (uc *RoadmapUsecase) RegenerateNodeIDs(roadmapDTO *dto.RoadmapWithMaterialsDTO) *dto.RoadmapWithMaterialsDTO {
+func (uc *RoadmapUsecase) RegenerateNode(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.RegenerateNodeRequestDTO) (*dto.RegenerateNodeResponseDTO, error) {
+	const op = "RoadmapUsecase.RegenerateNode"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+		"user_id":    userID,
+	})
+
+	if req.Mode == "" {
+		req.Mode = dto.RegenerationModeReplace
+	}
+
+	if !req.Mode.IsValid() {
+		logger.WithField("mode", req.Mode).Warn("invalid regeneration mode provided")
+		return nil, fmt.Errorf("invalid regeneration mode: %s", req.Mode)
+	}
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info for authorization check")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		logger.Error("roadmap info connected with roadmap doesn't exist")
+		return nil, errs.ErrNotFound
+	}
+
+	if !entities.CollaboratorRole(roadmapInfo.Role).CanEdit() {
+		logger.WithFields(map[string]interface{}{
+			"request_user_id": userID,
+			"author_id":       roadmapInfo.RoadmapInfo.Author.UserId,
+		}).Warn("user cannot edit the roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	existingRoadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get existing roadmap")
+		return nil, fmt.Errorf("failed to get existing roadmap: %w", err)
+	}
+
+	if existingRoadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	graph := newRoadmapGraph(existingRoadmap)
+	targetNode, exists := graph.nodes[nodeID.String()]
+	if !exists {
+		logger.Warn("node not found in roadmap")
+		return nil, errs.ErrNotFound
+	}
+
+	subtreeIDs := graph.subtree(nodeID.String())
+
+	subtreeDTO := dto.RegenerateSubtreeDTO{
+		Topic:        roadmapInfo.RoadmapInfo.Name,
+		Description:  roadmapInfo.RoadmapInfo.Description,
+		Complexity:   req.Complexity,
+		Instructions: req.Instructions,
+		Mode:         req.Mode,
+		Node:         dto.NodesToDTO([]entities.RoadmapNode{targetNode})[0],
+		Ancestors:    dto.NodesToDTO(graph.ancestors(nodeID.String())),
+		Siblings:     dto.NodesToDTO(graph.siblings(nodeID.String())),
+		Subtree:      dto.NodesToDTO(graph.nodesByIDs(subtreeIDs)),
+		Prompt:       uc.resolvePrompt(ctx, entities.PromptRoadmapRegeneration, roadmapID.Hex()),
+	}
+	promptUsage := entities.NewPromptUsage(entities.PromptRoadmapRegeneration, subtreeDTO.Prompt)
+
+	logger.WithFields(map[string]interface{}{
+		"subtree_size":   len(subtreeIDs),
+		"prompt_version": promptUsage.Version,
+	}).Info("regenerating roadmap subtree with AI")
+	generatedSubtree, err := uc.gigachatWebapi.RegenerateSubtreeContent(ctx, &subtreeDTO)
+	if err != nil {
+		logger.WithError(err).Error("failed to regenerate subtree content with AI")
+		uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeFailed)
+		return nil, fmt.Errorf("failed to generate content: %w", err)
+	}
+
+	if generatedSubtree == nil || len(generatedSubtree.Nodes) == 0 {
+		logger.Error("AI generated subtree is empty")
+		return nil, fmt.Errorf("failed to generate subtree content")
+	}
+
+	addedNodes, addedEdges := graftSubtree(targetNode, generatedSubtree)
+	if len(addedNodes) == 0 {
+		logger.Error("AI generated subtree has no usable nodes")
+		return nil, fmt.Errorf("failed to generate subtree content")
+	}
+
+	err = uc.moderateRoadmap(ctx, &entities.Roadmap{Nodes: addedNodes})
+	if err != nil {
+		logger.WithError(err).Warn("regenerated subtree rejected due to moderation")
+		return nil, fmt.Errorf("moderation check failed: %w", err)
+	}
+
+	removedIDs := map[string]bool{}
+	if req.Mode == dto.RegenerationModeReplace {
+		removedIDs = subtreeIDs
+	}
+
+	updatedRoadmap := &entities.Roadmap{
+		ID:                   existingRoadmap.ID,
+		Nodes:                make([]entities.RoadmapNode, 0, len(existingRoadmap.Nodes)+len(addedNodes)),
+		Edges:                make([]entities.RoadmapEdge, 0, len(existingRoadmap.Edges)+len(addedEdges)),
+		Prompt:               &promptUsage,
+		EnforcePrerequisites: existingRoadmap.EnforcePrerequisites,
+		UpdatedBy:            existingRoadmap.UpdatedBy,
+		Version:              existingRoadmap.Version,
+		CreatedAt:            existingRoadmap.CreatedAt,
+		UpdatedAt:            time.Now(),
+	}
+
+	var removedNodes []entities.RoadmapNode
+	for _, node := range existingRoadmap.Nodes {
+		if removedIDs[node.ID.String()] {
+			removedNodes = append(removedNodes, node)
+			continue
+		}
+		updatedRoadmap.Nodes = append(updatedRoadmap.Nodes, node)
+	}
+	updatedRoadmap.Nodes = append(updatedRoadmap.Nodes, addedNodes...)
+
+	for _, edge := range existingRoadmap.Edges {
+		if removedIDs[edge.Source] || removedIDs[edge.Target] {
+			continue
+		}
+		updatedRoadmap.Edges = append(updatedRoadmap.Edges, edge)
+	}
+	updatedRoadmap.Edges = append(updatedRoadmap.Edges, addedEdges...)
+
+	attributeEdits(existingRoadmap, updatedRoadmap, userID)
+
+	logger.Info("saving regenerated subtree to database")
+	saved, err := uc.mongoRepo.UpdateIfVersion(ctx, updatedRoadmap, existingRoadmap.Version)
+	if err != nil {
+		logger.WithError(err).Error("failed to save regenerated roadmap")
+		return nil, fmt.Errorf("failed to save roadmap: %w", err)
+	}
+
+	if !saved {
+		logger.Warn("roadmap was modified concurrently")
+		return nil, fmt.Errorf("attempt to update roadmap that was modified concurrently")
+	}
+
+	uc.remapRestructuredProgress(ctx, existingRoadmap, updatedRoadmap, nil)
+
+	uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeGenerated)
+
+	if roadmapInfo.RoadmapInfo.IsPublic {
+		go uc.createNodeChats(context.Background(), userID, addedNodes)
+		if len(removedNodes) > 0 {
+			go uc.deleteNodeChats(context.Background(), removedNodes)
+		}
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"mode":          req.Mode,
+		"added_nodes":   len(addedNodes),
+		"removed_nodes": len(removedNodes),
+	}).Info("successfully regenerated roadmap subtree")
+
+	return &dto.RegenerateNodeResponseDTO{
+		RoadmapID:    updatedRoadmap.ID,
+		NodeID:       nodeID,
+		AddedNodes:   len(addedNodes),
+		RemovedNodes: len(removedNodes),
+	}, nil
+}
+
+func (uc *RoadmapUsecase) SuggestNodeContent(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.SuggestNodeContentRequestDTO) (*dto.SuggestNodeContentResponseDTO, error) {
+	const op = "RoadmapUsecase.SuggestNodeContent"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID.String(),
+		"user_id":    userID,
+	})
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info for authorization check")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		logger.Error("roadmap info connected with roadmap doesn't exist")
+		return nil, errs.ErrNotFound
+	}
+
+	if !entities.CollaboratorRole(roadmapInfo.Role).CanEdit() {
+		logger.WithFields(map[string]interface{}{
+			"request_user_id": userID,
+			"author_id":       roadmapInfo.RoadmapInfo.Author.UserId,
+		}).Warn("user cannot edit the roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	existingRoadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get existing roadmap")
+		return nil, fmt.Errorf("failed to get existing roadmap: %w", err)
+	}
+
+	if existingRoadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	graph := newRoadmapGraph(existingRoadmap)
+	targetNode, exists := graph.nodes[nodeID.String()]
+	if !exists {
+		logger.Warn("node not found in roadmap")
+		return nil, errs.ErrNotFound
+	}
+
+	suggestionsDTO := dto.NodeSuggestionsDTO{
+		Topic:        roadmapInfo.RoadmapInfo.Name,
+		Description:  roadmapInfo.RoadmapInfo.Description,
+		Complexity:   req.Complexity,
+		Instructions: req.Instructions,
+		MaxMaterials: maxSuggestedMaterials,
+		Node:         dto.NodesToDTO([]entities.RoadmapNode{targetNode})[0],
+		Ancestors:    dto.NodesToDTO(graph.ancestors(nodeID.String())),
+		Prompt:       uc.resolvePrompt(ctx, entities.PromptNodeSuggestions, roadmapID.Hex()),
+	}
+	promptUsage := entities.NewPromptUsage(entities.PromptNodeSuggestions, suggestionsDTO.Prompt)
+
+	logger.WithField("prompt_version", promptUsage.Version).Info("suggesting node content with AI")
+	suggestions, err := uc.gigachatWebapi.SuggestNodeContent(ctx, &suggestionsDTO)
+	if err != nil {
+		logger.WithError(err).Error("failed to suggest node content with AI")
+		uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeFailed)
+		return nil, fmt.Errorf("failed to generate content: %w", err)
+	}
+	uc.promptUsecase.RecordUsage(ctx, promptUsage, roadmapID.Hex(), entities.PromptOutcomeGenerated)
+
+	description := suggestions.Description
+	if description != "" {
+		err = uc.moderateRoadmap(ctx, &entities.Roadmap{Nodes: []entities.RoadmapNode{{Description: description}}})
+		if errors.Is(err, errModerationRejected) {
+			logger.WithError(err).Warn("suggested description rejected due to moderation")
+			description = ""
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to moderate suggestions: %w", err)
+		}
+	}
+
+	materials := make([]entities.MaterialSuggestion, 0, len(suggestions.Materials))
+	rejected := 0
+	for _, suggestion := range suggestions.Materials {
+		err = uc.moderateMaterial(ctx, &entities.Material{Name: suggestion.Name, URL: suggestion.URL})
+		if errors.Is(err, errModerationRejected) {
+			logger.WithError(err).WithField("url", suggestion.URL).Warn("suggested material rejected due to moderation")
+			rejected++
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to moderate suggestions: %w", err)
+		}
+		materials = append(materials, suggestion)
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"has_description":    description != "",
+		"materials_count":    len(materials),
+		"rejected_materials": rejected,
+	}).Info("successfully suggested node content")
+
+	return &dto.SuggestNodeContentResponseDTO{
+		RoadmapID:         roadmapID,
+		NodeID:            nodeID,
+		Description:       description,
+		Materials:         dto.MaterialSuggestionsToDTO(materials),
+		RejectedMaterials: rejected,
+	}, nil
+}
+
+// RegenerateNodeIDs gives every node and edge of the roadmap a new ID. Besides
+// the copy it returns the mapping from old to new node IDs.
+func (uc *RoadmapUsecase) RegenerateNodeIDs(roadmapDTO *dto.RoadmapWithMaterialsDTO) (*dto.RoadmapWithMaterialsDTO, map[uuid.UUID]uuid.UUID) {
 	if roadmapDTO == nil {
-		return nil
+		return nil, nil
 	}
 
 	nodeIDMap := make(map[string]string)
+	regeneratedIDs := make(map[uuid.UUID]uuid.UUID, len(roadmapDTO.NodesWithMaterials))
 
 	regeneratedNodes := make([]dto.NodeWithMaterialsDTO, 0, len(roadmapDTO.NodesWithMaterials))
 	for _, node := range roadmapDTO.NodesWithMaterials {
 		oldID := node.ID
 		newID := uuid.New()
 		nodeIDMap[oldID.String()] = newID.String()
+		regeneratedIDs[oldID] = newID
 
 		regeneratedNode := dto.NodeWithMaterialsDTO{
 			ID:          newID,
@@ -442,9 +823,10 @@ func (uc *RoadmapUsecase) RegenerateNodeIDs(roadmapDTO *dto.RoadmapWithMaterials
 	regeneratedEdges := make([]dto.EdgeDTO, 0, len(roadmapDTO.Edges))
 	for _, edge := range roadmapDTO.Edges {
 		regeneratedEdge := dto.EdgeDTO{
-			ID:     uuid.New().String(),
-			Source: edge.Source,
-			Target: edge.Target,
+			ID:           uuid.New().String(),
+			Source:       edge.Source,
+			Target:       edge.Target,
+			Prerequisite: edge.Prerequisite,
 		}
 
 		if newSourceID, exists := nodeIDMap[edge.Source]; exists {
@@ -463,14 +845,18 @@ func (uc *RoadmapUsecase) RegenerateNodeIDs(roadmapDTO *dto.RoadmapWithMaterials
 		Edges:              regeneratedEdges,
 		CreatedAt:          roadmapDTO.CreatedAt,
 		UpdatedAt:          roadmapDTO.UpdatedAt,
-	}
+	}, regeneratedIDs
 }
 
-func (uc *RoadmapUsecase) isUserOwner(roadmapInfo *roadmapinfoclient.RoadmapInfo, userID string) bool {
-	if roadmapInfo == nil {
-		return false
+// resolvePrompt returns the prompt template version to use, or nil when the
+// built-in template should be used instead.
+func (uc *RoadmapUsecase) resolvePrompt(ctx context.Context, name string, subjectKey string) *entities.PromptTemplate {
+	tmpl, err := uc.promptUsecase.Resolve(ctx, name, subjectKey)
+	if err != nil {
+		ctxutil.GetLogger(ctx).WithError(err).WithField("prompt", name).Warn("failed to resolve prompt template, using built-in one")
+		return nil
 	}
-	return roadmapInfo.Author.UserId == userID
+	return tmpl
 }
 
 func (uc *RoadmapUsecase) createNodeChats(ctx context.Context, userID uuid.UUID, nodes []entities.RoadmapNode) {
@@ -518,51 +904,36 @@ func (uc *RoadmapUsecase) CreateMaterial(ctx context.Context, userID uuid.UUID,
 	const op = "RoadmapUsecase.CreateMaterial"
 	logger := ctxutil.GetLogger(ctx).WithField("op", op)
 
-	roadmapEntity, err := uc.mongoRepo.GetByID(ctx, roadmapID)
-	if err != nil {
-		logger.WithError(err).Error("failed to get roadmap")
-		return nil, fmt.Errorf("failed to get roadmap: %w", err)
-	}
-
-	if roadmapEntity == nil {
-		logger.Warn("roadmap not found")
-		return nil, errs.ErrNotFound
+	materialEntity := dto.CreateMaterialRequestToEntity(req, userID)
+	if !materialEntity.Type.IsValid() {
+		logger.WithField("type", req.Type).Warn("invalid material type provided")
+		return nil, fmt.Errorf("invalid material type: %s", req.Type)
 	}
 
-	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex()})
-	if err != nil {
-		logger.WithError(err).Error("failed to get roadmap info for authorization check")
-		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	if materialEntity.Type == entities.MaterialTypeFile {
+		logger.Warn("file material provided without upload")
+		return nil, fmt.Errorf("invalid material type: files must be uploaded")
 	}
 
-	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
-		logger.Error("roadmap info not found")
-		return nil, errs.ErrNotFound
+	if err := validateMaterialMetadata(materialEntity); err != nil {
+		logger.WithError(err).Warn("invalid material metadata provided")
+		return nil, err
 	}
 
-	if !roadmapInfo.RoadmapInfo.IsPublic && !uc.isUserOwner(roadmapInfo.RoadmapInfo, userID.String()) {
-		logger.WithFields(map[string]interface{}{
-			"request_user_id": userID,
-			"author_id":       roadmapInfo.RoadmapInfo.Author.UserId,
-		}).Warn("user is not author of the roadmap")
-		return nil, errs.ErrForbidden
+	if err := uc.ensureCanAddMaterial(ctx, userID, roadmapID, nodeID); err != nil {
+		return nil, err
 	}
 
-	nodeExists := false
-	for _, node := range roadmapEntity.Nodes {
-		if node.ID == nodeID {
-			nodeExists = true
-			break
-		}
+	preview, err := uc.unfurlWebapi.Unfurl(ctx, materialEntity.URL)
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch material link preview, creating without it")
 	}
+	materialEntity.Preview = preview
 
-	if !nodeExists {
-		logger.WithField("node_id", nodeID).Warn("node not found in roadmap")
-		return nil, errs.ErrNotFound
+	if strings.TrimSpace(materialEntity.Name) == "" {
+		materialEntity.Name = materialNameFallback(materialEntity)
 	}
 
-	materialEntity := dto.CreateMaterialRequestToEntity(req, userID)
-
 	err = uc.moderateMaterial(ctx, materialEntity)
 	if err != nil {
 		logger.WithError(err).Warn("material creation rejected due to moderation")
@@ -581,6 +952,10 @@ func (uc *RoadmapUsecase) CreateMaterial(ctx context.Context, userID uuid.UUID,
 		authorData = uc.createFallbackAuthorData(userID)
 	}
 
+	if err := uc.gamificationPublisher.MaterialAdded(ctx, userID.String(), roadmapID.Hex(), createdMaterial.ID.String()); err != nil {
+		logger.WithError(err).Warn("failed to publish material added event")
+	}
+
 	response := dto.MaterialToEnrichedDTO(createdMaterial, authorData)
 
 	logger.WithFields(map[string]interface{}{
@@ -593,41 +968,166 @@ func (uc *RoadmapUsecase) CreateMaterial(ctx context.Context, userID uuid.UUID,
 	return &response, nil
 }
 
-func (uc *RoadmapUsecase) DeleteMaterial(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, userID uuid.UUID) error {
-	const op = "RoadmapUsecase.DeleteMaterial"
-	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+func (uc *RoadmapUsecase) UploadMaterial(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.UploadMaterialRequestDTO) (*dto.EnrichedMaterialResponseDTO, error) {
+	const op = "RoadmapUsecase.UploadMaterial"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+		"user_id":    userID,
+	})
 
-	roadmapEntity, err := uc.mongoRepo.GetByID(ctx, roadmapID)
-	if err != nil {
-		logger.WithError(err).Error("failed to get roadmap")
-		return fmt.Errorf("failed to get roadmap: %w", err)
+	if req.Name == "" {
+		req.Name = req.FileName
 	}
 
-	if roadmapEntity == nil {
-		logger.Warn("roadmap not found")
-		return errs.ErrNotFound
+	materialEntity := dto.UploadMaterialRequestToEntity(req, userID)
+	if err := validateMaterialMetadata(materialEntity); err != nil {
+		logger.WithError(err).Warn("invalid material metadata provided")
+		return nil, err
 	}
 
-	nodeExists := false
-	for _, node := range roadmapEntity.Nodes {
-		if node.ID == nodeID {
-			nodeExists = true
-			break
-		}
+	if err := uc.ensureCanAddMaterial(ctx, userID, roadmapID, nodeID); err != nil {
+		return nil, err
 	}
 
-	if !nodeExists {
-		logger.WithField("node_id", nodeID).Warn("node not found in roadmap")
-		return errs.ErrNotFound
+	err := uc.moderateMaterial(ctx, materialEntity)
+	if err != nil {
+		logger.WithError(err).Warn("material upload rejected due to moderation")
+		return nil, fmt.Errorf("moderation check failed: %w", err)
 	}
 
-	material, err := uc.mongoRepo.GetMaterialByID(ctx, roadmapID, nodeID, materialID)
+	uploadInfo, err := uc.awsRepo.PutObject(ctx, *dto.UploadMaterialRequestToUploadInputEntity(req))
 	if err != nil {
-		logger.WithError(err).Error("failed to get material")
-		return fmt.Errorf("failed to get material: %w", err)
+		logger.WithError(err).Error("failed to upload material to storage")
+		return nil, fmt.Errorf("failed to upload material to storage: %w", err)
 	}
 
-	if material == nil {
+	materialEntity.URL = uc.generateAWSMinioURL(req.BucketName, uploadInfo.Key)
+	materialEntity.File = &entities.MaterialFile{
+		Bucket:      req.BucketName,
+		Key:         uploadInfo.Key,
+		Name:        req.FileName,
+		Size:        req.Size,
+		ContentType: req.ContentType,
+	}
+
+	createdMaterial, err := uc.mongoRepo.CreateMaterial(ctx, roadmapID, nodeID, materialEntity)
+	if err != nil {
+		logger.WithError(err).Error("failed to create material")
+
+		if cleanupErr := uc.awsRepo.RemoveObject(ctx, req.BucketName, uploadInfo.Key); cleanupErr != nil {
+			logger.WithError(cleanupErr).Error("failed to cleanup uploaded material after create failure")
+		}
+
+		return nil, fmt.Errorf("failed to create material: %w", err)
+	}
+
+	authorData, err := uc.fetchAuthorData(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch author data, using fallback")
+		authorData = uc.createFallbackAuthorData(userID)
+	}
+
+	if err := uc.gamificationPublisher.MaterialAdded(ctx, userID.String(), roadmapID.Hex(), createdMaterial.ID.String()); err != nil {
+		logger.WithError(err).Warn("failed to publish material added event")
+	}
+
+	response := dto.MaterialToEnrichedDTO(createdMaterial, authorData)
+
+	logger.WithFields(map[string]interface{}{
+		"material_id": createdMaterial.ID,
+		"url":         createdMaterial.URL,
+	}).Info("successfully uploaded material")
+
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) ensureCanAddMaterial(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	const op = "RoadmapUsecase.ensureCanAddMaterial"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapEntity, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmapEntity == nil {
+		logger.Warn("roadmap not found")
+		return errs.ErrNotFound
+	}
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info for authorization check")
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		logger.Error("roadmap info not found")
+		return errs.ErrNotFound
+	}
+
+	if !roadmapInfo.RoadmapInfo.IsPublic && !entities.CollaboratorRole(roadmapInfo.Role).CanEdit() {
+		logger.WithFields(map[string]interface{}{
+			"request_user_id": userID,
+			"author_id":       roadmapInfo.RoadmapInfo.Author.UserId,
+		}).Warn("user cannot edit the roadmap")
+		return errs.ErrForbidden
+	}
+
+	nodeExists := false
+	for _, node := range roadmapEntity.Nodes {
+		if node.ID == nodeID {
+			nodeExists = true
+			break
+		}
+	}
+
+	if !nodeExists {
+		logger.WithField("node_id", nodeID).Warn("node not found in roadmap")
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+func (uc *RoadmapUsecase) DeleteMaterial(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, userID uuid.UUID) error {
+	const op = "RoadmapUsecase.DeleteMaterial"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	roadmapEntity, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmapEntity == nil {
+		logger.Warn("roadmap not found")
+		return errs.ErrNotFound
+	}
+
+	nodeExists := false
+	for _, node := range roadmapEntity.Nodes {
+		if node.ID == nodeID {
+			nodeExists = true
+			break
+		}
+	}
+
+	if !nodeExists {
+		logger.WithField("node_id", nodeID).Warn("node not found in roadmap")
+		return errs.ErrNotFound
+	}
+
+	material, err := uc.mongoRepo.GetMaterialByID(ctx, roadmapID, nodeID, materialID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get material")
+		return fmt.Errorf("failed to get material: %w", err)
+	}
+
+	if material == nil {
 		logger.WithField("material_id", materialID).Warn("material not found")
 		return errs.ErrNotFound
 	}
@@ -648,6 +1148,16 @@ func (uc *RoadmapUsecase) DeleteMaterial(ctx context.Context, roadmapID primitiv
 		return fmt.Errorf("failed to delete material: %w", err)
 	}
 
+	if err := uc.mongoRepo.DeleteMaterialVotes(ctx, materialID); err != nil {
+		logger.WithError(err).Warn("failed to delete material votes")
+	}
+
+	if material.File != nil {
+		if err := uc.awsRepo.RemoveObject(ctx, material.File.Bucket, material.File.Key); err != nil {
+			logger.WithError(err).Warn("failed to remove material file from storage")
+		}
+	}
+
 	logger.WithFields(map[string]interface{}{
 		"material_id": materialID,
 		"roadmap_id":  roadmapID.Hex(),
@@ -658,7 +1168,7 @@ func (uc *RoadmapUsecase) DeleteMaterial(ctx context.Context, roadmapID primitiv
 	return nil
 }
 
-func (uc *RoadmapUsecase) GetMaterialsByNode(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*dto.MaterialListResponseDTO, error) {
+func (uc *RoadmapUsecase) GetMaterialsByNode(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) (*dto.MaterialListResponseDTO, error) {
 	const op = "RoadmapUsecase.GetMaterialsByNode"
 	logger := ctxutil.GetLogger(ctx).WithField("op", op)
 
@@ -701,7 +1211,23 @@ func (uc *RoadmapUsecase) GetMaterialsByNode(ctx context.Context, roadmapID prim
 		logger.WithError(err).Warn("failed to fetch some author data, using fallback")
 	}
 
-	response := dto.MaterialListToEnrichedDTO(materials, authorData)
+	voteStats, err := uc.mongoRepo.GetMaterialVoteStats(ctx, roadmapID, nodeID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get material vote stats, using insertion order")
+		voteStats = map[uuid.UUID]*entities.MaterialVoteStats{}
+	}
+
+	var myVotes map[uuid.UUID]*entities.MaterialVote
+	if userID != uuid.Nil {
+		myVotes, err = uc.mongoRepo.GetUserMaterialVotes(ctx, userID, roadmapID, nodeID)
+		if err != nil {
+			logger.WithError(err).Warn("failed to get user material votes")
+		}
+	}
+
+	sortMaterialsByVotes(materials, voteStats)
+
+	response := dto.MaterialListToEnrichedDTO(materials, authorData, voteStats, myVotes)
 
 	logger.WithFields(map[string]interface{}{
 		"roadmap_id": roadmapID.Hex(),
@@ -712,6 +1238,137 @@ func (uc *RoadmapUsecase) GetMaterialsByNode(ctx context.Context, roadmapID prim
 	return &response, nil
 }
 
+func (uc *RoadmapUsecase) VoteMaterial(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID, req *dto.VoteMaterialRequestDTO) (*dto.VoteMaterialResponseDTO, error) {
+	const op = "RoadmapUsecase.VoteMaterial"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"user_id":     userID,
+		"roadmap_id":  roadmapID.Hex(),
+		"node_id":     nodeID,
+		"material_id": materialID,
+		"vote":        req.Vote,
+		"helpful":     req.Helpful,
+	})
+
+	if !req.Vote.IsValid() {
+		logger.Warn("invalid vote value provided")
+		return nil, fmt.Errorf("invalid vote value: %s", req.Vote)
+	}
+
+	material, err := uc.mongoRepo.GetMaterialByID(ctx, roadmapID, nodeID, materialID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get material")
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+
+	if material == nil {
+		logger.Warn("material not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if material.AuthorID == userID {
+		logger.Warn("user tried to vote for their own material")
+		return nil, fmt.Errorf("invalid vote: cannot vote for own material")
+	}
+
+	vote := dto.VoteMaterialRequestToEntity(req, userID, roadmapID, nodeID, materialID)
+
+	if vote.IsEmpty() {
+		err = uc.mongoRepo.DeleteMaterialVote(ctx, userID, materialID)
+	} else {
+		err = uc.mongoRepo.UpsertMaterialVote(ctx, vote)
+	}
+	if err != nil {
+		logger.WithError(err).Error("failed to save material vote")
+		return nil, fmt.Errorf("failed to save material vote: %w", err)
+	}
+
+	response, err := uc.buildVoteMaterialResponse(ctx, userID, roadmapID, nodeID, materialID)
+	if err != nil {
+		logger.WithError(err).Error("failed to build vote response")
+		return nil, err
+	}
+
+	logger.Info("successfully voted for material")
+	return response, nil
+}
+
+func (uc *RoadmapUsecase) DeleteMaterialVote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID) (*dto.VoteMaterialResponseDTO, error) {
+	const op = "RoadmapUsecase.DeleteMaterialVote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"user_id":     userID,
+		"roadmap_id":  roadmapID.Hex(),
+		"node_id":     nodeID,
+		"material_id": materialID,
+	})
+
+	material, err := uc.mongoRepo.GetMaterialByID(ctx, roadmapID, nodeID, materialID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get material")
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+
+	if material == nil {
+		logger.Warn("material not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if err := uc.mongoRepo.DeleteMaterialVote(ctx, userID, materialID); err != nil {
+		logger.WithError(err).Error("failed to delete material vote")
+		return nil, fmt.Errorf("failed to delete material vote: %w", err)
+	}
+
+	response, err := uc.buildVoteMaterialResponse(ctx, userID, roadmapID, nodeID, materialID)
+	if err != nil {
+		logger.WithError(err).Error("failed to build vote response")
+		return nil, err
+	}
+
+	logger.Info("successfully removed material vote")
+	return response, nil
+}
+
+func (uc *RoadmapUsecase) buildVoteMaterialResponse(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, materialID uuid.UUID) (*dto.VoteMaterialResponseDTO, error) {
+	voteStats, err := uc.mongoRepo.GetMaterialVoteStats(ctx, roadmapID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material vote stats: %w", err)
+	}
+
+	myVote, err := uc.mongoRepo.GetMaterialVote(ctx, userID, materialID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material vote: %w", err)
+	}
+
+	return &dto.VoteMaterialResponseDTO{
+		MaterialID: materialID,
+		Votes:      dto.MaterialVoteStatsToDTO(voteStats[materialID]),
+		MyVote:     dto.MaterialVoteToMyVoteDTO(myVote),
+	}, nil
+}
+
+// sortMaterialsByVotes orders materials by Wilson score, then by helpful marks,
+// keeping insertion order for materials nobody has voted on yet.
+func sortMaterialsByVotes(materials []*entities.Material, voteStats map[uuid.UUID]*entities.MaterialVoteStats) {
+	sort.SliceStable(materials, func(i, j int) bool {
+		left, right := voteStats[materials[i].ID], voteStats[materials[j].ID]
+
+		leftScore, rightScore := left.WilsonScore(), right.WilsonScore()
+		if leftScore != rightScore {
+			return leftScore > rightScore
+		}
+
+		return helpfulCount(left) > helpfulCount(right)
+	})
+}
+
+func helpfulCount(stats *entities.MaterialVoteStats) int {
+	if stats == nil {
+		return 0
+	}
+	return stats.Helpful
+}
+
 func (uc *RoadmapUsecase) UpdateNodeProgress(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, req *dto.UpdateNodeProgressRequestDTO) error {
 	const op = "RoadmapUsecase.UpdateNodeProgress"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -722,9 +1379,29 @@ func (uc *RoadmapUsecase) UpdateNodeProgress(ctx context.Context, userID uuid.UU
 		"status":     req.Status,
 	})
 
-	if !req.Status.IsValid() {
-		logger.Warn("invalid progress status provided")
-		return fmt.Errorf("invalid progress status: %s", req.Status)
+	var status entities.NodeProgressStatus
+	if req.Status != "" {
+		parsed, ok := entities.ParseNodeProgressStatus(string(req.Status))
+		if !ok {
+			logger.Warn("invalid progress status provided")
+			return fmt.Errorf("invalid progress status: %s", req.Status)
+		}
+		status = parsed
+	}
+
+	if req.TimeSpentMinutes < 0 || req.TimeSpentMinutes > maxLoggedMinutes {
+		logger.Warn("invalid time spent provided")
+		return fmt.Errorf("invalid time spent: must be between 0 and %d minutes", maxLoggedMinutes)
+	}
+
+	if req.Confidence != nil && (*req.Confidence < minConfidence || *req.Confidence > maxConfidence) {
+		logger.Warn("invalid confidence provided")
+		return fmt.Errorf("invalid confidence: must be between %d and %d", minConfidence, maxConfidence)
+	}
+
+	if status == "" && req.TimeSpentMinutes == 0 && req.Confidence == nil {
+		logger.Warn("empty progress update provided")
+		return fmt.Errorf("invalid progress update: status, time spent or confidence is required")
 	}
 
 	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
@@ -733,36 +1410,516 @@ func (uc *RoadmapUsecase) UpdateNodeProgress(ctx context.Context, userID uuid.UU
 		return fmt.Errorf("failed to get roadmap: %w", err)
 	}
 
+	if roadmap == nil || !roadmapHasNode(roadmap, req.NodeID) {
+		logger.Warn("roadmap or node not found")
+		return errs.ErrNotFound
+	}
+
+	userProgress, err := uc.getUserProgress(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user progress")
+		return fmt.Errorf("failed to get user progress: %w", err)
+	}
+
+	if roadmap.EnforcePrerequisites && (status == entities.NodeProgressInProgress || status == entities.NodeProgressDone) {
+		if requiredIDs := lockedNodes(roadmap, userProgress)[req.NodeID]; len(requiredIDs) > 0 {
+			logger.WithField("locked_by", requiredIDs).Warn("attempt to progress on locked node")
+			return fmt.Errorf("invalid progress update: node is locked until its prerequisites are finished")
+		}
+	}
+
+	current, exists := userProgress.Progress[req.NodeID]
+	if !exists || current.Status == "" {
+		current.Status = entities.NodeProgressPending
+	}
+
+	next, events := applyProgressUpdate(current, status, req.TimeSpentMinutes, req.Confidence, time.Now())
+	if len(events) == 0 {
+		logger.Debug("progress is unchanged")
+		return nil
+	}
+
+	if err := uc.mongoRepo.UpsertUserProgress(ctx, userID, roadmapID, req.NodeID, next); err != nil {
+		logger.WithError(err).Error("failed to update node progress")
+		return fmt.Errorf("failed to update node progress: %w", err)
+	}
+
+	for _, event := range events {
+		event.UserID = userID
+		event.RoadmapID = roadmapID
+		event.NodeID = req.NodeID
+	}
+
+	if err := uc.mongoRepo.AppendProgressEvents(ctx, events); err != nil {
+		logger.WithError(err).Error("failed to append progress events")
+		return fmt.Errorf("failed to append progress events: %w", err)
+	}
+
+	if next.Status == entities.NodeProgressDone && current.Status != entities.NodeProgressDone {
+		if err := uc.gamificationPublisher.NodeCompleted(ctx, userID.String(), roadmapID.Hex(), req.NodeID.String()); err != nil {
+			logger.WithError(err).Warn("failed to publish node completed event")
+		}
+	}
+
+	// Skipping a secondary node can finish the roadmap just like completing one.
+	finishing := next.Status == entities.NodeProgressDone || next.Status == entities.NodeProgressSkipped
+	if finishing && next.Status != current.Status {
+		wasCompleted := isRoadmapCompleted(roadmap, userProgress)
+		userProgress.Progress[req.NodeID] = next
+		if !wasCompleted && isRoadmapCompleted(roadmap, userProgress) {
+			if issued, err := uc.issueCertificate(ctx, userID, roadmap); err != nil {
+				logger.WithError(err).Warn("failed to issue completion certificate")
+			} else {
+				logger.WithField("certificate_id", issued.ID.String()).Info("roadmap completed, certificate issued")
+			}
+
+			if err := uc.gamificationPublisher.RoadmapCompleted(ctx, userID.String(), roadmapID.Hex()); err != nil {
+				logger.WithError(err).Warn("failed to publish roadmap completed event")
+			}
+		}
+	}
+
+	if next.Status != current.Status {
+		uc.syncCalendarFeed(ctx, userID)
+	}
+
+	logger.WithField("events", len(events)).Info("successfully updated node progress")
+	return nil
+}
+
+// applyProgressUpdate returns the new node progress together with the history
+// events describing what changed. Timestamps follow the status: starting work
+// sets started_at, finishing sets completed_at and resetting clears both.
+func applyProgressUpdate(current entities.NodeProgress, status entities.NodeProgressStatus, timeSpent int, confidence *int, now time.Time) (entities.NodeProgress, []*entities.ProgressEvent) {
+	next := current
+	var events []*entities.ProgressEvent
+
+	if status != "" && status != current.Status {
+		next.Status = status
+
+		switch status {
+		case entities.NodeProgressPending:
+			next.StartedAt = nil
+			next.CompletedAt = nil
+		case entities.NodeProgressInProgress:
+			if next.StartedAt == nil {
+				next.StartedAt = &now
+			}
+			next.CompletedAt = nil
+		case entities.NodeProgressDone:
+			if next.StartedAt == nil {
+				next.StartedAt = &now
+			}
+			next.CompletedAt = &now
+		case entities.NodeProgressSkipped:
+			next.CompletedAt = nil
+		}
+
+		events = append(events, &entities.ProgressEvent{
+			Type:       entities.ProgressEventStatusChanged,
+			FromStatus: current.Status,
+			ToStatus:   status,
+			CreatedAt:  now,
+		})
+	}
+
+	if timeSpent > 0 {
+		next.TimeSpentMinutes += timeSpent
+		if next.StartedAt == nil {
+			next.StartedAt = &now
+		}
+
+		events = append(events, &entities.ProgressEvent{
+			Type:             entities.ProgressEventTimeLogged,
+			TimeSpentMinutes: timeSpent,
+			CreatedAt:        now,
+		})
+	}
+
+	if confidence != nil && *confidence != current.Confidence {
+		next.Confidence = *confidence
+
+		events = append(events, &entities.ProgressEvent{
+			Type:       entities.ProgressEventConfidenceRated,
+			Confidence: *confidence,
+			CreatedAt:  now,
+		})
+	}
+
+	next.UpdatedAt = now
+	return next, events
+}
+
+func (uc *RoadmapUsecase) GetProgressTimeline(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, lang string, limit, offset int) (*dto.ProgressTimelineResponseDTO, error) {
+	const op = "RoadmapUsecase.GetProgressTimeline"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"limit":      limit,
+		"offset":     offset,
+	})
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
 	if roadmap == nil {
 		logger.Warn("roadmap not found")
-		return errs.ErrNotFound
+		return nil, errs.ErrNotFound
 	}
 
-	nodeExists := false
+	events, err := uc.mongoRepo.GetProgressEvents(ctx, userID, roadmapID, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get progress events")
+		return nil, fmt.Errorf("failed to get progress events: %w", err)
+	}
+
+	total, err := uc.mongoRepo.CountProgressEvents(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to count progress events")
+		return nil, fmt.Errorf("failed to count progress events: %w", err)
+	}
+
+	nodeLabels := make(map[uuid.UUID]string, len(roadmap.Nodes))
 	for _, node := range roadmap.Nodes {
-		if node.ID == req.NodeID {
-			nodeExists = true
-			break
+		nodeLabels[node.ID] = node.Data.Label
+	}
+
+	response := dto.ProgressEventsToTimelineDTO(roadmapID, events, nodeLabels, lang, total, limit, offset)
+
+	logger.WithField("count", len(response.Events)).Info("successfully retrieved progress timeline")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) GetProgressSummary(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, lang string) (*dto.ProgressSummaryDTO, error) {
+	const op = "RoadmapUsecase.GetProgressSummary"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	userProgress, err := uc.getUserProgress(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user progress")
+		return nil, fmt.Errorf("failed to get user progress: %w", err)
+	}
+
+	response := dto.ProgressSummaryToDTO(roadmapID, summarizeProgress(roadmap, userProgress), lang)
+
+	logger.WithField("completion", response.CompletionPercent).Info("successfully retrieved progress summary")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) GetMyLearning(ctx context.Context, userID uuid.UUID, lang string, limit, offset int) (*dto.MyLearningResponseDTO, error) {
+	const op = "RoadmapUsecase.GetMyLearning"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+		"limit":   limit,
+		"offset":  offset,
+	})
+
+	progressList, err := uc.mongoRepo.GetUserProgressList(ctx, userID, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user progress list")
+		return nil, fmt.Errorf("failed to get user progress list: %w", err)
+	}
+
+	total, err := uc.mongoRepo.CountUserProgress(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to count user progress")
+		return nil, fmt.Errorf("failed to count user progress: %w", err)
+	}
+
+	roadmapIDs := make([]primitive.ObjectID, 0, len(progressList))
+	for _, progress := range progressList {
+		roadmapIDs = append(roadmapIDs, progress.RoadmapID)
+	}
+
+	roadmaps, err := uc.mongoRepo.GetByIDs(ctx, roadmapIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmaps")
+		return nil, fmt.Errorf("failed to get roadmaps: %w", err)
+	}
+
+	items := make([]dto.MyLearningRoadmapDTO, 0, len(progressList))
+	for _, progress := range progressList {
+		roadmap, ok := roadmaps[progress.RoadmapID]
+		if !ok {
+			logger.WithField("roadmap_id", progress.RoadmapID.Hex()).Debug("skipping progress of deleted roadmap")
+			continue
+		}
+
+		item := dto.MyLearningRoadmapDTO{
+			RoadmapID: progress.RoadmapID.Hex(),
+			Summary:   dto.ProgressSummaryToDTO(progress.RoadmapID, summarizeProgress(roadmap, progress), lang),
+		}
+
+		roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: progress.RoadmapID.Hex()})
+		if err != nil {
+			logger.WithError(err).WithField("roadmap_id", progress.RoadmapID.Hex()).Warn("failed to get roadmap info")
+		} else if roadmapInfo != nil && roadmapInfo.RoadmapInfo != nil {
+			item.RoadmapInfoID = roadmapInfo.RoadmapInfo.Id
+			item.Name = roadmapInfo.RoadmapInfo.Name
 		}
+
+		items = append(items, item)
 	}
 
-	if !nodeExists {
-		logger.WithField("node_id", req.NodeID).Warn("node not found in roadmap")
-		return errs.ErrNotFound
+	logger.WithField("count", len(items)).Info("successfully retrieved my learning")
+	return &dto.MyLearningResponseDTO{
+		Roadmaps: items,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+func (uc *RoadmapUsecase) GetLearningOrder(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, lang string) (*dto.LearningOrderResponseDTO, error) {
+	const op = "RoadmapUsecase.GetLearningOrder"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap")
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
 	}
 
-	nodeProgress := dto.UpdateNodeProgressRequestToEntity(*req)
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	userProgress, err := uc.getUserProgress(ctx, userID, roadmapID)
+	if err != nil && !errs.IsNotFoundError(err) {
+		logger.WithError(err).Warn("failed to get user progress")
+	}
+
+	response := dto.LearningOrderToDTO(roadmap, learningOrder(roadmap), userProgress, lockedNodes(roadmap, userProgress), lang)
 
-	err = uc.mongoRepo.UpsertUserProgress(ctx, userID, roadmapID, req.NodeID, nodeProgress)
+	logger.WithField("nodes_count", len(response.Nodes)).Info("successfully retrieved learning order")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) GetBrokenLinks(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.BrokenLinksResponseDTO, error) {
+	const op = "RoadmapUsecase.GetBrokenLinks"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
 	if err != nil {
-		logger.WithError(err).Error("failed to update node progress")
-		return fmt.Errorf("failed to update node progress: %w", err)
+		logger.WithError(err).Error("failed to get roadmap info for authorization check")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		logger.Error("roadmap info connected with roadmap doesn't exist")
+		return nil, errs.ErrNotFound
+	}
+
+	if !entities.CollaboratorRole(roadmapInfo.Role).CanEdit() {
+		logger.WithField("author_id", roadmapInfo.RoadmapInfo.Author.UserId).Warn("user is not author of the roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	targets, err := uc.mongoRepo.GetBrokenMaterials(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get broken materials")
+		return nil, fmt.Errorf("failed to get broken materials: %w", err)
+	}
+
+	response := dto.BrokenMaterialsToDTO(roadmapID, targets)
+
+	logger.WithField("count", response.Total).Info("successfully retrieved broken links")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) UpsertNodeNote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID, req *dto.UpsertNodeNoteRequestDTO) (*dto.NodeNoteResponseDTO, error) {
+	const op = "RoadmapUsecase.UpsertNodeNote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	})
+
+	if strings.TrimSpace(req.Content) == "" {
+		logger.Warn("empty note content provided")
+		return nil, fmt.Errorf("invalid note: content is required")
+	}
+
+	if utf8.RuneCountInString(req.Content) > maxNodeNoteLength {
+		logger.Warn("note content is too long")
+		return nil, fmt.Errorf("invalid note: content must not exceed %d characters", maxNodeNoteLength)
+	}
+
+	if err := uc.ensureNodeExists(ctx, roadmapID, nodeID); err != nil {
+		logger.WithError(err).Warn("failed to find node for note")
+		return nil, err
+	}
+
+	note, err := uc.mongoRepo.UpsertNodeNote(ctx, dto.UpsertNodeNoteRequestToEntity(req, userID, roadmapID, nodeID))
+	if err != nil {
+		logger.WithError(err).Error("failed to save node note")
+		return nil, fmt.Errorf("failed to save node note: %w", err)
+	}
+
+	response := dto.NodeNoteToDTO(note)
+
+	logger.Info("successfully saved node note")
+	return &response, nil
+}
+
+func (uc *RoadmapUsecase) DeleteNodeNote(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	const op = "RoadmapUsecase.DeleteNodeNote"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+		"node_id":    nodeID,
+	})
+
+	if err := uc.mongoRepo.DeleteNodeNote(ctx, userID, roadmapID, nodeID); err != nil {
+		logger.WithError(err).Error("failed to delete node note")
+		return fmt.Errorf("failed to delete node note: %w", err)
 	}
 
-	logger.Info("successfully updated node progress")
+	logger.Info("successfully deleted node note")
 	return nil
 }
 
+func (uc *RoadmapUsecase) SearchNodeNotes(ctx context.Context, userID uuid.UUID, query string, limit, offset int) (*dto.NodeNoteSearchResponseDTO, error) {
+	const op = "RoadmapUsecase.SearchNodeNotes"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID,
+		"query":   query,
+		"limit":   limit,
+		"offset":  offset,
+	})
+
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) > maxNoteSearchQueryLength {
+		logger.Warn("search query is too long")
+		return nil, fmt.Errorf("invalid query: must not exceed %d characters", maxNoteSearchQueryLength)
+	}
+
+	notes, err := uc.mongoRepo.SearchNodeNotes(ctx, userID, query, limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to search node notes")
+		return nil, fmt.Errorf("failed to search node notes: %w", err)
+	}
+
+	total, err := uc.mongoRepo.CountNodeNotes(ctx, userID, query)
+	if err != nil {
+		logger.WithError(err).Error("failed to count node notes")
+		return nil, fmt.Errorf("failed to count node notes: %w", err)
+	}
+
+	response := dto.NodeNotesToSearchDTO(notes, query, total, limit, offset)
+
+	logger.WithField("count", len(response.Notes)).Info("successfully searched node notes")
+	return &response, nil
+}
+
+// ExportRoadmap renders the roadmap as a Markdown document together with the
+// requesting user's progress and private notes.
+func (uc *RoadmapUsecase) ExportRoadmap(ctx context.Context, userID uuid.UUID, roadmapID primitive.ObjectID) (*dto.RoadmapExportDTO, error) {
+	const op = "RoadmapUsecase.ExportRoadmap"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"user_id":    userID,
+		"roadmap_id": roadmapID.Hex(),
+	})
+
+	roadmapInfo, err := uc.roadmapInfoClient.GetByRoadmapID(ctx, &roadmapinfoclient.GetByRoadmapIDRequest{RoadmapId: roadmapID.Hex(), UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info for authorization check")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || roadmapInfo.RoadmapInfo == nil {
+		logger.Error("roadmap info connected with roadmap doesn't exist")
+		return nil, errs.ErrNotFound
+	}
+
+	if !roadmapInfo.RoadmapInfo.IsPublic && !entities.CollaboratorRole(roadmapInfo.Role).CanView() {
+		logger.Warn("user has no access to private roadmap")
+		return nil, errs.ErrForbidden
+	}
+
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap by ID")
+		return nil, fmt.Errorf("failed to get roadmap by ID: %w", err)
+	}
+
+	if roadmap == nil {
+		logger.Warn("roadmap not found")
+		return nil, errs.ErrNotFound
+	}
+
+	userProgress, err := uc.getUserProgress(ctx, userID, roadmapID)
+	if err != nil && !errs.IsNotFoundError(err) {
+		logger.WithError(err).Warn("failed to get user progress")
+	}
+
+	notes, err := uc.mongoRepo.GetNodeNotes(ctx, userID, roadmapID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get node notes")
+		return nil, fmt.Errorf("failed to get node notes: %w", err)
+	}
+
+	export := dto.RoadmapToMarkdownExport(roadmapInfo.RoadmapInfo.Name, roadmapInfo.RoadmapInfo.Description, roadmap, userProgress, notes)
+
+	logger.WithField("size", len(export.Content)).Info("successfully exported roadmap")
+	return export, nil
+}
+
+func (uc *RoadmapUsecase) ensureNodeExists(ctx context.Context, roadmapID primitive.ObjectID, nodeID uuid.UUID) error {
+	roadmap, err := uc.mongoRepo.GetByID(ctx, roadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap == nil || !roadmapHasNode(roadmap, nodeID) {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+func roadmapHasNode(roadmap *entities.Roadmap, nodeID uuid.UUID) bool {
+	for _, node := range roadmap.Nodes {
+		if node.ID == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
 func (uc *RoadmapUsecase) extractRoadmapContent(roadmap *entities.Roadmap) string {
 	if roadmap == nil || len(roadmap.Nodes) == 0 {
 		return ""
@@ -802,6 +1959,12 @@ func (uc *RoadmapUsecase) extractMaterialContent(material *entities.Material) st
 		contentBuilder.WriteString(" ")
 	}
 
+	if material.Preview != nil {
+		contentBuilder.WriteString(material.Preview.Title)
+		contentBuilder.WriteString(" ")
+		contentBuilder.WriteString(material.Preview.Description)
+	}
+
 	return strings.TrimSpace(contentBuilder.String())
 }
 
@@ -841,7 +2004,7 @@ func (uc *RoadmapUsecase) moderateRoadmap(ctx context.Context, roadmap *entities
 		}).Warn("roadmap content failed moderation")
 
 		categoriesStr := strings.Join(resp.Result.Categories, ", ")
-		return fmt.Errorf("content violates moderation rules: %s", categoriesStr)
+		return fmt.Errorf("%w: %s", errModerationRejected, categoriesStr)
 	}
 
 	logger.WithFields(map[string]interface{}{
@@ -888,7 +2051,7 @@ func (uc *RoadmapUsecase) moderateMaterial(ctx context.Context, material *entiti
 		}).Warn("material content failed moderation")
 
 		categoriesStr := strings.Join(resp.Result.Categories, ", ")
-		return fmt.Errorf("content violates moderation rules: %s", categoriesStr)
+		return fmt.Errorf("%w: %s", errModerationRejected, categoriesStr)
 	}
 
 	logger.WithFields(map[string]interface{}{
@@ -899,6 +2062,40 @@ func (uc *RoadmapUsecase) moderateMaterial(ctx context.Context, material *entiti
 	return nil
 }
 
+func (uc *RoadmapUsecase) generateAWSMinioURL(bucket string, key string) string {
+	return fmt.Sprintf("%s/%s/%s", uc.cfg.AWS.FilesEndpoint, bucket, key)
+}
+
+// materialNameFallback names a material by its page title, or by the link itself
+// when the page could not be fetched.
+func materialNameFallback(material *entities.Material) string {
+	if material.Preview != nil && material.Preview.Title != "" {
+		return material.Preview.Title
+	}
+
+	if parsed, err := url.Parse(material.URL); err == nil && parsed.Host != "" {
+		return strings.TrimSuffix(parsed.Host+parsed.Path, "/")
+	}
+
+	return material.URL
+}
+
+func validateMaterialMetadata(material *entities.Material) error {
+	if material.DurationMinutes < 0 || material.DurationMinutes > maxMaterialDurationMinutes {
+		return fmt.Errorf("invalid material duration: must be between 0 and %d minutes", maxMaterialDurationMinutes)
+	}
+
+	if len(material.Language) > maxMaterialLanguageLength {
+		return fmt.Errorf("invalid material language: %s", material.Language)
+	}
+
+	if material.Difficulty != "" && !material.Difficulty.IsValid() {
+		return fmt.Errorf("invalid material difficulty: %s", material.Difficulty)
+	}
+
+	return nil
+}
+
 func (uc *RoadmapUsecase) fetchAuthorData(ctx context.Context, userID uuid.UUID) (dto.MaterialAuthorDTO, error) {
 	resp, err := uc.authClient.GetUserByID(ctx, &authclient.GetUserByIDRequest{UserId: userID.String()})
 	if err != nil || resp == nil || resp.User == nil {
@@ -0,0 +1,11 @@
+package roadmapinfo
+
+import (
+	"context"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+)
+
+type NotificationPublisher interface {
+	NotifyCollaboratorInvite(ctx context.Context, collaborator *entities.RoadmapCollaborator, roadmapName, inviterName string) error
+}
@@ -0,0 +1,41 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/broker"
+	notificationDTO "github.com/F0urward/proftwist-backend/services/notification/dto"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+)
+
+type NotificationPublisher struct {
+	producer broker.Producer
+}
+
+func NewNotificationPublisher(producer broker.Producer) roadmapinfo.NotificationPublisher {
+	return &NotificationPublisher{producer: producer}
+}
+
+func (k *NotificationPublisher) NotifyCollaboratorInvite(ctx context.Context, collaborator *entities.RoadmapCollaborator, roadmapName, inviterName string) error {
+	userID := collaborator.UserID.String()
+
+	event := notificationDTO.CollaboratorInviteEvent{
+		Type:          notificationDTO.CollaboratorInviteType,
+		UserIDs:       []string{userID},
+		RoadmapInfoID: collaborator.RoadmapInfoID.String(),
+		RoadmapName:   roadmapName,
+		Role:          string(collaborator.Role),
+		InvitedBy:     collaborator.InvitedBy.String(),
+		InviterName:   inviterName,
+		SentAt:        time.Now(),
+	}
+
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return k.producer.Publish(ctx, userID, data)
+}
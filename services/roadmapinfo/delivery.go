@@ -20,4 +20,20 @@ type Handlers interface {
 	GetSubscribedRoadmaps(w http.ResponseWriter, r *http.Request)
 	CheckSubscription(w http.ResponseWriter, r *http.Request)
 	SearchPublic(w http.ResponseWriter, r *http.Request)
+	CreateDraft(w http.ResponseWriter, r *http.Request)
+	SubmitRelease(w http.ResponseWriter, r *http.Request)
+	GetPendingReleases(w http.ResponseWriter, r *http.Request)
+	ReviewRelease(w http.ResponseWriter, r *http.Request)
+	Release(w http.ResponseWriter, r *http.Request)
+	GetReleases(w http.ResponseWriter, r *http.Request)
+	Unpublish(w http.ResponseWriter, r *http.Request)
+	Restore(w http.ResponseWriter, r *http.Request)
+	InviteCollaborator(w http.ResponseWriter, r *http.Request)
+	GetCollaborators(w http.ResponseWriter, r *http.Request)
+	UpdateCollaborator(w http.ResponseWriter, r *http.Request)
+	RemoveCollaborator(w http.ResponseWriter, r *http.Request)
+	GetInvitations(w http.ResponseWriter, r *http.Request)
+	AcceptInvitation(w http.ResponseWriter, r *http.Request)
+	DeclineInvitation(w http.ResponseWriter, r *http.Request)
+	GetShared(w http.ResponseWriter, r *http.Request)
 }
@@ -30,8 +30,59 @@ func (s *RoadmapInfoServer) GetByRoadmapID(ctx context.Context, req *roadmapinfo
 
 	protoRoadmapInfo := convertRoadmapInfoToProto(&roadmapInfo.RoadmapInfo)
 
+	var role string
+	if req.UserId != "" {
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return &roadmapinfoclient.GetByRoadmapIDResponse{
+				Error: "invalid user id",
+			}, nil
+		}
+
+		roadmapInfoID, err := uuid.Parse(roadmapInfo.RoadmapInfo.ID)
+		if err != nil {
+			return &roadmapinfoclient.GetByRoadmapIDResponse{
+				Error: "invalid roadmap info id",
+			}, nil
+		}
+
+		userRole, err := s.uc.GetUserRole(ctx, roadmapInfoID, userID)
+		if err != nil {
+			return &roadmapinfoclient.GetByRoadmapIDResponse{
+				Error: err.Error(),
+			}, nil
+		}
+		role = string(userRole)
+	}
+
 	return &roadmapinfoclient.GetByRoadmapIDResponse{
 		RoadmapInfo: protoRoadmapInfo,
+		Role:        role,
+	}, nil
+}
+
+func (s *RoadmapInfoServer) GetSubscribedRoadmaps(ctx context.Context, req *roadmapinfoclient.GetSubscribedRoadmapsRequest) (*roadmapinfoclient.GetSubscribedRoadmapsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &roadmapinfoclient.GetSubscribedRoadmapsResponse{
+			Error: "invalid user id",
+		}, nil
+	}
+
+	subscribed, err := s.uc.GetSubscribed(ctx, userID)
+	if err != nil {
+		return &roadmapinfoclient.GetSubscribedRoadmapsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	protoRoadmapInfos := make([]*roadmapinfoclient.RoadmapInfo, 0, len(subscribed.RoadmapsInfo))
+	for i := range subscribed.RoadmapsInfo {
+		protoRoadmapInfos = append(protoRoadmapInfos, convertRoadmapInfoToProto(&subscribed.RoadmapsInfo[i]))
+	}
+
+	return &roadmapinfoclient.GetSubscribedRoadmapsResponse{
+		RoadmapInfos: protoRoadmapInfos,
 	}, nil
 }
 
@@ -443,7 +443,10 @@ func (h *RoadmapInfoHandlers) Fork(w http.ResponseWriter, r *http.Request) {
 		"user_id":         userID.String(),
 	})
 
-	res, err := h.uc.Fork(r.Context(), roadmapInfoID, userID)
+	carryProgress := r.URL.Query().Get("carry_progress") == "true"
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	res, err := h.uc.Fork(r.Context(), roadmapInfoID, userID, carryProgress, idempotencyKey)
 	if err != nil {
 		logger.WithError(err).Error("failed to fork roadmapInfo")
 
@@ -507,7 +510,10 @@ func (h *RoadmapInfoHandlers) Publish(w http.ResponseWriter, r *http.Request) {
 		"user_id":         userID.String(),
 	})
 
-	res, err := h.uc.Publish(r.Context(), roadmapInfoID, userID)
+	carryProgress := r.URL.Query().Get("carry_progress") == "true"
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	res, err := h.uc.Publish(r.Context(), roadmapInfoID, userID, carryProgress, idempotencyKey)
 	if err != nil {
 		logger.WithError(err).Error("failed to publish roadmapInfo")
 
@@ -812,3 +818,947 @@ func (h *RoadmapInfoHandlers) SearchPublic(w http.ResponseWriter, r *http.Reques
 	logger.WithField("count", len(res.RoadmapsInfo)).Info("successfully searched public roadmapInfos")
 	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
 }
+
+func (h *RoadmapInfoHandlers) CreateDraft(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.CreateDraft"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	res, err := h.uc.CreateDraft(r.Context(), roadmapInfoID, userID, idempotencyKey)
+	if err != nil {
+		logger.WithError(err).Error("failed to create draft")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to create draft"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmapInfo not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("draft_roadmap_info_id", res.RoadmapInfo.ID).Info("successfully created draft")
+	utils.JSONResponse(r.Context(), w, http.StatusCreated, res)
+}
+
+func (h *RoadmapInfoHandlers) SubmitRelease(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.SubmitRelease"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	var req dto.SubmitReleaseRequestDTO
+
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.SubmitRelease(r.Context(), roadmapInfoID, userID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to submit release")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to submit release"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "release not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this release"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("release_id", res.Release.ID).Info("successfully submitted release")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) GetPendingReleases(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.GetPendingReleases"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	res, err := h.uc.GetPendingReleases(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("failed to get pending releases")
+		utils.JSONError(r.Context(), w, http.StatusInternalServerError, "failed to get pending releases")
+		return
+	}
+
+	logger.WithField("count", len(res.Releases)).Info("successfully retrieved pending releases")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) ReviewRelease(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.ReviewRelease"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	releaseIDStr := vars["release_id"]
+	if releaseIDStr == "" {
+		logger.Warn("release_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "release_id parameter is required")
+		return
+	}
+
+	releaseID, err := uuid.Parse(releaseIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("release_id", releaseIDStr).Warn("invalid release_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid release_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	reviewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"release_id":  releaseID.String(),
+		"reviewer_id": reviewerID.String(),
+	})
+
+	var req dto.ReviewReleaseRequestDTO
+
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.ReviewRelease(r.Context(), releaseID, reviewerID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to review release")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to review release"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "release not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("status", res.Release.Status).Info("successfully reviewed release")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) Release(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.Release"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	releaseIDStr := vars["release_id"]
+	if releaseIDStr == "" {
+		logger.Warn("release_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "release_id parameter is required")
+		return
+	}
+
+	releaseID, err := uuid.Parse(releaseIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("release_id", releaseIDStr).Warn("invalid release_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid release_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"release_id": releaseID.String(),
+		"user_id":    userID.String(),
+	})
+
+	res, err := h.uc.Release(r.Context(), releaseID, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to release draft")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to release draft"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "release not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this release"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully released draft")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) GetReleases(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.GetReleases"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	logger = logger.WithField("roadmap_info_id", roadmapInfoID.String())
+
+	res, err := h.uc.GetReleases(r.Context(), roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get releases")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get releases"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmapInfo not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("count", len(res.Releases)).Info("successfully retrieved releases")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) Unpublish(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.Unpublish"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	err = h.uc.Unpublish(r.Context(), roadmapInfoID, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to unpublish roadmap")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to unpublish roadmap"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmapInfo not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully unpublished roadmap")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapInfoHandlers) Restore(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.Restore"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	err = h.uc.Restore(r.Context(), roadmapInfoID, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to restore roadmap")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to restore roadmap"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmapInfo not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you are not the author of this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully restored roadmap")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapInfoHandlers) InviteCollaborator(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.InviteCollaborator"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	var req dto.InviteCollaboratorRequestDTO
+
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.InviteCollaborator(r.Context(), roadmapInfoID, userID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to invite collaborator")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to invite collaborator"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmapInfo not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you cannot manage collaborators of this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("invitee_id", res.Collaborator.User.UserID).Info("successfully invited collaborator")
+	utils.JSONResponse(r.Context(), w, http.StatusCreated, res)
+}
+
+func (h *RoadmapInfoHandlers) GetCollaborators(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.GetCollaborators"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	res, err := h.uc.GetCollaborators(r.Context(), roadmapInfoID, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborators")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to get collaborators"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "roadmapInfo not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you have no access to this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.WithField("count", len(res.Collaborators)).Info("successfully retrieved collaborators")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) UpdateCollaborator(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.UpdateCollaborator"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	collaboratorIDStr := vars["collaborator_id"]
+	if collaboratorIDStr == "" {
+		logger.Warn("collaborator_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "collaborator_id parameter is required")
+		return
+	}
+
+	collaboratorID, err := uuid.Parse(collaboratorIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("collaborator_id", collaboratorIDStr).Warn("invalid collaborator_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid collaborator_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"collaborator_id": collaboratorID.String(),
+		"user_id":         userID.String(),
+	})
+
+	var req dto.UpdateCollaboratorRequestDTO
+
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Warn("invalid request body")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.uc.UpdateCollaborator(r.Context(), roadmapInfoID, userID, collaboratorID, &req)
+	if err != nil {
+		logger.WithError(err).Error("failed to update collaborator")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to update collaborator"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "collaborator not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you cannot manage collaborators of this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully updated collaborator")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) RemoveCollaborator(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.RemoveCollaborator"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	collaboratorIDStr := vars["collaborator_id"]
+	if collaboratorIDStr == "" {
+		logger.Warn("collaborator_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "collaborator_id parameter is required")
+		return
+	}
+
+	collaboratorID, err := uuid.Parse(collaboratorIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("collaborator_id", collaboratorIDStr).Warn("invalid collaborator_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid collaborator_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"collaborator_id": collaboratorID.String(),
+		"user_id":         userID.String(),
+	})
+
+	err = h.uc.RemoveCollaborator(r.Context(), roadmapInfoID, userID, collaboratorID)
+	if err != nil {
+		logger.WithError(err).Error("failed to remove collaborator")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to remove collaborator"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "collaborator not found"
+		} else if errs.IsForbiddenError(err) {
+			statusCode = http.StatusForbidden
+			errorMsg = "access denied: you cannot manage collaborators of this roadmap"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully removed collaborator")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapInfoHandlers) GetInvitations(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.GetInvitations"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithField("user_id", userID.String())
+
+	res, err := h.uc.GetInvitations(r.Context(), userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get invitations")
+		utils.JSONError(r.Context(), w, http.StatusInternalServerError, "failed to get invitations")
+		return
+	}
+
+	logger.WithField("count", len(res.Invitations)).Info("successfully retrieved invitations")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
+
+func (h *RoadmapInfoHandlers) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.AcceptInvitation"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	err = h.uc.RespondToInvitation(r.Context(), roadmapInfoID, userID, true)
+	if err != nil {
+		logger.WithError(err).Error("failed to accept invitation")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to accept invitation"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "invitation not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully accepted invitation")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapInfoHandlers) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.DeclineInvitation"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	roadmapInfoIDStr := vars["roadmap_info_id"]
+	if roadmapInfoIDStr == "" {
+		logger.Warn("roadmap_info_id parameter is required")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "roadmap_info_id parameter is required")
+		return
+	}
+
+	roadmapInfoID, err := uuid.Parse(roadmapInfoIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("roadmap_info_id", roadmapInfoIDStr).Warn("invalid roadmap_info_id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid roadmap_info_id format")
+		return
+	}
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	err = h.uc.RespondToInvitation(r.Context(), roadmapInfoID, userID, false)
+	if err != nil {
+		logger.WithError(err).Error("failed to decline invitation")
+
+		statusCode := http.StatusInternalServerError
+		errorMsg := "failed to decline invitation"
+
+		if errs.IsNotFoundError(err) {
+			statusCode = http.StatusNotFound
+			errorMsg = "invitation not found"
+		} else if errs.IsBusinessLogicError(err) {
+			statusCode = http.StatusBadRequest
+			errorMsg = err.Error()
+		}
+
+		utils.JSONError(r.Context(), w, statusCode, errorMsg)
+		return
+	}
+
+	logger.Info("successfully declined invitation")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RoadmapInfoHandlers) GetShared(w http.ResponseWriter, r *http.Request) {
+	const op = "RoadmapInfoHandlers.GetShared"
+	logger := ctxutil.GetLogger(r.Context()).WithField("op", op)
+
+	userIDStr, ok := r.Context().Value(utils.UserIDKey{}).(string)
+	if !ok || userIDStr == "" {
+		logger.Warn("user ID not found in context")
+		utils.JSONError(r.Context(), w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userIDStr).Warn("invalid user id format")
+		utils.JSONError(r.Context(), w, http.StatusBadRequest, "invalid user_id format")
+		return
+	}
+
+	logger = logger.WithField("user_id", userID.String())
+
+	res, err := h.uc.GetShared(r.Context(), userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get shared roadmaps")
+		utils.JSONError(r.Context(), w, http.StatusInternalServerError, "failed to get shared roadmaps")
+		return
+	}
+
+	logger.WithField("count", len(res.RoadmapsInfo)).Info("successfully retrieved shared roadmaps")
+	utils.JSONResponse(r.Context(), w, http.StatusOK, res)
+}
@@ -26,6 +26,8 @@ func (r *RoadmapInfoHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
 	s.MUX.Handle("/api/v1/roadmapsinfo/public/{roadmap_info_id}/unsubscribe", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Unsubscribe))).Methods("DELETE")
 	s.MUX.Handle("/api/v1/roadmapsinfo/public/{roadmap_info_id}/subscription", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.CheckSubscription))).Methods("GET")
 	s.MUX.Handle("/api/v1/roadmapsinfo", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetAllByUserID))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmapsinfo/shared", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetShared))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmapsinfo/invitations", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetInvitations))).Methods("GET")
 	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}", http.HandlerFunc(r.handlers.GetByID)).Methods("GET")
 	s.MUX.Handle("/api/v1/roadmapsinfo/roadmap/{roadmap_id}", http.HandlerFunc(r.handlers.GetByRoadmapID)).Methods("GET")
 	s.MUX.Handle("/api/v1/roadmapsinfo/private", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.CreatePrivate))).Methods("POST")
@@ -33,4 +35,18 @@ func (r *RoadmapInfoHttpRegistrar) RegisterRoutes(s *httpServer.HttpServer) {
 	s.MUX.Handle("/api/v1/roadmapsinfo/private/{roadmap_info_id}/publish", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Publish))).Methods("POST")
 	s.MUX.Handle("/api/v1/roadmapsinfo/public/{roadmap_info_id}/fork", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Fork))).Methods("POST")
 	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Delete))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/roadmapsinfo/public/{roadmap_info_id}/draft", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.CreateDraft))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmapsinfo/private/{roadmap_info_id}/submit", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.SubmitRelease))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmapsinfo/releases/{release_id}/release", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Release))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/releases", http.HandlerFunc(r.handlers.GetReleases)).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmapsinfo/public/{roadmap_info_id}/unpublish", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Unpublish))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmapsinfo/public/{roadmap_info_id}/restore", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.Restore))).Methods("POST")
+	s.MUX.Handle("/api/v1/admin/roadmapsinfo/releases", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.GetPendingReleases))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/collaborators", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.InviteCollaborator))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/collaborators", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.GetCollaborators))).Methods("GET")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/collaborators/{collaborator_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.UpdateCollaborator))).Methods("PUT")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/collaborators/{collaborator_id}", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.RemoveCollaborator))).Methods("DELETE")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/invitation/accept", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.AcceptInvitation))).Methods("POST")
+	s.MUX.Handle("/api/v1/roadmapsinfo/{roadmap_info_id}/invitation/decline", s.AuthMiddleware.AuthMiddleware(http.HandlerFunc(r.handlers.DeclineInvitation))).Methods("POST")
+	s.MUX.Handle("/api/v1/admin/roadmapsinfo/releases/{release_id}/review", s.AuthMiddleware.AdminMiddleware(http.HandlerFunc(r.handlers.ReviewRelease))).Methods("POST")
 }
@@ -29,16 +29,17 @@ type AuthorDTO struct {
 }
 
 type RoadmapInfoDTO struct {
-	ID                      string    `json:"id"`
-	RoadmapID               string    `json:"roadmap_id"`
-	Author                  AuthorDTO `json:"author"`
-	CategoryID              string    `json:"category_id"`
-	Name                    string    `json:"name"`
-	Description             string    `json:"description"`
-	IsPublic                bool      `json:"is_public"`
-	ReferencedRoadmapInfoID string    `json:"referenced_roadmap_info_id"`
-	CreatedAt               time.Time `json:"created_at"`
-	UpdatedAt               time.Time `json:"updated_at"`
+	ID                      string     `json:"id"`
+	RoadmapID               string     `json:"roadmap_id"`
+	Author                  AuthorDTO  `json:"author"`
+	CategoryID              string     `json:"category_id"`
+	Name                    string     `json:"name"`
+	Description             string     `json:"description"`
+	IsPublic                bool       `json:"is_public"`
+	ReferencedRoadmapInfoID string     `json:"referenced_roadmap_info_id"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+	UnpublishedAt           *time.Time `json:"unpublished_at,omitempty"`
 }
 
 type CreatePrivateRoadmapInfoResponseDTO struct {
@@ -56,3 +57,71 @@ type GetByIDRoadmapInfoResponseDTO struct {
 type GetSubscribedRoadmapsInfoResponseDTO struct {
 	RoadmapsInfo []RoadmapInfoDTO `json:"roadmaps_info"`
 }
+
+type SubmitReleaseRequestDTO struct {
+	Notes string `json:"notes"`
+}
+
+type ReviewReleaseRequestDTO struct {
+	Approved bool   `json:"approved"`
+	Comment  string `json:"comment"`
+}
+
+type RoadmapReleaseDTO struct {
+	ID                 string     `json:"id"`
+	RoadmapInfoID      string     `json:"roadmap_info_id"`
+	DraftRoadmapInfoID string     `json:"draft_roadmap_info_id,omitempty"`
+	Author             AuthorDTO  `json:"author"`
+	Version            int        `json:"version,omitempty"`
+	Status             string     `json:"status"`
+	Notes              string     `json:"notes"`
+	ReviewComment      string     `json:"review_comment,omitempty"`
+	ReviewedAt         *time.Time `json:"reviewed_at,omitempty"`
+	ReleasedAt         *time.Time `json:"released_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type RoadmapReleaseResponseDTO struct {
+	Release RoadmapReleaseDTO `json:"release"`
+}
+
+type GetReleasesResponseDTO struct {
+	Releases []RoadmapReleaseDTO `json:"releases"`
+}
+
+type InviteCollaboratorRequestDTO struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+type UpdateCollaboratorRequestDTO struct {
+	Role string `json:"role"`
+}
+
+type CollaboratorDTO struct {
+	User      AuthorDTO `json:"user"`
+	Role      string    `json:"role"`
+	Status    string    `json:"status"`
+	InvitedBy string    `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CollaboratorResponseDTO struct {
+	Collaborator CollaboratorDTO `json:"collaborator"`
+}
+
+type GetCollaboratorsResponseDTO struct {
+	Collaborators []CollaboratorDTO `json:"collaborators"`
+}
+
+type InvitationDTO struct {
+	RoadmapInfo RoadmapInfoDTO `json:"roadmap_info"`
+	Role        string         `json:"role"`
+	InvitedBy   AuthorDTO      `json:"invited_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+type GetInvitationsResponseDTO struct {
+	Invitations []InvitationDTO `json:"invitations"`
+}
@@ -8,15 +8,16 @@ import (
 
 func RoadmapInfoToDTO(roadmap *entities.RoadmapInfo, author AuthorDTO) RoadmapInfoDTO {
 	dto := RoadmapInfoDTO{
-		ID:          roadmap.ID.String(),
-		RoadmapID:   roadmap.RoadmapID,
-		Author:      author,
-		CategoryID:  roadmap.CategoryID.String(),
-		Name:        roadmap.Name,
-		Description: roadmap.Description,
-		IsPublic:    roadmap.IsPublic,
-		CreatedAt:   roadmap.CreatedAt,
-		UpdatedAt:   roadmap.UpdatedAt,
+		ID:            roadmap.ID.String(),
+		RoadmapID:     roadmap.RoadmapID,
+		Author:        author,
+		CategoryID:    roadmap.CategoryID.String(),
+		Name:          roadmap.Name,
+		Description:   roadmap.Description,
+		IsPublic:      roadmap.IsPublic,
+		CreatedAt:     roadmap.CreatedAt,
+		UpdatedAt:     roadmap.UpdatedAt,
+		UnpublishedAt: roadmap.UnpublishedAt,
 	}
 
 	if roadmap.ReferencedRoadmapInfoID != nil {
@@ -33,14 +34,15 @@ func RoadmapInfoListToDTO(roadmaps []*entities.RoadmapInfo, authorData map[uuid.
 
 	for _, roadmap := range roadmaps {
 		dto := RoadmapInfoDTO{
-			ID:          roadmap.ID.String(),
-			RoadmapID:   roadmap.RoadmapID,
-			CategoryID:  roadmap.CategoryID.String(),
-			Name:        roadmap.Name,
-			Description: roadmap.Description,
-			IsPublic:    roadmap.IsPublic,
-			CreatedAt:   roadmap.CreatedAt,
-			UpdatedAt:   roadmap.UpdatedAt,
+			ID:            roadmap.ID.String(),
+			RoadmapID:     roadmap.RoadmapID,
+			CategoryID:    roadmap.CategoryID.String(),
+			Name:          roadmap.Name,
+			Description:   roadmap.Description,
+			IsPublic:      roadmap.IsPublic,
+			CreatedAt:     roadmap.CreatedAt,
+			UpdatedAt:     roadmap.UpdatedAt,
+			UnpublishedAt: roadmap.UnpublishedAt,
 		}
 
 		if roadmap.ReferencedRoadmapInfoID != nil {
@@ -118,3 +120,51 @@ func UpdatePrivateRequestToEntity(existing *entities.RoadmapInfo, request *Updat
 
 	return &updated, nil
 }
+
+func RoadmapReleaseToDTO(release *entities.RoadmapRelease, author AuthorDTO) RoadmapReleaseDTO {
+	dto := RoadmapReleaseDTO{
+		ID:            release.ID.String(),
+		RoadmapInfoID: release.RoadmapInfoID.String(),
+		Author:        author,
+		Version:       release.Version,
+		Status:        string(release.Status),
+		Notes:         release.Notes,
+		ReviewComment: release.ReviewComment,
+		ReviewedAt:    release.ReviewedAt,
+		ReleasedAt:    release.ReleasedAt,
+		CreatedAt:     release.CreatedAt,
+		UpdatedAt:     release.UpdatedAt,
+	}
+
+	if release.DraftRoadmapInfoID != nil {
+		dto.DraftRoadmapInfoID = release.DraftRoadmapInfoID.String()
+	}
+
+	return dto
+}
+
+func RoadmapReleaseListToDTO(releases []*entities.RoadmapRelease, authorData map[uuid.UUID]AuthorDTO) []RoadmapReleaseDTO {
+	releaseDTOs := make([]RoadmapReleaseDTO, 0, len(releases))
+	for _, release := range releases {
+		releaseDTOs = append(releaseDTOs, RoadmapReleaseToDTO(release, authorData[release.AuthorID]))
+	}
+	return releaseDTOs
+}
+
+func CollaboratorToDTO(collaborator *entities.RoadmapCollaborator, user AuthorDTO) CollaboratorDTO {
+	return CollaboratorDTO{
+		User:      user,
+		Role:      string(collaborator.Role),
+		Status:    string(collaborator.Status),
+		InvitedBy: collaborator.InvitedBy.String(),
+		CreatedAt: collaborator.CreatedAt,
+	}
+}
+
+func CollaboratorListToDTO(collaborators []*entities.RoadmapCollaborator, userData map[uuid.UUID]AuthorDTO) []CollaboratorDTO {
+	collaboratorDTOs := make([]CollaboratorDTO, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		collaboratorDTOs = append(collaboratorDTOs, CollaboratorToDTO(collaborator, userData[collaborator.UserID]))
+	}
+	return collaboratorDTOs
+}
@@ -2,6 +2,7 @@ package roadmapinfo
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,6 +13,7 @@ type Repository interface {
 	GetAllPublic(ctx context.Context) ([]*entities.RoadmapInfo, error)
 	GetAllPublicByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*entities.RoadmapInfo, error)
 	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.RoadmapInfo, error)
+	GetAllCreatedBefore(ctx context.Context, createdBefore time.Time) ([]*entities.RoadmapInfo, error)
 	GetByID(context.Context, uuid.UUID) (*entities.RoadmapInfo, error)
 	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entities.RoadmapInfo, error)
 	GetByRoadmapID(ctx context.Context, roadmapID string) (*entities.RoadmapInfo, error)
@@ -23,4 +25,22 @@ type Repository interface {
 	SubscriptionExists(ctx context.Context, userID, roadmapInfoID uuid.UUID) (bool, error)
 	GetSubscribedRoadmapIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 	SearchPublic(ctx context.Context, query string, categoryID *uuid.UUID) ([]*entities.RoadmapInfo, error)
+	CreateSaga(ctx context.Context, saga *entities.RoadmapSaga) (*entities.RoadmapSaga, error)
+	GetSagaByIdempotencyKey(ctx context.Context, userID uuid.UUID, kind entities.RoadmapSagaKind, idempotencyKey string) (*entities.RoadmapSaga, error)
+	UpdateSaga(ctx context.Context, saga *entities.RoadmapSaga) error
+	ClaimStalledSagas(ctx context.Context, stalledBefore time.Time, limit int) ([]*entities.RoadmapSaga, error)
+	CreateRelease(ctx context.Context, release *entities.RoadmapRelease) (*entities.RoadmapRelease, error)
+	GetReleaseByID(ctx context.Context, releaseID uuid.UUID) (*entities.RoadmapRelease, error)
+	GetReleaseByDraftRoadmapInfoID(ctx context.Context, draftRoadmapInfoID uuid.UUID) (*entities.RoadmapRelease, error)
+	GetOpenRelease(ctx context.Context, roadmapInfoID uuid.UUID) (*entities.RoadmapRelease, error)
+	GetReleasesByStatus(ctx context.Context, status entities.RoadmapReleaseStatus) ([]*entities.RoadmapRelease, error)
+	GetReleasedByRoadmapInfoID(ctx context.Context, roadmapInfoID uuid.UUID) ([]*entities.RoadmapRelease, error)
+	GetLatestReleaseVersion(ctx context.Context, roadmapInfoID uuid.UUID) (int, error)
+	UpdateRelease(ctx context.Context, release *entities.RoadmapRelease) error
+	CreateCollaborator(ctx context.Context, collaborator *entities.RoadmapCollaborator) (*entities.RoadmapCollaborator, error)
+	GetCollaborator(ctx context.Context, roadmapInfoID, userID uuid.UUID) (*entities.RoadmapCollaborator, error)
+	GetCollaborators(ctx context.Context, roadmapInfoID uuid.UUID) ([]*entities.RoadmapCollaborator, error)
+	GetCollaborationsByUserID(ctx context.Context, userID uuid.UUID, status entities.CollaboratorStatus) ([]*entities.RoadmapCollaborator, error)
+	UpdateCollaborator(ctx context.Context, collaborator *entities.RoadmapCollaborator) error
+	DeleteCollaborator(ctx context.Context, roadmapInfoID, userID uuid.UUID) error
 }
@@ -3,31 +3,37 @@ package repository
 const (
 	queryGetAllPublic = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at 
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
-        WHERE is_public = true`
+        WHERE is_public = true AND unpublished_at IS NULL`
 
 	queryGetAllPublicByCategoryID = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-                referenced_roadmap_info_id, created_at, updated_at 
+                referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
-        WHERE category_id = $1 AND is_public = true`
+        WHERE category_id = $1 AND is_public = true AND unpublished_at IS NULL`
 
 	queryGetAllByUserID = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-        referenced_roadmap_info_id, created_at, updated_at 
+        referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
         WHERE author_id = $1`
 
+	queryGetAllCreatedBefore = `
+        SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
+        FROM roadmap_info 
+        WHERE created_at <= $1`
+
 	queryGetByID = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at 
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
         WHERE id = $1`
 
 	queryGetByRoadmapID = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at 
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
         WHERE roadmap_id = $1`
 
@@ -36,12 +42,13 @@ const (
         (author_id, category_id, name, description, is_public, referenced_roadmap_info_id, roadmap_id) 
         VALUES ($1, $2, $3, $4, $5, $6, $7)
         RETURNING id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at`
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at`
 
 	queryUpdate = `
         UPDATE roadmap_info 
         SET category_id = $2, name = $3, description = $4, is_public = $5, 
-            referenced_roadmap_info_id = $6, roadmap_id = $7, updated_at = $8 
+            referenced_roadmap_info_id = $6, roadmap_id = $7, updated_at = $8,
+            unpublished_at = $9
         WHERE id = $1`
 
 	queryDelete = `
@@ -70,24 +77,143 @@ const (
 
 	queryGetByIDs = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at 
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
         WHERE id = ANY($1)`
 
 	querySearchPublic = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at 
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
         WHERE fts @@ make_prefix_tsquery($1)
         AND is_public = true
+        AND unpublished_at IS NULL
         ORDER BY ts_rank(fts, make_prefix_tsquery($1)) DESC`
 
 	querySearchPublicWithCategory = `
         SELECT id, roadmap_id, author_id, category_id, name, description, is_public,
-               referenced_roadmap_info_id, created_at, updated_at 
+               referenced_roadmap_info_id, created_at, updated_at, unpublished_at 
         FROM roadmap_info 
         WHERE fts @@ make_prefix_tsquery($1)
         AND is_public = true
+        AND unpublished_at IS NULL
         AND category_id = $2
         ORDER BY ts_rank(fts, make_prefix_tsquery($1)) DESC`
+
+	querySagaColumns = `
+        id, kind, user_id, idempotency_key, source_roadmap_info_id, roadmap_id,
+        roadmap_info_id, carry_progress, status, step, attempts, last_error,
+        created_at, updated_at`
+
+	queryCreateSaga = `
+        INSERT INTO roadmap_saga
+        (kind, user_id, idempotency_key, source_roadmap_info_id, roadmap_id, carry_progress, status, step)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        ON CONFLICT (user_id, kind, idempotency_key) DO NOTHING
+        RETURNING` + querySagaColumns
+
+	queryGetSagaByIdempotencyKey = `
+        SELECT` + querySagaColumns + `
+        FROM roadmap_saga
+        WHERE user_id = $1 AND kind = $2 AND idempotency_key = $3`
+
+	queryUpdateSaga = `
+        UPDATE roadmap_saga
+        SET roadmap_info_id = $2, status = $3, step = $4, attempts = $5, last_error = $6, updated_at = NOW()
+        WHERE id = $1`
+
+	queryClaimStalledSagas = `
+        UPDATE roadmap_saga
+        SET updated_at = NOW()
+        WHERE id IN (
+            SELECT id FROM roadmap_saga
+            WHERE status IN ('running', 'compensating') AND updated_at <= $1
+            ORDER BY updated_at
+            LIMIT $2
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING` + querySagaColumns
+
+	queryReleaseColumns = `
+        id, roadmap_info_id, draft_roadmap_info_id, author_id, COALESCE(version, 0),
+        status, notes, reviewer_id, review_comment, reviewed_at, released_at,
+        created_at, updated_at`
+
+	queryCreateRelease = `
+        INSERT INTO roadmap_release (roadmap_info_id, draft_roadmap_info_id, author_id, status)
+        VALUES ($1, $2, $3, $4)
+        RETURNING` + queryReleaseColumns
+
+	queryGetReleaseByID = `
+        SELECT` + queryReleaseColumns + `
+        FROM roadmap_release
+        WHERE id = $1`
+
+	queryGetReleaseByDraftRoadmapInfoID = `
+        SELECT` + queryReleaseColumns + `
+        FROM roadmap_release
+        WHERE draft_roadmap_info_id = $1`
+
+	queryGetOpenRelease = `
+        SELECT` + queryReleaseColumns + `
+        FROM roadmap_release
+        WHERE roadmap_info_id = $1 AND status IN ('draft', 'in_review', 'approved', 'rejected')`
+
+	queryGetReleasesByStatus = `
+        SELECT` + queryReleaseColumns + `
+        FROM roadmap_release
+        WHERE status = $1
+        ORDER BY updated_at`
+
+	queryGetReleasedByRoadmapInfoID = `
+        SELECT` + queryReleaseColumns + `
+        FROM roadmap_release
+        WHERE roadmap_info_id = $1 AND status = 'released'
+        ORDER BY version DESC`
+
+	queryGetLatestReleaseVersion = `
+        SELECT COALESCE(MAX(version), 0)
+        FROM roadmap_release
+        WHERE roadmap_info_id = $1`
+
+	queryUpdateRelease = `
+        UPDATE roadmap_release
+        SET draft_roadmap_info_id = $2, version = NULLIF($3, 0), status = $4, notes = $5,
+            reviewer_id = $6, review_comment = $7, reviewed_at = $8, released_at = $9,
+            updated_at = NOW()
+        WHERE id = $1`
+
+	queryCollaboratorColumns = `
+        roadmap_info_id, user_id, role, status, invited_by, created_at, updated_at`
+
+	queryCreateCollaborator = `
+        INSERT INTO roadmap_collaborator (roadmap_info_id, user_id, role, status, invited_by)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING` + queryCollaboratorColumns
+
+	queryGetCollaborator = `
+        SELECT` + queryCollaboratorColumns + `
+        FROM roadmap_collaborator
+        WHERE roadmap_info_id = $1 AND user_id = $2`
+
+	queryGetCollaborators = `
+        SELECT` + queryCollaboratorColumns + `
+        FROM roadmap_collaborator
+        WHERE roadmap_info_id = $1
+        ORDER BY created_at`
+
+	queryGetCollaborationsByUserID = `
+        SELECT` + queryCollaboratorColumns + `
+        FROM roadmap_collaborator
+        WHERE user_id = $1 AND status = $2
+        ORDER BY created_at DESC`
+
+	queryUpdateCollaborator = `
+        UPDATE roadmap_collaborator
+        SET role = $3, status = $4, updated_at = NOW()
+        WHERE roadmap_info_id = $1 AND user_id = $2`
+
+	queryDeleteCollaborator = `
+        DELETE FROM roadmap_collaborator
+        WHERE roadmap_info_id = $1 AND user_id = $2`
 )
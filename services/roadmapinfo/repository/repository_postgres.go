@@ -43,6 +43,7 @@ func (r *RoadmapInfoPostgresRepository) GetAllPublic(ctx context.Context) ([]*en
 	for rows.Next() {
 		roadmap := &entities.RoadmapInfo{}
 		var referencedRoadmapInfoID sql.NullString
+		var unpublishedAt sql.NullTime
 
 		if err = rows.Scan(
 			&roadmap.ID,
@@ -55,6 +56,7 @@ func (r *RoadmapInfoPostgresRepository) GetAllPublic(ctx context.Context) ([]*en
 			&referencedRoadmapInfoID,
 			&roadmap.CreatedAt,
 			&roadmap.UpdatedAt,
+			&unpublishedAt,
 		); err != nil {
 			logger.WithError(err).Error("failed to scan roadmap row")
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -71,6 +73,10 @@ func (r *RoadmapInfoPostgresRepository) GetAllPublic(ctx context.Context) ([]*en
 			roadmap.ReferencedRoadmapInfoID = nil
 		}
 
+		if unpublishedAt.Valid {
+			roadmap.UnpublishedAt = &unpublishedAt.Time
+		}
+
 		roadmaps = append(roadmaps, roadmap)
 	}
 
@@ -107,6 +113,7 @@ func (r *RoadmapInfoPostgresRepository) GetAllPublicByCategoryID(ctx context.Con
 	for rows.Next() {
 		roadmap := &entities.RoadmapInfo{}
 		var referencedRoadmapInfoID sql.NullString
+		var unpublishedAt sql.NullTime
 
 		if err = rows.Scan(
 			&roadmap.ID,
@@ -119,6 +126,7 @@ func (r *RoadmapInfoPostgresRepository) GetAllPublicByCategoryID(ctx context.Con
 			&referencedRoadmapInfoID,
 			&roadmap.CreatedAt,
 			&roadmap.UpdatedAt,
+			&unpublishedAt,
 		); err != nil {
 			logger.WithError(err).Error("failed to scan roadmap row")
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -135,6 +143,10 @@ func (r *RoadmapInfoPostgresRepository) GetAllPublicByCategoryID(ctx context.Con
 			roadmap.ReferencedRoadmapInfoID = nil
 		}
 
+		if unpublishedAt.Valid {
+			roadmap.UnpublishedAt = &unpublishedAt.Time
+		}
+
 		roadmaps = append(roadmaps, roadmap)
 	}
 
@@ -171,6 +183,7 @@ func (r *RoadmapInfoPostgresRepository) GetAllByUserID(ctx context.Context, user
 	for rows.Next() {
 		roadmap := &entities.RoadmapInfo{}
 		var referencedRoadmapInfoID sql.NullString
+		var unpublishedAt sql.NullTime
 
 		if err = rows.Scan(
 			&roadmap.ID,
@@ -183,6 +196,7 @@ func (r *RoadmapInfoPostgresRepository) GetAllByUserID(ctx context.Context, user
 			&referencedRoadmapInfoID,
 			&roadmap.CreatedAt,
 			&roadmap.UpdatedAt,
+			&unpublishedAt,
 		); err != nil {
 			logger.WithError(err).Error("failed to scan roadmap row")
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -199,6 +213,10 @@ func (r *RoadmapInfoPostgresRepository) GetAllByUserID(ctx context.Context, user
 			roadmap.ReferencedRoadmapInfoID = nil
 		}
 
+		if unpublishedAt.Valid {
+			roadmap.UnpublishedAt = &unpublishedAt.Time
+		}
+
 		roadmaps = append(roadmaps, roadmap)
 	}
 
@@ -211,6 +229,76 @@ func (r *RoadmapInfoPostgresRepository) GetAllByUserID(ctx context.Context, user
 	return roadmaps, nil
 }
 
+func (r *RoadmapInfoPostgresRepository) GetAllCreatedBefore(ctx context.Context, createdBefore time.Time) ([]*entities.RoadmapInfo, error) {
+	const op = "RoadmapInfoRepository.GetAllCreatedBefore"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":             op,
+		"created_before": createdBefore,
+	})
+
+	rows, err := r.db.QueryContext(ctx, queryGetAllCreatedBefore, createdBefore)
+	if err != nil {
+		logger.WithError(err).Error("failed to query roadmaps")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("failed to close rows")
+		}
+	}()
+
+	roadmaps := []*entities.RoadmapInfo{}
+
+	for rows.Next() {
+		roadmap := &entities.RoadmapInfo{}
+		var referencedRoadmapInfoID sql.NullString
+		var unpublishedAt sql.NullTime
+
+		if err = rows.Scan(
+			&roadmap.ID,
+			&roadmap.RoadmapID,
+			&roadmap.AuthorID,
+			&roadmap.CategoryID,
+			&roadmap.Name,
+			&roadmap.Description,
+			&roadmap.IsPublic,
+			&referencedRoadmapInfoID,
+			&roadmap.CreatedAt,
+			&roadmap.UpdatedAt,
+			&unpublishedAt,
+		); err != nil {
+			logger.WithError(err).Error("failed to scan roadmap row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if referencedRoadmapInfoID.Valid {
+			parsedUUID, err := uuid.Parse(referencedRoadmapInfoID.String)
+			if err != nil {
+				logger.WithError(err).WithField("referenced_roadmap_id", referencedRoadmapInfoID.String).Error("invalid referenced roadmap ID in database")
+				return nil, fmt.Errorf("%s: %w", op, fmt.Errorf("invalid referenced_roadmap_id in database: %w", err))
+			}
+			roadmap.ReferencedRoadmapInfoID = &parsedUUID
+		} else {
+			roadmap.ReferencedRoadmapInfoID = nil
+		}
+
+		if unpublishedAt.Valid {
+			roadmap.UnpublishedAt = &unpublishedAt.Time
+		}
+
+		roadmaps = append(roadmaps, roadmap)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("error iterating rows")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("roadmaps_count", len(roadmaps)).Info("successfully retrieved roadmaps")
+	return roadmaps, nil
+}
+
 func (r *RoadmapInfoPostgresRepository) GetByID(ctx context.Context, roadmapID uuid.UUID) (*entities.RoadmapInfo, error) {
 	const op = "RoadmapInfoRepository.GetByID"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
@@ -220,6 +308,7 @@ func (r *RoadmapInfoPostgresRepository) GetByID(ctx context.Context, roadmapID u
 
 	roadmap := &entities.RoadmapInfo{}
 	var referencedRoadmapInfoID sql.NullString
+	var unpublishedAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, queryGetByID, roadmapID).Scan(
 		&roadmap.ID,
@@ -232,6 +321,7 @@ func (r *RoadmapInfoPostgresRepository) GetByID(ctx context.Context, roadmapID u
 		&referencedRoadmapInfoID,
 		&roadmap.CreatedAt,
 		&roadmap.UpdatedAt,
+		&unpublishedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -255,6 +345,10 @@ func (r *RoadmapInfoPostgresRepository) GetByID(ctx context.Context, roadmapID u
 		roadmap.ReferencedRoadmapInfoID = nil
 	}
 
+	if unpublishedAt.Valid {
+		roadmap.UnpublishedAt = &unpublishedAt.Time
+	}
+
 	logger.Info("successfully retrieved roadmap")
 	return roadmap, nil
 }
@@ -293,6 +387,7 @@ func (r *RoadmapInfoPostgresRepository) GetByIDs(ctx context.Context, ids []uuid
 	for rows.Next() {
 		roadmap := &entities.RoadmapInfo{}
 		var referencedRoadmapInfoID sql.NullString
+		var unpublishedAt sql.NullTime
 
 		if err = rows.Scan(
 			&roadmap.ID,
@@ -305,6 +400,7 @@ func (r *RoadmapInfoPostgresRepository) GetByIDs(ctx context.Context, ids []uuid
 			&referencedRoadmapInfoID,
 			&roadmap.CreatedAt,
 			&roadmap.UpdatedAt,
+			&unpublishedAt,
 		); err != nil {
 			logger.WithError(err).Error("failed to scan roadmap row")
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -321,6 +417,10 @@ func (r *RoadmapInfoPostgresRepository) GetByIDs(ctx context.Context, ids []uuid
 			roadmap.ReferencedRoadmapInfoID = nil
 		}
 
+		if unpublishedAt.Valid {
+			roadmap.UnpublishedAt = &unpublishedAt.Time
+		}
+
 		roadmaps = append(roadmaps, roadmap)
 	}
 
@@ -342,6 +442,7 @@ func (r *RoadmapInfoPostgresRepository) GetByRoadmapID(ctx context.Context, road
 
 	roadmap := &entities.RoadmapInfo{}
 	var referencedRoadmapInfoID sql.NullString
+	var unpublishedAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, queryGetByRoadmapID, roadmapID).Scan(
 		&roadmap.ID,
@@ -354,6 +455,7 @@ func (r *RoadmapInfoPostgresRepository) GetByRoadmapID(ctx context.Context, road
 		&referencedRoadmapInfoID,
 		&roadmap.CreatedAt,
 		&roadmap.UpdatedAt,
+		&unpublishedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -377,6 +479,10 @@ func (r *RoadmapInfoPostgresRepository) GetByRoadmapID(ctx context.Context, road
 		roadmap.ReferencedRoadmapInfoID = nil
 	}
 
+	if unpublishedAt.Valid {
+		roadmap.UnpublishedAt = &unpublishedAt.Time
+	}
+
 	logger.Info("successfully retrieved roadmap by roadmap ID")
 	return roadmap, nil
 }
@@ -397,6 +503,7 @@ func (r *RoadmapInfoPostgresRepository) Create(ctx context.Context, roadmap *ent
 
 	createdRoadmap := &entities.RoadmapInfo{}
 	var referencedRoadmapInfoID sql.NullString
+	var unpublishedAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, queryCreate,
 		roadmap.AuthorID,
@@ -417,6 +524,7 @@ func (r *RoadmapInfoPostgresRepository) Create(ctx context.Context, roadmap *ent
 		&referencedRoadmapInfoID,
 		&createdRoadmap.CreatedAt,
 		&createdRoadmap.UpdatedAt,
+		&unpublishedAt,
 	)
 	if err != nil {
 		logger.WithError(err).Error("failed to create roadmap")
@@ -434,6 +542,10 @@ func (r *RoadmapInfoPostgresRepository) Create(ctx context.Context, roadmap *ent
 		createdRoadmap.ReferencedRoadmapInfoID = nil
 	}
 
+	if unpublishedAt.Valid {
+		createdRoadmap.UnpublishedAt = &unpublishedAt.Time
+	}
+
 	logger.Info("successfully created roadmap")
 	return createdRoadmap, nil
 }
@@ -463,6 +575,7 @@ func (r *RoadmapInfoPostgresRepository) Update(ctx context.Context, roadmap *ent
 		refRoadmapInfoID,
 		roadmap.RoadmapID,
 		roadmap.UpdatedAt,
+		roadmap.UnpublishedAt,
 	)
 	if err != nil {
 		logger.WithError(err).Error("failed to update roadmap")
@@ -661,6 +774,7 @@ func (r *RoadmapInfoPostgresRepository) SearchPublic(ctx context.Context, query
 	for rows.Next() {
 		roadmap := &entities.RoadmapInfo{}
 		var referencedRoadmapInfoID sql.NullString
+		var unpublishedAt sql.NullTime
 
 		if err = rows.Scan(
 			&roadmap.ID,
@@ -673,6 +787,7 @@ func (r *RoadmapInfoPostgresRepository) SearchPublic(ctx context.Context, query
 			&referencedRoadmapInfoID,
 			&roadmap.CreatedAt,
 			&roadmap.UpdatedAt,
+			&unpublishedAt,
 		); err != nil {
 			logger.WithError(err).Error("failed to scan roadmap row")
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -689,6 +804,10 @@ func (r *RoadmapInfoPostgresRepository) SearchPublic(ctx context.Context, query
 			roadmap.ReferencedRoadmapInfoID = nil
 		}
 
+		if unpublishedAt.Valid {
+			roadmap.UnpublishedAt = &unpublishedAt.Time
+		}
+
 		roadmaps = append(roadmaps, roadmap)
 	}
 
@@ -700,3 +819,533 @@ func (r *RoadmapInfoPostgresRepository) SearchPublic(ctx context.Context, query
 	logger.WithField("roadmaps_count", len(roadmaps)).Info("successfully searched public roadmaps")
 	return roadmaps, nil
 }
+
+// CreateSaga stores a new saga. It returns nil when the user already started
+// a saga of the same kind with the same idempotency key.
+func (r *RoadmapInfoPostgresRepository) CreateSaga(ctx context.Context, saga *entities.RoadmapSaga) (*entities.RoadmapSaga, error) {
+	const op = "RoadmapInfoRepository.CreateSaga"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"kind":    saga.Kind,
+		"user_id": saga.UserID.String(),
+	})
+
+	row := r.db.QueryRowContext(ctx, queryCreateSaga,
+		saga.Kind,
+		saga.UserID,
+		saga.IdempotencyKey,
+		saga.SourceRoadmapInfoID,
+		saga.RoadmapID,
+		saga.CarryProgress,
+		saga.Status,
+		saga.Step,
+	)
+
+	createdSaga, err := scanSaga(row)
+	if err == sql.ErrNoRows {
+		logger.Info("saga with this idempotency key already exists")
+		return nil, nil
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to create saga")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return createdSaga, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) GetSagaByIdempotencyKey(ctx context.Context, userID uuid.UUID, kind entities.RoadmapSagaKind, idempotencyKey string) (*entities.RoadmapSaga, error) {
+	const op = "RoadmapInfoRepository.GetSagaByIdempotencyKey"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"kind":    kind,
+		"user_id": userID.String(),
+	})
+
+	saga, err := scanSaga(r.db.QueryRowContext(ctx, queryGetSagaByIdempotencyKey, userID, kind, idempotencyKey))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to get saga")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return saga, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) UpdateSaga(ctx context.Context, saga *entities.RoadmapSaga) error {
+	const op = "RoadmapInfoRepository.UpdateSaga"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"saga_id": saga.ID.String(),
+	})
+
+	var roadmapInfoID interface{}
+	if saga.RoadmapInfoID != nil {
+		roadmapInfoID = *saga.RoadmapInfoID
+	}
+
+	result, err := r.db.ExecContext(ctx, queryUpdateSaga,
+		saga.ID,
+		roadmapInfoID,
+		saga.Status,
+		saga.Step,
+		saga.Attempts,
+		saga.LastError,
+	)
+	if err != nil {
+		logger.WithError(err).Error("failed to update saga")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("failed to get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("saga not found for update")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("saga not found"))
+	}
+
+	return nil
+}
+
+// ClaimStalledSagas returns unfinished sagas that have not moved since
+// stalledBefore and touches them, so concurrent callers never claim the same
+// saga twice.
+func (r *RoadmapInfoPostgresRepository) ClaimStalledSagas(ctx context.Context, stalledBefore time.Time, limit int) ([]*entities.RoadmapSaga, error) {
+	const op = "RoadmapInfoRepository.ClaimStalledSagas"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryClaimStalledSagas, stalledBefore, limit)
+	if err != nil {
+		logger.WithError(err).Error("failed to claim stalled sagas")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("failed to close rows")
+		}
+	}()
+
+	sagas := []*entities.RoadmapSaga{}
+	for rows.Next() {
+		saga, err := scanSaga(rows)
+		if err != nil {
+			logger.WithError(err).Error("failed to scan saga row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		sagas = append(sagas, saga)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("error iterating rows")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sagas, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSaga(row rowScanner) (*entities.RoadmapSaga, error) {
+	saga := &entities.RoadmapSaga{}
+	var roadmapInfoID uuid.NullUUID
+
+	if err := row.Scan(
+		&saga.ID,
+		&saga.Kind,
+		&saga.UserID,
+		&saga.IdempotencyKey,
+		&saga.SourceRoadmapInfoID,
+		&saga.RoadmapID,
+		&roadmapInfoID,
+		&saga.CarryProgress,
+		&saga.Status,
+		&saga.Step,
+		&saga.Attempts,
+		&saga.LastError,
+		&saga.CreatedAt,
+		&saga.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if roadmapInfoID.Valid {
+		saga.RoadmapInfoID = &roadmapInfoID.UUID
+	}
+
+	return saga, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) CreateRelease(ctx context.Context, release *entities.RoadmapRelease) (*entities.RoadmapRelease, error) {
+	const op = "RoadmapInfoRepository.CreateRelease"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": release.RoadmapInfoID.String(),
+	})
+
+	var draftRoadmapInfoID interface{}
+	if release.DraftRoadmapInfoID != nil {
+		draftRoadmapInfoID = *release.DraftRoadmapInfoID
+	}
+
+	createdRelease, err := scanRelease(r.db.QueryRowContext(ctx, queryCreateRelease,
+		release.RoadmapInfoID,
+		draftRoadmapInfoID,
+		release.AuthorID,
+		release.Status,
+	))
+	if err != nil {
+		logger.WithError(err).Error("failed to create release")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("release_id", createdRelease.ID.String()).Info("successfully created release")
+	return createdRelease, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) GetReleaseByID(ctx context.Context, releaseID uuid.UUID) (*entities.RoadmapRelease, error) {
+	return r.getRelease(ctx, "RoadmapInfoRepository.GetReleaseByID", queryGetReleaseByID, releaseID)
+}
+
+func (r *RoadmapInfoPostgresRepository) GetReleaseByDraftRoadmapInfoID(ctx context.Context, draftRoadmapInfoID uuid.UUID) (*entities.RoadmapRelease, error) {
+	return r.getRelease(ctx, "RoadmapInfoRepository.GetReleaseByDraftRoadmapInfoID", queryGetReleaseByDraftRoadmapInfoID, draftRoadmapInfoID)
+}
+
+func (r *RoadmapInfoPostgresRepository) GetOpenRelease(ctx context.Context, roadmapInfoID uuid.UUID) (*entities.RoadmapRelease, error) {
+	return r.getRelease(ctx, "RoadmapInfoRepository.GetOpenRelease", queryGetOpenRelease, roadmapInfoID)
+}
+
+func (r *RoadmapInfoPostgresRepository) getRelease(ctx context.Context, op, query string, id uuid.UUID) (*entities.RoadmapRelease, error) {
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op": op,
+		"id": id.String(),
+	})
+
+	release, err := scanRelease(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to get release")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return release, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) GetReleasesByStatus(ctx context.Context, status entities.RoadmapReleaseStatus) ([]*entities.RoadmapRelease, error) {
+	return r.listReleases(ctx, "RoadmapInfoRepository.GetReleasesByStatus", queryGetReleasesByStatus, status)
+}
+
+func (r *RoadmapInfoPostgresRepository) GetReleasedByRoadmapInfoID(ctx context.Context, roadmapInfoID uuid.UUID) ([]*entities.RoadmapRelease, error) {
+	return r.listReleases(ctx, "RoadmapInfoRepository.GetReleasedByRoadmapInfoID", queryGetReleasedByRoadmapInfoID, roadmapInfoID)
+}
+
+func (r *RoadmapInfoPostgresRepository) listReleases(ctx context.Context, op, query string, arg interface{}) ([]*entities.RoadmapRelease, error) {
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, query, arg)
+	if err != nil {
+		logger.WithError(err).Error("failed to query releases")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("failed to close rows")
+		}
+	}()
+
+	releases := []*entities.RoadmapRelease{}
+	for rows.Next() {
+		release, err := scanRelease(rows)
+		if err != nil {
+			logger.WithError(err).Error("failed to scan release row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		releases = append(releases, release)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("error iterating rows")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return releases, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) GetLatestReleaseVersion(ctx context.Context, roadmapInfoID uuid.UUID) (int, error) {
+	const op = "RoadmapInfoRepository.GetLatestReleaseVersion"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+	})
+
+	var version int
+	if err := r.db.QueryRowContext(ctx, queryGetLatestReleaseVersion, roadmapInfoID).Scan(&version); err != nil {
+		logger.WithError(err).Error("failed to get latest release version")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) UpdateRelease(ctx context.Context, release *entities.RoadmapRelease) error {
+	const op = "RoadmapInfoRepository.UpdateRelease"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"release_id": release.ID.String(),
+	})
+
+	var draftRoadmapInfoID, reviewerID interface{}
+	if release.DraftRoadmapInfoID != nil {
+		draftRoadmapInfoID = *release.DraftRoadmapInfoID
+	}
+	if release.ReviewerID != nil {
+		reviewerID = *release.ReviewerID
+	}
+
+	result, err := r.db.ExecContext(ctx, queryUpdateRelease,
+		release.ID,
+		draftRoadmapInfoID,
+		release.Version,
+		release.Status,
+		release.Notes,
+		reviewerID,
+		release.ReviewComment,
+		release.ReviewedAt,
+		release.ReleasedAt,
+	)
+	if err != nil {
+		logger.WithError(err).Error("failed to update release")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("failed to get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("release not found for update")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("release not found"))
+	}
+
+	return nil
+}
+
+func scanRelease(row rowScanner) (*entities.RoadmapRelease, error) {
+	release := &entities.RoadmapRelease{}
+	var draftRoadmapInfoID, reviewerID uuid.NullUUID
+	var reviewedAt, releasedAt sql.NullTime
+
+	if err := row.Scan(
+		&release.ID,
+		&release.RoadmapInfoID,
+		&draftRoadmapInfoID,
+		&release.AuthorID,
+		&release.Version,
+		&release.Status,
+		&release.Notes,
+		&reviewerID,
+		&release.ReviewComment,
+		&reviewedAt,
+		&releasedAt,
+		&release.CreatedAt,
+		&release.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if draftRoadmapInfoID.Valid {
+		release.DraftRoadmapInfoID = &draftRoadmapInfoID.UUID
+	}
+	if reviewerID.Valid {
+		release.ReviewerID = &reviewerID.UUID
+	}
+	if reviewedAt.Valid {
+		release.ReviewedAt = &reviewedAt.Time
+	}
+	if releasedAt.Valid {
+		release.ReleasedAt = &releasedAt.Time
+	}
+
+	return release, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) CreateCollaborator(ctx context.Context, collaborator *entities.RoadmapCollaborator) (*entities.RoadmapCollaborator, error) {
+	const op = "RoadmapInfoRepository.CreateCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": collaborator.RoadmapInfoID.String(),
+		"user_id":         collaborator.UserID.String(),
+	})
+
+	createdCollaborator, err := scanCollaborator(r.db.QueryRowContext(ctx, queryCreateCollaborator,
+		collaborator.RoadmapInfoID,
+		collaborator.UserID,
+		collaborator.Role,
+		collaborator.Status,
+		collaborator.InvitedBy,
+	))
+	if err != nil {
+		logger.WithError(err).Error("failed to create collaborator")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("successfully created collaborator")
+	return createdCollaborator, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) GetCollaborator(ctx context.Context, roadmapInfoID, userID uuid.UUID) (*entities.RoadmapCollaborator, error) {
+	const op = "RoadmapInfoRepository.GetCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	collaborator, err := scanCollaborator(r.db.QueryRowContext(ctx, queryGetCollaborator, roadmapInfoID, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborator")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return collaborator, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) GetCollaborators(ctx context.Context, roadmapInfoID uuid.UUID) ([]*entities.RoadmapCollaborator, error) {
+	return r.listCollaborators(ctx, "RoadmapInfoRepository.GetCollaborators", queryGetCollaborators, roadmapInfoID)
+}
+
+func (r *RoadmapInfoPostgresRepository) GetCollaborationsByUserID(ctx context.Context, userID uuid.UUID, status entities.CollaboratorStatus) ([]*entities.RoadmapCollaborator, error) {
+	return r.listCollaborators(ctx, "RoadmapInfoRepository.GetCollaborationsByUserID", queryGetCollaborationsByUserID, userID, status)
+}
+
+func (r *RoadmapInfoPostgresRepository) listCollaborators(ctx context.Context, op, query string, args ...interface{}) ([]*entities.RoadmapCollaborator, error) {
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.WithError(err).Error("failed to query collaborators")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			logger.WithError(closeErr).Warn("failed to close rows")
+		}
+	}()
+
+	collaborators := []*entities.RoadmapCollaborator{}
+	for rows.Next() {
+		collaborator, err := scanCollaborator(rows)
+		if err != nil {
+			logger.WithError(err).Error("failed to scan collaborator row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("error iterating rows")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return collaborators, nil
+}
+
+func (r *RoadmapInfoPostgresRepository) UpdateCollaborator(ctx context.Context, collaborator *entities.RoadmapCollaborator) error {
+	const op = "RoadmapInfoRepository.UpdateCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": collaborator.RoadmapInfoID.String(),
+		"user_id":         collaborator.UserID.String(),
+	})
+
+	result, err := r.db.ExecContext(ctx, queryUpdateCollaborator,
+		collaborator.RoadmapInfoID,
+		collaborator.UserID,
+		collaborator.Role,
+		collaborator.Status,
+	)
+	if err != nil {
+		logger.WithError(err).Error("failed to update collaborator")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("failed to get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("collaborator not found for update")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("collaborator not found"))
+	}
+
+	return nil
+}
+
+func (r *RoadmapInfoPostgresRepository) DeleteCollaborator(ctx context.Context, roadmapInfoID, userID uuid.UUID) error {
+	const op = "RoadmapInfoRepository.DeleteCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	result, err := r.db.ExecContext(ctx, queryDeleteCollaborator, roadmapInfoID, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to delete collaborator")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("failed to get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("collaborator not found for deletion")
+		return fmt.Errorf("%s: %w", op, fmt.Errorf("collaborator not found"))
+	}
+
+	return nil
+}
+
+func scanCollaborator(row rowScanner) (*entities.RoadmapCollaborator, error) {
+	collaborator := &entities.RoadmapCollaborator{}
+
+	if err := row.Scan(
+		&collaborator.RoadmapInfoID,
+		&collaborator.UserID,
+		&collaborator.Role,
+		&collaborator.Status,
+		&collaborator.InvitedBy,
+		&collaborator.CreatedAt,
+		&collaborator.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return collaborator, nil
+}
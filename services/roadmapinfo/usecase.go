@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/services/roadmapinfo/dto"
 )
 
@@ -17,11 +18,36 @@ type Usecase interface {
 	CreatePrivate(ctx context.Context, request *dto.CreatePrivateRoadmapInfoRequestDTO) (*dto.CreatePrivateRoadmapInfoResponseDTO, error)
 	UpdatePrivate(context.Context, uuid.UUID, uuid.UUID, *dto.UpdatePrivateRoadmapInfoRequestDTO) error
 	Delete(context.Context, uuid.UUID, uuid.UUID) error
-	Fork(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) (*dto.CreatePrivateRoadmapInfoResponseDTO, error)
-	Publish(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) (*dto.CreatePrivateRoadmapInfoResponseDTO, error)
+	Fork(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID, carryProgress bool, idempotencyKey string) (*dto.CreatePrivateRoadmapInfoResponseDTO, error)
+	Publish(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID, carryProgress bool, idempotencyKey string) (*dto.CreatePrivateRoadmapInfoResponseDTO, error)
 	Subscribe(ctx context.Context, roadmapInfoID, userID uuid.UUID) error
 	Unsubscribe(ctx context.Context, roadmapInfoID, userID uuid.UUID) error
 	GetSubscribed(ctx context.Context, userID uuid.UUID) (*dto.GetSubscribedRoadmapsInfoResponseDTO, error)
 	CheckSubscription(ctx context.Context, roadmapInfoID, userID uuid.UUID) (bool, error)
 	SearchPublic(ctx context.Context, query string, categoryID *uuid.UUID) (*dto.GetAllRoadmapsInfoResponseDTO, error)
+	CreateDraft(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID, idempotencyKey string) (*dto.CreatePrivateRoadmapInfoResponseDTO, error)
+	SubmitRelease(ctx context.Context, draftRoadmapInfoID uuid.UUID, userID uuid.UUID, req *dto.SubmitReleaseRequestDTO) (*dto.RoadmapReleaseResponseDTO, error)
+	GetPendingReleases(ctx context.Context) (*dto.GetReleasesResponseDTO, error)
+	ReviewRelease(ctx context.Context, releaseID uuid.UUID, reviewerID uuid.UUID, req *dto.ReviewReleaseRequestDTO) (*dto.RoadmapReleaseResponseDTO, error)
+	Release(ctx context.Context, releaseID uuid.UUID, userID uuid.UUID) (*dto.GetByIDRoadmapInfoResponseDTO, error)
+	GetReleases(ctx context.Context, roadmapInfoID uuid.UUID) (*dto.GetReleasesResponseDTO, error)
+	Unpublish(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) error
+	Restore(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) error
+	GetUserRole(ctx context.Context, roadmapInfoID, userID uuid.UUID) (entities.CollaboratorRole, error)
+	InviteCollaborator(ctx context.Context, roadmapInfoID, userID uuid.UUID, req *dto.InviteCollaboratorRequestDTO) (*dto.CollaboratorResponseDTO, error)
+	GetCollaborators(ctx context.Context, roadmapInfoID, userID uuid.UUID) (*dto.GetCollaboratorsResponseDTO, error)
+	UpdateCollaborator(ctx context.Context, roadmapInfoID, userID, collaboratorID uuid.UUID, req *dto.UpdateCollaboratorRequestDTO) (*dto.CollaboratorResponseDTO, error)
+	RemoveCollaborator(ctx context.Context, roadmapInfoID, userID, collaboratorID uuid.UUID) error
+	GetInvitations(ctx context.Context, userID uuid.UUID) (*dto.GetInvitationsResponseDTO, error)
+	RespondToInvitation(ctx context.Context, roadmapInfoID, userID uuid.UUID, accept bool) error
+	GetShared(ctx context.Context, userID uuid.UUID) (*dto.GetAllRoadmapsInfoResponseDTO, error)
+}
+
+type ReconcilerUsecase interface {
+	Reconcile(ctx context.Context) error
+}
+
+type SagaUsecase interface {
+	Start(ctx context.Context, saga *entities.RoadmapSaga) (*entities.RoadmapSaga, error)
+	ResumeStalled(ctx context.Context) error
 }
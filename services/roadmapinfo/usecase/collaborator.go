@@ -0,0 +1,460 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo/dto"
+)
+
+// userRole returns the role the user has on the roadmap: owner for its
+// author, the role of an accepted invitation otherwise, and an empty role
+// when the user has no access beyond what is public.
+func (uc *RoadmapInfoUsecase) userRole(ctx context.Context, roadmapInfo *entities.RoadmapInfo, userID uuid.UUID) (entities.CollaboratorRole, error) {
+	if roadmapInfo == nil || userID == uuid.Nil {
+		return "", nil
+	}
+
+	if roadmapInfo.AuthorID == userID {
+		return entities.CollaboratorRoleOwner, nil
+	}
+
+	collaborator, err := uc.repo.GetCollaborator(ctx, roadmapInfo.ID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if collaborator == nil || collaborator.Status != entities.CollaboratorStatusAccepted {
+		return "", nil
+	}
+
+	return collaborator.Role, nil
+}
+
+func (uc *RoadmapInfoUsecase) GetUserRole(ctx context.Context, roadmapInfoID, userID uuid.UUID) (entities.CollaboratorRole, error) {
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		return "", errs.ErrNotFound
+	}
+
+	return uc.userRole(ctx, roadmapInfo, userID)
+}
+
+// InviteCollaborator invites a friend of the inviter to work on the roadmap.
+// The invitation is pending until the invitee accepts it.
+func (uc *RoadmapInfoUsecase) InviteCollaborator(ctx context.Context, roadmapInfoID, userID uuid.UUID, req *dto.InviteCollaboratorRequestDTO) (*dto.CollaboratorResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.InviteCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+		"invitee_id":      req.UserID.String(),
+		"role":            req.Role,
+	})
+
+	role := entities.CollaboratorRole(req.Role)
+	if !role.IsValid() {
+		logger.Warn("invalid collaborator role")
+		return nil, fmt.Errorf("validation failed: role must be one of owner, editor, viewer")
+	}
+
+	if req.UserID == uuid.Nil || req.UserID == userID {
+		logger.Warn("invalid invitee")
+		return nil, fmt.Errorf("validation failed: invitee must be another user")
+	}
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		logger.Warn("roadmap info not found")
+		return nil, errs.ErrNotFound
+	}
+
+	inviterRole, err := uc.userRole(ctx, roadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return nil, fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !inviterRole.CanManage() {
+		logger.WithField("inviter_role", inviterRole).Warn("user cannot manage collaborators")
+		return nil, errs.ErrForbidden
+	}
+
+	if req.UserID == roadmapInfo.AuthorID {
+		logger.Warn("attempt to invite roadmap author")
+		return nil, fmt.Errorf("attempt to invite the roadmap author")
+	}
+
+	friendship, err := uc.friendClient.GetFriendshipStatus(ctx, &friendclient.GetFriendshipStatusRequest{
+		UserId:       userID.String(),
+		TargetUserId: req.UserID.String(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to get friendship status")
+		return nil, fmt.Errorf("failed to get friendship status: %w", err)
+	}
+
+	if friendship.Error != "" {
+		logger.WithField("error", friendship.Error).Error("failed to get friendship status")
+		return nil, fmt.Errorf("failed to get friendship status: %s", friendship.Error)
+	}
+
+	if friendship.Status != string(entities.FriendStatusAccepted) {
+		logger.WithField("friendship_status", friendship.Status).Warn("attempt to invite non-friend")
+		return nil, fmt.Errorf("attempt to invite a user who is not a friend")
+	}
+
+	existing, err := uc.repo.GetCollaborator(ctx, roadmapInfo.ID, req.UserID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborator")
+		return nil, fmt.Errorf("failed to get collaborator: %w", err)
+	}
+
+	if existing != nil {
+		logger.WithField("status", existing.Status).Warn("attempt to invite existing collaborator")
+		return nil, fmt.Errorf("attempt to invite an existing collaborator")
+	}
+
+	collaborator, err := uc.repo.CreateCollaborator(ctx, &entities.RoadmapCollaborator{
+		RoadmapInfoID: roadmapInfo.ID,
+		UserID:        req.UserID,
+		Role:          role,
+		Status:        entities.CollaboratorStatusPending,
+		InvitedBy:     userID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to create collaborator")
+		return nil, fmt.Errorf("failed to create collaborator: %w", err)
+	}
+
+	inviter := uc.fetchSingleUserData(ctx, userID)
+	if err := uc.notificationPublisher.NotifyCollaboratorInvite(ctx, collaborator, roadmapInfo.Name, inviter.Username); err != nil {
+		logger.WithError(err).Warn("failed to publish collaborator invite notification")
+	}
+
+	logger.Info("successfully invited collaborator")
+
+	invitee := uc.fetchSingleUserData(ctx, collaborator.UserID)
+	return &dto.CollaboratorResponseDTO{Collaborator: dto.CollaboratorToDTO(collaborator, invitee)}, nil
+}
+
+func (uc *RoadmapInfoUsecase) GetCollaborators(ctx context.Context, roadmapInfoID, userID uuid.UUID) (*dto.GetCollaboratorsResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.GetCollaborators"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		logger.Warn("roadmap info not found")
+		return nil, errs.ErrNotFound
+	}
+
+	role, err := uc.userRole(ctx, roadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return nil, fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !role.CanView() {
+		logger.Warn("user has no access to roadmap collaborators")
+		return nil, errs.ErrForbidden
+	}
+
+	collaborators, err := uc.repo.GetCollaborators(ctx, roadmapInfo.ID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborators")
+		return nil, fmt.Errorf("failed to get collaborators: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		userIDs = append(userIDs, collaborator.UserID)
+	}
+
+	logger.WithField("count", len(collaborators)).Info("successfully retrieved collaborators")
+	return &dto.GetCollaboratorsResponseDTO{
+		Collaborators: dto.CollaboratorListToDTO(collaborators, uc.fetchUserData(ctx, userIDs)),
+	}, nil
+}
+
+func (uc *RoadmapInfoUsecase) UpdateCollaborator(ctx context.Context, roadmapInfoID, userID, collaboratorID uuid.UUID, req *dto.UpdateCollaboratorRequestDTO) (*dto.CollaboratorResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.UpdateCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+		"collaborator_id": collaboratorID.String(),
+		"role":            req.Role,
+	})
+
+	role := entities.CollaboratorRole(req.Role)
+	if !role.IsValid() {
+		logger.Warn("invalid collaborator role")
+		return nil, fmt.Errorf("validation failed: role must be one of owner, editor, viewer")
+	}
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		logger.Warn("roadmap info not found")
+		return nil, errs.ErrNotFound
+	}
+
+	managerRole, err := uc.userRole(ctx, roadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return nil, fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !managerRole.CanManage() {
+		logger.WithField("manager_role", managerRole).Warn("user cannot manage collaborators")
+		return nil, errs.ErrForbidden
+	}
+
+	collaborator, err := uc.repo.GetCollaborator(ctx, roadmapInfo.ID, collaboratorID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborator")
+		return nil, fmt.Errorf("failed to get collaborator: %w", err)
+	}
+
+	if collaborator == nil {
+		logger.Warn("collaborator not found")
+		return nil, errs.ErrNotFound
+	}
+
+	collaborator.Role = role
+	if err := uc.repo.UpdateCollaborator(ctx, collaborator); err != nil {
+		logger.WithError(err).Error("failed to update collaborator")
+		return nil, fmt.Errorf("failed to update collaborator: %w", err)
+	}
+
+	logger.Info("successfully updated collaborator")
+
+	user := uc.fetchSingleUserData(ctx, collaborator.UserID)
+	return &dto.CollaboratorResponseDTO{Collaborator: dto.CollaboratorToDTO(collaborator, user)}, nil
+}
+
+// RemoveCollaborator removes a collaborator or withdraws a pending invitation.
+// Collaborators may also remove themselves to leave a roadmap.
+func (uc *RoadmapInfoUsecase) RemoveCollaborator(ctx context.Context, roadmapInfoID, userID, collaboratorID uuid.UUID) error {
+	const op = "RoadmapInfoUsecase.RemoveCollaborator"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+		"collaborator_id": collaboratorID.String(),
+	})
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		logger.Warn("roadmap info not found")
+		return errs.ErrNotFound
+	}
+
+	if collaboratorID != userID {
+		role, err := uc.userRole(ctx, roadmapInfo, userID)
+		if err != nil {
+			logger.WithError(err).Error("failed to get user role")
+			return fmt.Errorf("failed to get user role: %w", err)
+		}
+
+		if !role.CanManage() {
+			logger.WithField("role", role).Warn("user cannot manage collaborators")
+			return errs.ErrForbidden
+		}
+	}
+
+	collaborator, err := uc.repo.GetCollaborator(ctx, roadmapInfo.ID, collaboratorID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborator")
+		return fmt.Errorf("failed to get collaborator: %w", err)
+	}
+
+	if collaborator == nil {
+		logger.Warn("collaborator not found")
+		return errs.ErrNotFound
+	}
+
+	if err := uc.repo.DeleteCollaborator(ctx, roadmapInfo.ID, collaboratorID); err != nil {
+		logger.WithError(err).Error("failed to delete collaborator")
+		return fmt.Errorf("failed to delete collaborator: %w", err)
+	}
+
+	logger.Info("successfully removed collaborator")
+	return nil
+}
+
+func (uc *RoadmapInfoUsecase) GetInvitations(ctx context.Context, userID uuid.UUID) (*dto.GetInvitationsResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.GetInvitations"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	invitations, err := uc.repo.GetCollaborationsByUserID(ctx, userID, entities.CollaboratorStatusPending)
+	if err != nil {
+		logger.WithError(err).Error("failed to get invitations")
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+
+	if len(invitations) == 0 {
+		return &dto.GetInvitationsResponseDTO{Invitations: []dto.InvitationDTO{}}, nil
+	}
+
+	roadmapInfoIDs := make([]uuid.UUID, 0, len(invitations))
+	for _, invitation := range invitations {
+		roadmapInfoIDs = append(roadmapInfoIDs, invitation.RoadmapInfoID)
+	}
+
+	roadmaps, err := uc.repo.GetByIDs(ctx, roadmapInfoIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmaps by IDs")
+		return nil, fmt.Errorf("failed to get roadmaps by IDs: %w", err)
+	}
+
+	roadmapsByID := make(map[uuid.UUID]*entities.RoadmapInfo, len(roadmaps))
+	userIDs := make([]uuid.UUID, 0, len(roadmaps)+len(invitations))
+	for _, roadmap := range roadmaps {
+		roadmapsByID[roadmap.ID] = roadmap
+		userIDs = append(userIDs, roadmap.AuthorID)
+	}
+	for _, invitation := range invitations {
+		userIDs = append(userIDs, invitation.InvitedBy)
+	}
+
+	userData := uc.fetchUserData(ctx, userIDs)
+
+	invitationDTOs := make([]dto.InvitationDTO, 0, len(invitations))
+	for _, invitation := range invitations {
+		roadmap, ok := roadmapsByID[invitation.RoadmapInfoID]
+		if !ok {
+			continue
+		}
+
+		invitationDTOs = append(invitationDTOs, dto.InvitationDTO{
+			RoadmapInfo: dto.RoadmapInfoToDTO(roadmap, userData[roadmap.AuthorID]),
+			Role:        string(invitation.Role),
+			InvitedBy:   userData[invitation.InvitedBy],
+			CreatedAt:   invitation.CreatedAt,
+		})
+	}
+
+	logger.WithField("count", len(invitationDTOs)).Info("successfully retrieved invitations")
+	return &dto.GetInvitationsResponseDTO{Invitations: invitationDTOs}, nil
+}
+
+func (uc *RoadmapInfoUsecase) RespondToInvitation(ctx context.Context, roadmapInfoID, userID uuid.UUID, accept bool) error {
+	const op = "RoadmapInfoUsecase.RespondToInvitation"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+		"accept":          accept,
+	})
+
+	invitation, err := uc.repo.GetCollaborator(ctx, roadmapInfoID, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get invitation")
+		return fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	if invitation == nil {
+		logger.Warn("invitation not found")
+		return errs.ErrNotFound
+	}
+
+	if invitation.Status != entities.CollaboratorStatusPending {
+		logger.WithField("status", invitation.Status).Warn("attempt to respond to accepted invitation")
+		return fmt.Errorf("attempt to respond to an invitation that is already accepted")
+	}
+
+	if !accept {
+		if err := uc.repo.DeleteCollaborator(ctx, roadmapInfoID, userID); err != nil {
+			logger.WithError(err).Error("failed to decline invitation")
+			return fmt.Errorf("failed to decline invitation: %w", err)
+		}
+
+		logger.Info("successfully declined invitation")
+		return nil
+	}
+
+	invitation.Status = entities.CollaboratorStatusAccepted
+	if err := uc.repo.UpdateCollaborator(ctx, invitation); err != nil {
+		logger.WithError(err).Error("failed to accept invitation")
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	logger.Info("successfully accepted invitation")
+	return nil
+}
+
+func (uc *RoadmapInfoUsecase) GetShared(ctx context.Context, userID uuid.UUID) (*dto.GetAllRoadmapsInfoResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.GetShared"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":      op,
+		"user_id": userID.String(),
+	})
+
+	collaborations, err := uc.repo.GetCollaborationsByUserID(ctx, userID, entities.CollaboratorStatusAccepted)
+	if err != nil {
+		logger.WithError(err).Error("failed to get collaborations")
+		return nil, fmt.Errorf("failed to get collaborations: %w", err)
+	}
+
+	if len(collaborations) == 0 {
+		return &dto.GetAllRoadmapsInfoResponseDTO{RoadmapsInfo: []dto.RoadmapInfoDTO{}}, nil
+	}
+
+	roadmapInfoIDs := make([]uuid.UUID, 0, len(collaborations))
+	for _, collaboration := range collaborations {
+		roadmapInfoIDs = append(roadmapInfoIDs, collaboration.RoadmapInfoID)
+	}
+
+	roadmaps, err := uc.repo.GetByIDs(ctx, roadmapInfoIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmaps by IDs")
+		return nil, fmt.Errorf("failed to get roadmaps by IDs: %w", err)
+	}
+
+	authorIDs := make([]uuid.UUID, 0, len(roadmaps))
+	for _, roadmap := range roadmaps {
+		authorIDs = append(authorIDs, roadmap.AuthorID)
+	}
+
+	roadmapDTOs := dto.RoadmapInfoListToDTO(roadmaps, uc.fetchUserData(ctx, authorIDs))
+
+	logger.WithField("count", len(roadmapDTOs)).Info("successfully retrieved shared roadmaps")
+	return &dto.GetAllRoadmapsInfoResponseDTO{RoadmapsInfo: roadmapDTOs}, nil
+}
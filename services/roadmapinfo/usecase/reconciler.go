@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
+	"github.com/F0urward/proftwist-backend/internal/metrics"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+)
+
+const (
+	issueOrphanedRoadmap = "orphaned_roadmap"
+	issueMissingRoadmap  = "missing_roadmap"
+	issueMissingNodeChat = "missing_node_chat"
+)
+
+// ReconcilerUsecase finds records that operations spanning Mongo, Postgres
+// and the chat service left behind half done: roadmaps without roadmap info,
+// roadmap info pointing at a deleted roadmap and public nodes without a chat.
+type ReconcilerUsecase struct {
+	cfg           config.ReconcilerConfig
+	repo          roadmapinfo.Repository
+	roadmapClient roadmapclient.RoadmapServiceClient
+	chatClient    chatclient.ChatServiceClient
+	metrics       metrics.Metrics
+}
+
+func NewReconcilerUsecase(
+	cfg *config.Config,
+	repo roadmapinfo.Repository,
+	roadmapClient roadmapclient.RoadmapServiceClient,
+	chatClient chatclient.ChatServiceClient,
+	mtrs metrics.Metrics,
+) roadmapinfo.ReconcilerUsecase {
+	return &ReconcilerUsecase{
+		cfg:           cfg.Workers.Reconciler,
+		repo:          repo,
+		roadmapClient: roadmapClient,
+		chatClient:    chatClient,
+		metrics:       mtrs,
+	}
+}
+
+func (uc *ReconcilerUsecase) Reconcile(ctx context.Context) error {
+	const op = "ReconcilerUsecase.Reconcile"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":    op,
+		"apply": uc.cfg.Apply,
+	})
+
+	createdBefore := time.Now().Add(-uc.cfg.GracePeriod)
+
+	idsResp, err := uc.roadmapClient.ListRoadmapIDs(ctx, &roadmapclient.ListRoadmapIDsRequest{
+		CreatedBefore: timestamppb.New(createdBefore),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to list roadmap IDs")
+		return fmt.Errorf("failed to list roadmap IDs: %w", err)
+	}
+
+	if idsResp.Error != "" {
+		logger.WithField("error", idsResp.Error).Error("failed to list roadmap IDs")
+		return fmt.Errorf("failed to list roadmap IDs: %s", idsResp.Error)
+	}
+
+	roadmapInfos, err := uc.repo.GetAllCreatedBefore(ctx, createdBefore)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap infos")
+		return fmt.Errorf("failed to get roadmap infos: %w", err)
+	}
+
+	issues := map[string]int{
+		issueOrphanedRoadmap: 0,
+		issueMissingRoadmap:  0,
+		issueMissingNodeChat: 0,
+	}
+
+	referenced := make(map[string]bool, len(roadmapInfos))
+	for _, roadmapInfo := range roadmapInfos {
+		referenced[roadmapInfo.RoadmapID] = true
+	}
+
+	existing := make(map[string]bool, len(idsResp.Ids))
+	for _, roadmapID := range idsResp.Ids {
+		existing[roadmapID] = true
+
+		if referenced[roadmapID] || ctx.Err() != nil {
+			continue
+		}
+
+		if uc.checkOrphanedRoadmap(ctx, roadmapID) {
+			issues[issueOrphanedRoadmap]++
+		}
+	}
+
+	for _, roadmapInfo := range roadmapInfos {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if !existing[roadmapInfo.RoadmapID] {
+			issues[issueMissingRoadmap]++
+			uc.repairMissingRoadmap(ctx, roadmapInfo)
+			continue
+		}
+
+		if roadmapInfo.IsPublic {
+			issues[issueMissingNodeChat] += uc.checkNodeChats(ctx, roadmapInfo, createdBefore)
+		}
+	}
+
+	uc.metrics.SetConsistencyIssues(issues)
+
+	logger.WithFields(map[string]interface{}{
+		"roadmaps":           len(idsResp.Ids),
+		"roadmap_infos":      len(roadmapInfos),
+		issueOrphanedRoadmap: issues[issueOrphanedRoadmap],
+		issueMissingRoadmap:  issues[issueMissingRoadmap],
+		issueMissingNodeChat: issues[issueMissingNodeChat],
+	}).Info("finished consistency check")
+
+	return nil
+}
+
+// checkOrphanedRoadmap reports whether the roadmap has no roadmap info. The
+// roadmap info is looked up once more so one created after the listing is
+// not mistaken for a missing one.
+func (uc *ReconcilerUsecase) checkOrphanedRoadmap(ctx context.Context, roadmapID string) bool {
+	logger := ctxutil.GetLogger(ctx).WithField("roadmap_id", roadmapID)
+
+	roadmapInfo, err := uc.repo.GetByRoadmapID(ctx, roadmapID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get roadmap info for roadmap")
+		return false
+	}
+
+	if roadmapInfo != nil {
+		return false
+	}
+
+	logger.Warn("found roadmap without roadmap info")
+
+	uc.repair(ctx, issueOrphanedRoadmap, func() error {
+		resp, err := uc.roadmapClient.Delete(ctx, &roadmapclient.DeleteRequest{Id: roadmapID})
+		if err != nil {
+			return err
+		}
+		if resp.Error != "" {
+			return errors.New(resp.Error)
+		}
+		return nil
+	})
+
+	return true
+}
+
+func (uc *ReconcilerUsecase) repairMissingRoadmap(ctx context.Context, roadmapInfo *entities.RoadmapInfo) {
+	ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfo.ID.String(),
+		"roadmap_id":      roadmapInfo.RoadmapID,
+	}).Warn("found roadmap info without roadmap")
+
+	uc.repair(ctx, issueMissingRoadmap, func() error {
+		return uc.repo.Delete(ctx, roadmapInfo.ID)
+	})
+}
+
+// checkNodeChats returns the number of nodes of the public roadmap that have
+// no group chat. Roadmaps changed during the grace period are skipped, since
+// chats for new nodes are created in the background.
+func (uc *ReconcilerUsecase) checkNodeChats(ctx context.Context, roadmapInfo *entities.RoadmapInfo, createdBefore time.Time) int {
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"roadmap_info_id": roadmapInfo.ID.String(),
+		"roadmap_id":      roadmapInfo.RoadmapID,
+	})
+
+	roadmapResp, err := uc.roadmapClient.GetByIDWithMaterials(ctx, &roadmapclient.GetByIDWithMaterialsRequest{
+		Id: roadmapInfo.RoadmapID,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("failed to get roadmap")
+		return 0
+	}
+
+	if roadmapResp.Roadmap == nil {
+		logger.WithField("error", roadmapResp.Error).Warn("failed to get roadmap")
+		return 0
+	}
+
+	if roadmapResp.Roadmap.UpdatedAt.AsTime().After(createdBefore) {
+		return 0
+	}
+
+	missing := 0
+	for _, node := range roadmapResp.Roadmap.Nodes {
+		if ctx.Err() != nil {
+			break
+		}
+
+		chatResp, err := uc.chatClient.GetGroupChatByNode(ctx, &chatclient.GetGroupChatByNodeRequest{
+			NodeId: node.Id,
+		})
+		if err != nil {
+			logger.WithError(err).WithField("node_id", node.Id).Warn("failed to get chat for node")
+			continue
+		}
+
+		if chatResp.GroupChat != nil && chatResp.GroupChat.Id != "" {
+			continue
+		}
+
+		if !errs.IsNotFoundError(errors.New(chatResp.Error)) {
+			logger.WithField("node_id", node.Id).WithField("error", chatResp.Error).Warn("failed to get chat for node")
+			continue
+		}
+
+		missing++
+		logger.WithField("node_id", node.Id).Warn("found public node without chat")
+
+		uc.repair(ctx, issueMissingNodeChat, func() error {
+			resp, err := uc.chatClient.CreateGroupChat(ctx, &chatclient.CreateGroupChatRequest{
+				UserId:        roadmapInfo.AuthorID.String(),
+				Title:         fmt.Sprintf("Discussion: %s", node.GetData().GetLabel()),
+				RoadmapNodeId: node.Id,
+				MemberIds:     []string{},
+			})
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return errors.New(resp.Error)
+			}
+			return nil
+		})
+	}
+
+	return missing
+}
+
+// repair runs fix in apply mode and records the outcome. In dry-run mode the
+// issue is only reported.
+func (uc *ReconcilerUsecase) repair(ctx context.Context, kind string, fix func() error) {
+	if !uc.cfg.Apply {
+		return
+	}
+
+	if err := fix(); err != nil {
+		ctxutil.GetLogger(ctx).WithError(err).WithField("kind", kind).Warn("failed to repair inconsistency")
+		uc.metrics.IncConsistencyRepair(kind, "failed")
+		return
+	}
+
+	uc.metrics.IncConsistencyRepair(kind, "repaired")
+}
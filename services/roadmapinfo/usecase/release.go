@@ -0,0 +1,417 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo/dto"
+)
+
+// CreateDraft opens a private copy of a published roadmap for the author to
+// edit. A roadmap has at most one open draft; asking again returns it.
+func (uc *RoadmapInfoUsecase) CreateDraft(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID, idempotencyKey string) (*dto.CreatePrivateRoadmapInfoResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.CreateDraft"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		logger.Warn("roadmap info not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if !roadmapInfo.IsPublic {
+		logger.Warn("attempt to draft private roadmap")
+		return nil, fmt.Errorf("attempt to draft private roadmap: edit it directly")
+	}
+
+	role, err := uc.userRole(ctx, roadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return nil, fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !role.CanManage() {
+		logger.WithFields(map[string]interface{}{
+			"request_user_id": userID.String(),
+			"author_id":       roadmapInfo.AuthorID.String(),
+			"role":            role,
+		}).Warn("user cannot manage the roadmap info")
+		return nil, errs.ErrForbidden
+	}
+
+	openRelease, err := uc.repo.GetOpenRelease(ctx, roadmapInfo.ID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get open release")
+		return nil, fmt.Errorf("failed to get open release: %w", err)
+	}
+
+	if openRelease != nil && openRelease.DraftRoadmapInfoID != nil {
+		draft, err := uc.repo.GetByID(ctx, *openRelease.DraftRoadmapInfoID)
+		if err != nil {
+			logger.WithError(err).Error("failed to get draft roadmap info")
+			return nil, fmt.Errorf("failed to get draft roadmap info: %w", err)
+		}
+
+		if draft != nil {
+			logger.WithField("draft_roadmap_info_id", draft.ID.String()).Info("returning open draft")
+			author := uc.fetchSingleUserData(ctx, draft.AuthorID)
+			return &dto.CreatePrivateRoadmapInfoResponseDTO{RoadmapInfo: dto.RoadmapInfoToDTO(draft, author)}, nil
+		}
+	}
+
+	draftRoadmapInfo, err := uc.runSaga(ctx, &entities.RoadmapSaga{
+		Kind:                entities.RoadmapSagaDraft,
+		UserID:              userID,
+		IdempotencyKey:      idempotencyKey,
+		SourceRoadmapInfoID: roadmapInfo.ID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to create draft")
+		return nil, err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"draft_roadmap_info_id": draftRoadmapInfo.ID,
+		"draft_roadmap_id":      draftRoadmapInfo.RoadmapID,
+	}).Info("successfully created draft")
+
+	return &dto.CreatePrivateRoadmapInfoResponseDTO{RoadmapInfo: *draftRoadmapInfo}, nil
+}
+
+func (uc *RoadmapInfoUsecase) SubmitRelease(ctx context.Context, draftRoadmapInfoID uuid.UUID, userID uuid.UUID, req *dto.SubmitReleaseRequestDTO) (*dto.RoadmapReleaseResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.SubmitRelease"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":                    op,
+		"draft_roadmap_info_id": draftRoadmapInfoID.String(),
+		"user_id":               userID.String(),
+	})
+
+	release, err := uc.repo.GetReleaseByDraftRoadmapInfoID(ctx, draftRoadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get release")
+		return nil, fmt.Errorf("failed to get release: %w", err)
+	}
+
+	if release == nil {
+		logger.Warn("release not found for draft")
+		return nil, errs.ErrNotFound
+	}
+
+	if release.AuthorID != userID {
+		logger.Warn("user is not author of the release")
+		return nil, errs.ErrForbidden
+	}
+
+	if release.Status == entities.RoadmapReleaseInReview {
+		logger.Warn("attempt to submit release that is already in review")
+		return nil, fmt.Errorf("attempt to submit a release that is already in review")
+	}
+
+	draft, err := uc.repo.GetByID(ctx, draftRoadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get draft roadmap info")
+		return nil, fmt.Errorf("failed to get draft roadmap info: %w", err)
+	}
+
+	if draft == nil {
+		logger.Warn("draft roadmap info not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if err := uc.moderateRoadmapInfo(ctx, draft); err != nil {
+		logger.WithError(err).Warn("draft rejected due to moderation")
+		return nil, fmt.Errorf("moderation check failed: %w", err)
+	}
+
+	release.Status = entities.RoadmapReleaseInReview
+	release.Notes = strings.TrimSpace(req.Notes)
+	release.ReviewerID = nil
+	release.ReviewComment = ""
+	release.ReviewedAt = nil
+
+	if err := uc.repo.UpdateRelease(ctx, release); err != nil {
+		logger.WithError(err).Error("failed to submit release")
+		return nil, fmt.Errorf("failed to submit release: %w", err)
+	}
+
+	logger.WithField("release_id", release.ID.String()).Info("successfully submitted release for review")
+
+	author := uc.fetchSingleUserData(ctx, release.AuthorID)
+	return &dto.RoadmapReleaseResponseDTO{Release: dto.RoadmapReleaseToDTO(release, author)}, nil
+}
+
+func (uc *RoadmapInfoUsecase) GetPendingReleases(ctx context.Context) (*dto.GetReleasesResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.GetPendingReleases"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	releases, err := uc.repo.GetReleasesByStatus(ctx, entities.RoadmapReleaseInReview)
+	if err != nil {
+		logger.WithError(err).Error("failed to get pending releases")
+		return nil, fmt.Errorf("failed to get pending releases: %w", err)
+	}
+
+	logger.WithField("count", len(releases)).Info("successfully retrieved pending releases")
+	return &dto.GetReleasesResponseDTO{Releases: uc.releasesToDTO(ctx, releases)}, nil
+}
+
+func (uc *RoadmapInfoUsecase) ReviewRelease(ctx context.Context, releaseID uuid.UUID, reviewerID uuid.UUID, req *dto.ReviewReleaseRequestDTO) (*dto.RoadmapReleaseResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.ReviewRelease"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":          op,
+		"release_id":  releaseID.String(),
+		"reviewer_id": reviewerID.String(),
+		"approved":    req.Approved,
+	})
+
+	release, err := uc.repo.GetReleaseByID(ctx, releaseID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get release")
+		return nil, fmt.Errorf("failed to get release: %w", err)
+	}
+
+	if release == nil {
+		logger.Warn("release not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if release.Status != entities.RoadmapReleaseInReview {
+		logger.WithField("status", release.Status).Warn("attempt to review release that is not in review")
+		return nil, fmt.Errorf("attempt to review a release that is not in review")
+	}
+
+	if release.AuthorID == reviewerID {
+		logger.Warn("attempt to review own release")
+		return nil, fmt.Errorf("attempt to review own release")
+	}
+
+	comment := strings.TrimSpace(req.Comment)
+	if !req.Approved && comment == "" {
+		logger.Warn("rejection without comment")
+		return nil, fmt.Errorf("validation failed: comment is required when rejecting a release")
+	}
+
+	now := time.Now()
+	release.Status = entities.RoadmapReleaseRejected
+	if req.Approved {
+		release.Status = entities.RoadmapReleaseApproved
+	}
+	release.ReviewerID = &reviewerID
+	release.ReviewComment = comment
+	release.ReviewedAt = &now
+
+	if err := uc.repo.UpdateRelease(ctx, release); err != nil {
+		logger.WithError(err).Error("failed to review release")
+		return nil, fmt.Errorf("failed to review release: %w", err)
+	}
+
+	logger.Info("successfully reviewed release")
+
+	author := uc.fetchSingleUserData(ctx, release.AuthorID)
+	return &dto.RoadmapReleaseResponseDTO{Release: dto.RoadmapReleaseToDTO(release, author)}, nil
+}
+
+// Release replaces the published roadmap with its approved draft. The
+// published roadmap info keeps its ID, so subscribers and their progress stay
+// with it. A draft changed after its review has to be reviewed again. The
+// release runs as a saga, so a release interrupted halfway is finished by the
+// saga worker.
+func (uc *RoadmapInfoUsecase) Release(ctx context.Context, releaseID uuid.UUID, userID uuid.UUID) (*dto.GetByIDRoadmapInfoResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.Release"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":         op,
+		"release_id": releaseID.String(),
+		"user_id":    userID.String(),
+	})
+
+	release, err := uc.repo.GetReleaseByID(ctx, releaseID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get release")
+		return nil, fmt.Errorf("failed to get release: %w", err)
+	}
+
+	if release == nil {
+		logger.Warn("release not found")
+		return nil, errs.ErrNotFound
+	}
+
+	if release.AuthorID != userID {
+		logger.Warn("user is not author of the release")
+		return nil, errs.ErrForbidden
+	}
+
+	if release.Status != entities.RoadmapReleaseApproved || release.DraftRoadmapInfoID == nil || release.ReviewedAt == nil {
+		logger.WithField("status", release.Status).Warn("attempt to release draft that is not approved")
+		return nil, fmt.Errorf("attempt to release a draft that is not approved")
+	}
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, release.RoadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	draft, err := uc.repo.GetByID(ctx, *release.DraftRoadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get draft roadmap info")
+		return nil, fmt.Errorf("failed to get draft roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || draft == nil {
+		logger.Warn("roadmap info or draft not found")
+		return nil, errs.ErrNotFound
+	}
+
+	draftRoadmap, err := uc.roadmapClient.GetByIDWithMaterials(ctx, &roadmapclient.GetByIDWithMaterialsRequest{Id: draft.RoadmapID})
+	if err != nil {
+		logger.WithError(err).Error("failed to get draft roadmap")
+		return nil, fmt.Errorf("failed to get draft roadmap: %w", err)
+	}
+
+	if draftRoadmap.Roadmap == nil {
+		logger.WithField("error", draftRoadmap.Error).Error("failed to get draft roadmap")
+		return nil, fmt.Errorf("failed to get draft roadmap: %s", draftRoadmap.Error)
+	}
+
+	if draft.UpdatedAt.After(*release.ReviewedAt) || draftRoadmap.Roadmap.UpdatedAt.AsTime().After(*release.ReviewedAt) {
+		logger.Warn("attempt to release draft changed after review")
+		return nil, fmt.Errorf("attempt to release a draft changed after review: submit it again")
+	}
+
+	releasedRoadmapInfo, err := uc.runSaga(ctx, &entities.RoadmapSaga{
+		Kind:                entities.RoadmapSagaRelease,
+		UserID:              userID,
+		SourceRoadmapInfoID: draft.ID,
+		RoadmapID:           roadmapInfo.RoadmapID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to release draft")
+		return nil, err
+	}
+
+	logger.WithField("roadmap_info_id", releasedRoadmapInfo.ID).Info("successfully released draft")
+	return &dto.GetByIDRoadmapInfoResponseDTO{RoadmapInfo: *releasedRoadmapInfo}, nil
+}
+
+func (uc *RoadmapInfoUsecase) GetReleases(ctx context.Context, roadmapInfoID uuid.UUID) (*dto.GetReleasesResponseDTO, error) {
+	const op = "RoadmapInfoUsecase.GetReleases"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+	})
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil || !roadmapInfo.IsPublic {
+		logger.Warn("public roadmap info not found")
+		return nil, errs.ErrNotFound
+	}
+
+	releases, err := uc.repo.GetReleasedByRoadmapInfoID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get releases")
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+
+	logger.WithField("count", len(releases)).Info("successfully retrieved releases")
+	return &dto.GetReleasesResponseDTO{Releases: uc.releasesToDTO(ctx, releases)}, nil
+}
+
+func (uc *RoadmapInfoUsecase) releasesToDTO(ctx context.Context, releases []*entities.RoadmapRelease) []dto.RoadmapReleaseDTO {
+	authorIDs := make([]uuid.UUID, 0, len(releases))
+	for _, release := range releases {
+		authorIDs = append(authorIDs, release.AuthorID)
+	}
+
+	return dto.RoadmapReleaseListToDTO(releases, uc.fetchUserData(ctx, authorIDs))
+}
+
+// Unpublish hides a published roadmap from listings, search and new forks
+// and subscriptions. Existing subscribers keep it, and its node chats stay.
+func (uc *RoadmapInfoUsecase) Unpublish(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) error {
+	return uc.setUnpublished(ctx, "RoadmapInfoUsecase.Unpublish", roadmapInfoID, userID, true)
+}
+
+func (uc *RoadmapInfoUsecase) Restore(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) error {
+	return uc.setUnpublished(ctx, "RoadmapInfoUsecase.Restore", roadmapInfoID, userID, false)
+}
+
+func (uc *RoadmapInfoUsecase) setUnpublished(ctx context.Context, op string, roadmapInfoID uuid.UUID, userID uuid.UUID, unpublished bool) error {
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":              op,
+		"roadmap_info_id": roadmapInfoID.String(),
+		"user_id":         userID.String(),
+	})
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, roadmapInfoID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get roadmap info")
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		logger.Warn("roadmap info not found")
+		return errs.ErrNotFound
+	}
+
+	role, err := uc.userRole(ctx, roadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !role.CanManage() {
+		logger.WithFields(map[string]interface{}{
+			"request_user_id": userID.String(),
+			"author_id":       roadmapInfo.AuthorID.String(),
+			"role":            role,
+		}).Warn("user cannot manage the roadmap info")
+		return errs.ErrForbidden
+	}
+
+	if !roadmapInfo.IsPublic {
+		logger.Warn("attempt to change visibility of private roadmap")
+		return fmt.Errorf("attempt to unpublish or restore private roadmap")
+	}
+
+	if unpublished == (roadmapInfo.UnpublishedAt != nil) {
+		if unpublished {
+			return fmt.Errorf("attempt to unpublish roadmap that is already unpublished")
+		}
+		return fmt.Errorf("attempt to restore roadmap that is not unpublished")
+	}
+
+	roadmapInfo.UnpublishedAt = nil
+	if unpublished {
+		now := time.Now()
+		roadmapInfo.UnpublishedAt = &now
+	}
+
+	if err := uc.repo.Update(ctx, roadmapInfo); err != nil {
+		logger.WithError(err).Error("failed to update roadmap info")
+		return fmt.Errorf("failed to update roadmap info: %w", err)
+	}
+
+	logger.WithField("unpublished", unpublished).Info("successfully changed roadmap visibility")
+	return nil
+}
@@ -0,0 +1,675 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/entities/errs"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
+	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+)
+
+// SagaUsecase runs forks, publishes, drafts and releases step by step and persists
+// the state after every step, so a saga interrupted at any point can be
+// resumed. Steps are idempotent: each one first checks whether a previous
+// attempt already did its work.
+type SagaUsecase struct {
+	cfg           config.SagaConfig
+	repo          roadmapinfo.Repository
+	roadmapClient roadmapclient.RoadmapServiceClient
+	chatClient    chatclient.ChatServiceClient
+}
+
+func NewSagaUsecase(
+	cfg *config.Config,
+	repo roadmapinfo.Repository,
+	roadmapClient roadmapclient.RoadmapServiceClient,
+	chatClient chatclient.ChatServiceClient,
+) roadmapinfo.SagaUsecase {
+	return &SagaUsecase{
+		cfg:           cfg.Workers.Saga,
+		repo:          repo,
+		roadmapClient: roadmapClient,
+		chatClient:    chatClient,
+	}
+}
+
+// Start stores the saga and runs it. If the user already started a saga of
+// the same kind with the same idempotency key, that saga is returned as it is
+// and nothing runs.
+func (uc *SagaUsecase) Start(ctx context.Context, saga *entities.RoadmapSaga) (*entities.RoadmapSaga, error) {
+	const op = "SagaUsecase.Start"
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"op":                     op,
+		"kind":                   saga.Kind,
+		"user_id":                saga.UserID.String(),
+		"source_roadmap_info_id": saga.SourceRoadmapInfoID.String(),
+	})
+
+	// A release works on the published roadmap, which its caller sets.
+	if saga.Kind != entities.RoadmapSagaRelease {
+		saga.RoadmapID = primitive.NewObjectID().Hex()
+	}
+	saga.Status = entities.RoadmapSagaRunning
+	saga.Step = firstSagaStep(saga)
+
+	createdSaga, err := uc.repo.CreateSaga(ctx, saga)
+	if err != nil {
+		logger.WithError(err).Error("failed to create saga")
+		return nil, fmt.Errorf("failed to create saga: %w", err)
+	}
+
+	if createdSaga == nil {
+		existing, err := uc.repo.GetSagaByIdempotencyKey(ctx, saga.UserID, saga.Kind, saga.IdempotencyKey)
+		if err != nil {
+			logger.WithError(err).Error("failed to get saga by idempotency key")
+			return nil, fmt.Errorf("failed to get saga: %w", err)
+		}
+
+		if existing == nil {
+			logger.Error("saga with idempotency key disappeared")
+			return nil, fmt.Errorf("failed to get saga")
+		}
+
+		if existing.SourceRoadmapInfoID != saga.SourceRoadmapInfoID {
+			logger.Warn("idempotency key reused for another roadmap")
+			return nil, fmt.Errorf("invalid idempotency key: already used for another roadmap")
+		}
+
+		logger.WithField("saga_id", existing.ID.String()).Info("returning saga with the same idempotency key")
+		return existing, nil
+	}
+
+	// The saga must not be cut short when the client goes away, otherwise it
+	// would be rolled back for no reason.
+	uc.run(context.WithoutCancel(ctx), createdSaga)
+
+	return createdSaga, nil
+}
+
+func (uc *SagaUsecase) ResumeStalled(ctx context.Context) error {
+	const op = "SagaUsecase.ResumeStalled"
+	logger := ctxutil.GetLogger(ctx).WithField("op", op)
+
+	sagas, err := uc.repo.ClaimStalledSagas(ctx, time.Now().Add(-uc.cfg.StalledAfter), uc.cfg.BatchSize)
+	if err != nil {
+		logger.WithError(err).Error("failed to claim stalled sagas")
+		return fmt.Errorf("failed to claim stalled sagas: %w", err)
+	}
+
+	finished := 0
+	for _, saga := range sagas {
+		if ctx.Err() != nil {
+			break
+		}
+
+		uc.run(ctx, saga)
+		if saga.Status == entities.RoadmapSagaCompleted || saga.Status == entities.RoadmapSagaCompensated {
+			finished++
+		}
+	}
+
+	if len(sagas) > 0 {
+		logger.WithFields(map[string]interface{}{
+			"claimed":  len(sagas),
+			"finished": finished,
+		}).Info("resumed stalled sagas")
+	}
+
+	return nil
+}
+
+// run drives the saga until it finishes or a step runs out of attempts. A
+// saga that fails before its roadmap info exists is compensated; after that
+// it is left running and the worker keeps retrying it.
+func (uc *SagaUsecase) run(ctx context.Context, saga *entities.RoadmapSaga) {
+	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"saga_id": saga.ID.String(),
+		"kind":    saga.Kind,
+	})
+
+	for {
+		var err error
+		switch saga.Status {
+		case entities.RoadmapSagaRunning:
+			if saga.Step == entities.RoadmapSagaStepDone {
+				saga.Status = entities.RoadmapSagaCompleted
+				if uc.save(ctx, saga) {
+					logger.Info("saga completed")
+				}
+				return
+			}
+			err = uc.runStep(ctx, saga)
+		case entities.RoadmapSagaCompensating:
+			err = uc.compensate(ctx, saga)
+		default:
+			return
+		}
+
+		if err == nil {
+			saga.Attempts = 0
+			if saga.Status == entities.RoadmapSagaRunning {
+				saga.LastError = ""
+			}
+			if !uc.save(ctx, saga) {
+				return
+			}
+			if saga.Status == entities.RoadmapSagaCompensated {
+				logger.WithField("error", saga.LastError).Warn("saga rolled back")
+			}
+			continue
+		}
+
+		saga.Attempts++
+		saga.LastError = err.Error()
+		logger.WithError(err).WithFields(map[string]interface{}{
+			"step":     saga.Step,
+			"attempts": saga.Attempts,
+		}).Warn("saga step failed")
+
+		if saga.Attempts >= uc.cfg.MaxAttempts || errs.IsNotFoundError(err) {
+			if saga.Status == entities.RoadmapSagaRunning && saga.RoadmapInfoID == nil {
+				saga.Status = entities.RoadmapSagaCompensating
+				saga.Step = entities.RoadmapSagaStepDeleteRoadmap
+				saga.Attempts = 0
+				if !uc.save(ctx, saga) {
+					return
+				}
+				continue
+			}
+
+			uc.save(ctx, saga)
+			return
+		}
+
+		if !uc.save(ctx, saga) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(uc.cfg.RetryDelay * time.Duration(saga.Attempts)):
+		}
+	}
+}
+
+func (uc *SagaUsecase) save(ctx context.Context, saga *entities.RoadmapSaga) bool {
+	if err := uc.repo.UpdateSaga(ctx, saga); err != nil {
+		ctxutil.GetLogger(ctx).WithError(err).WithField("saga_id", saga.ID.String()).Error("failed to save saga, leaving it to the worker")
+		return false
+	}
+	return true
+}
+
+func (uc *SagaUsecase) runStep(ctx context.Context, saga *entities.RoadmapSaga) error {
+	var err error
+	switch saga.Step {
+	case entities.RoadmapSagaStepCreateRoadmap:
+		err = uc.createRoadmap(ctx, saga)
+	case entities.RoadmapSagaStepCreateRoadmapInfo:
+		err = uc.createRoadmapInfo(ctx, saga)
+	case entities.RoadmapSagaStepCreateNodeChats:
+		err = uc.createNodeChats(ctx, saga)
+	case entities.RoadmapSagaStepMigrateProgress:
+		err = uc.migrateProgress(ctx, saga)
+	case entities.RoadmapSagaStepCreateRelease:
+		err = uc.createRelease(ctx, saga)
+	case entities.RoadmapSagaStepApplyDraft:
+		err = uc.applyDraft(ctx, saga)
+	case entities.RoadmapSagaStepUpdateRoadmapInfo:
+		err = uc.updateRoadmapInfo(ctx, saga)
+	case entities.RoadmapSagaStepMarkReleased:
+		err = uc.markReleased(ctx, saga)
+	case entities.RoadmapSagaStepDeleteDraft:
+		err = uc.deleteDraft(ctx, saga)
+	default:
+		return fmt.Errorf("unknown saga step %q", saga.Step)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	saga.Step = nextSagaStep(saga)
+	return nil
+}
+
+func firstSagaStep(saga *entities.RoadmapSaga) entities.RoadmapSagaStep {
+	if saga.Kind == entities.RoadmapSagaRelease {
+		return entities.RoadmapSagaStepApplyDraft
+	}
+	return entities.RoadmapSagaStepCreateRoadmap
+}
+
+func nextSagaStep(saga *entities.RoadmapSaga) entities.RoadmapSagaStep {
+	if saga.Kind == entities.RoadmapSagaRelease {
+		return nextReleaseStep(saga)
+	}
+
+	switch saga.Step {
+	case entities.RoadmapSagaStepCreateRoadmap:
+		return entities.RoadmapSagaStepCreateRoadmapInfo
+	case entities.RoadmapSagaStepCreateRoadmapInfo:
+		if saga.Kind == entities.RoadmapSagaPublish {
+			return entities.RoadmapSagaStepCreateNodeChats
+		}
+		if saga.Kind == entities.RoadmapSagaDraft {
+			return entities.RoadmapSagaStepCreateRelease
+		}
+		fallthrough
+	case entities.RoadmapSagaStepCreateNodeChats:
+		if saga.CarryProgress {
+			return entities.RoadmapSagaStepMigrateProgress
+		}
+	}
+	return entities.RoadmapSagaStepDone
+}
+
+func nextReleaseStep(saga *entities.RoadmapSaga) entities.RoadmapSagaStep {
+	switch saga.Step {
+	case entities.RoadmapSagaStepApplyDraft:
+		return entities.RoadmapSagaStepUpdateRoadmapInfo
+	case entities.RoadmapSagaStepUpdateRoadmapInfo:
+		return entities.RoadmapSagaStepCreateNodeChats
+	case entities.RoadmapSagaStepCreateNodeChats:
+		return entities.RoadmapSagaStepMarkReleased
+	case entities.RoadmapSagaStepMarkReleased:
+		return entities.RoadmapSagaStepDeleteDraft
+	}
+	return entities.RoadmapSagaStepDone
+}
+
+// createRoadmap copies the source roadmap under the saga's roadmap ID. The
+// roadmap service returns the existing roadmap when a previous attempt
+// already created it.
+func (uc *SagaUsecase) createRoadmap(ctx context.Context, saga *entities.RoadmapSaga) error {
+	sourceRoadmapInfo, err := uc.repo.GetByID(ctx, saga.SourceRoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get source roadmap info: %w", err)
+	}
+
+	if sourceRoadmapInfo == nil {
+		return fmt.Errorf("source roadmap info not found")
+	}
+
+	sourceRoadmap, err := uc.roadmapClient.GetByIDWithMaterials(ctx, &roadmapclient.GetByIDWithMaterialsRequest{
+		Id: sourceRoadmapInfo.RoadmapID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get source roadmap: %w", err)
+	}
+
+	if sourceRoadmap.Roadmap == nil {
+		return fmt.Errorf("failed to get source roadmap: %s", sourceRoadmap.Error)
+	}
+
+	lineageKind := entities.RoadmapLineageFork
+	switch saga.Kind {
+	case entities.RoadmapSagaPublish:
+		lineageKind = entities.RoadmapLineagePublish
+	case entities.RoadmapSagaDraft:
+		lineageKind = entities.RoadmapLineageDraft
+	}
+
+	regeneratedRoadmap, err := uc.roadmapClient.RegenerateNodeIDs(ctx, &roadmapclient.RegenerateNodeIDsRequest{
+		Roadmap:         sourceRoadmap.Roadmap,
+		TargetRoadmapId: saga.RoadmapID,
+		LineageKind:     string(lineageKind),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to regenerate node IDs: %w", err)
+	}
+
+	if regeneratedRoadmap.Roadmap == nil {
+		return fmt.Errorf("failed to regenerate node IDs: %s", regeneratedRoadmap.Error)
+	}
+
+	createdRoadmap, err := uc.roadmapClient.Create(ctx, &roadmapclient.CreateRequest{
+		Id:       saga.RoadmapID,
+		IsPublic: saga.Kind == entities.RoadmapSagaPublish,
+		AuthorId: saga.UserID.String(),
+		Nodes:    regeneratedRoadmap.Roadmap.Nodes,
+		Edges:    regeneratedRoadmap.Roadmap.Edges,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create roadmap: %w", err)
+	}
+
+	if createdRoadmap.Roadmap == nil {
+		return fmt.Errorf("failed to create roadmap: %s", createdRoadmap.Error)
+	}
+
+	return nil
+}
+
+// createRoadmapInfo is the point of no return: once the roadmap info exists
+// the copy is visible to the user and the saga is no longer rolled back.
+func (uc *SagaUsecase) createRoadmapInfo(ctx context.Context, saga *entities.RoadmapSaga) error {
+	existing, err := uc.repo.GetByRoadmapID(ctx, saga.RoadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if existing != nil {
+		saga.RoadmapInfoID = &existing.ID
+		return nil
+	}
+
+	sourceRoadmapInfo, err := uc.repo.GetByID(ctx, saga.SourceRoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get source roadmap info: %w", err)
+	}
+
+	if sourceRoadmapInfo == nil {
+		return fmt.Errorf("source roadmap info not found")
+	}
+
+	roadmapInfo := &entities.RoadmapInfo{
+		RoadmapID:               saga.RoadmapID,
+		Name:                    sourceRoadmapInfo.Name,
+		Description:             sourceRoadmapInfo.Description,
+		CategoryID:              sourceRoadmapInfo.CategoryID,
+		AuthorID:                saga.UserID,
+		IsPublic:                false,
+		ReferencedRoadmapInfoID: &sourceRoadmapInfo.ID,
+	}
+
+	if saga.Kind == entities.RoadmapSagaPublish {
+		roadmapInfo.IsPublic = true
+		roadmapInfo.ReferencedRoadmapInfoID = sourceRoadmapInfo.ReferencedRoadmapInfoID
+	}
+
+	createdRoadmapInfo, err := uc.repo.Create(ctx, roadmapInfo)
+	if err != nil {
+		return fmt.Errorf("failed to create roadmap info: %w", err)
+	}
+
+	saga.RoadmapInfoID = &createdRoadmapInfo.ID
+	return nil
+}
+
+// createNodeChats creates the discussion chats of a published roadmap's
+// nodes, skipping nodes whose chat a previous attempt or an earlier release
+// already created.
+func (uc *SagaUsecase) createNodeChats(ctx context.Context, saga *entities.RoadmapSaga) error {
+	roadmap, err := uc.roadmapClient.GetByIDWithMaterials(ctx, &roadmapclient.GetByIDWithMaterialsRequest{
+		Id: saga.RoadmapID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap: %w", err)
+	}
+
+	if roadmap.Roadmap == nil {
+		return fmt.Errorf("failed to get roadmap: %s", roadmap.Error)
+	}
+
+	for _, node := range roadmap.Roadmap.Nodes {
+		chat, err := uc.chatClient.GetGroupChatByNode(ctx, &chatclient.GetGroupChatByNodeRequest{
+			NodeId: node.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get chat for node %s: %w", node.Id, err)
+		}
+
+		if chat.GroupChat != nil && chat.GroupChat.Id != "" {
+			continue
+		}
+
+		if !errs.IsNotFoundError(errors.New(chat.Error)) {
+			return fmt.Errorf("failed to get chat for node %s: %s", node.Id, chat.Error)
+		}
+
+		createdChat, err := uc.chatClient.CreateGroupChat(ctx, &chatclient.CreateGroupChatRequest{
+			UserId:        saga.UserID.String(),
+			Title:         fmt.Sprintf("Discussion: %s", node.GetData().GetLabel()),
+			RoadmapNodeId: node.Id,
+			MemberIds:     []string{},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create chat for node %s: %w", node.Id, err)
+		}
+
+		if createdChat.Error != "" {
+			return fmt.Errorf("failed to create chat for node %s: %s", node.Id, createdChat.Error)
+		}
+	}
+
+	return nil
+}
+
+// migrateProgress carries the user's progress over to the copy. Having no
+// lineage to migrate along is not an error; there is just nothing to carry.
+func (uc *SagaUsecase) migrateProgress(ctx context.Context, saga *entities.RoadmapSaga) error {
+	resp, err := uc.roadmapClient.MigrateProgress(ctx, &roadmapclient.MigrateProgressRequest{
+		UserId:    saga.UserID.String(),
+		RoadmapId: saga.RoadmapID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate progress: %w", err)
+	}
+
+	if resp.Error != "" {
+		if errs.IsNotFoundError(errors.New(resp.Error)) {
+			ctxutil.GetLogger(ctx).WithField("saga_id", saga.ID.String()).Warn("no progress to migrate")
+			return nil
+		}
+		return fmt.Errorf("failed to migrate progress: %s", resp.Error)
+	}
+
+	ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"saga_id":        saga.ID.String(),
+		"migrated_nodes": resp.MigratedNodes,
+	}).Info("carried progress over")
+	return nil
+}
+
+// createRelease opens the release that tracks a draft through review.
+func (uc *SagaUsecase) createRelease(ctx context.Context, saga *entities.RoadmapSaga) error {
+	existing, err := uc.repo.GetReleaseByDraftRoadmapInfoID(ctx, *saga.RoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get release: %w", err)
+	}
+
+	if existing != nil {
+		return nil
+	}
+
+	_, err = uc.repo.CreateRelease(ctx, &entities.RoadmapRelease{
+		RoadmapInfoID:      saga.SourceRoadmapInfoID,
+		DraftRoadmapInfoID: saga.RoadmapInfoID,
+		AuthorID:           saga.UserID,
+		Status:             entities.RoadmapReleaseDraft,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create release: %w", err)
+	}
+
+	return nil
+}
+
+// applyDraft is the point of no return of a release: it replaces the
+// published roadmap with the draft. Applying the same draft again gives the
+// same roadmap, so the step can be retried.
+func (uc *SagaUsecase) applyDraft(ctx context.Context, saga *entities.RoadmapSaga) error {
+	draft, err := uc.repo.GetByID(ctx, saga.SourceRoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft roadmap info: %w", err)
+	}
+
+	if draft == nil {
+		return fmt.Errorf("draft roadmap info not found")
+	}
+
+	roadmapInfo, err := uc.repo.GetByRoadmapID(ctx, saga.RoadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		return fmt.Errorf("roadmap info not found")
+	}
+
+	applied, err := uc.roadmapClient.ApplyDraft(ctx, &roadmapclient.ApplyDraftRequest{
+		DraftRoadmapId: draft.RoadmapID,
+		RoadmapId:      saga.RoadmapID,
+		UserId:         saga.UserID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply draft: %w", err)
+	}
+
+	if applied.Error != "" {
+		return fmt.Errorf("failed to apply draft: %s", applied.Error)
+	}
+
+	ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"saga_id":       saga.ID.String(),
+		"added_nodes":   applied.AddedNodes,
+		"removed_nodes": applied.RemovedNodes,
+	}).Info("applied draft")
+
+	saga.RoadmapInfoID = &roadmapInfo.ID
+	return nil
+}
+
+// updateRoadmapInfo copies the name, description and category of the draft
+// to the published roadmap info, which keeps its ID.
+func (uc *SagaUsecase) updateRoadmapInfo(ctx context.Context, saga *entities.RoadmapSaga) error {
+	draft, err := uc.repo.GetByID(ctx, saga.SourceRoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft roadmap info: %w", err)
+	}
+
+	if draft == nil {
+		return fmt.Errorf("draft roadmap info not found")
+	}
+
+	roadmapInfo, err := uc.repo.GetByID(ctx, *saga.RoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if roadmapInfo == nil {
+		return fmt.Errorf("roadmap info not found")
+	}
+
+	roadmapInfo.Name = draft.Name
+	roadmapInfo.Description = draft.Description
+	roadmapInfo.CategoryID = draft.CategoryID
+
+	if err := uc.repo.Update(ctx, roadmapInfo); err != nil {
+		return fmt.Errorf("failed to update roadmap info: %w", err)
+	}
+
+	return nil
+}
+
+// markReleased gives the release the next version number. A release that no
+// longer points at the draft has already been marked by a previous attempt.
+func (uc *SagaUsecase) markReleased(ctx context.Context, saga *entities.RoadmapSaga) error {
+	release, err := uc.repo.GetReleaseByDraftRoadmapInfoID(ctx, saga.SourceRoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get release: %w", err)
+	}
+
+	if release == nil {
+		return nil
+	}
+
+	version, err := uc.repo.GetLatestReleaseVersion(ctx, release.RoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get latest release version: %w", err)
+	}
+
+	now := time.Now()
+	release.Version = version + 1
+	release.Status = entities.RoadmapReleaseReleased
+	release.DraftRoadmapInfoID = nil
+	release.ReleasedAt = &now
+
+	if err := uc.repo.UpdateRelease(ctx, release); err != nil {
+		return fmt.Errorf("failed to mark release as released: %w", err)
+	}
+
+	ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
+		"saga_id":    saga.ID.String(),
+		"release_id": release.ID.String(),
+		"version":    release.Version,
+	}).Info("marked release as released")
+	return nil
+}
+
+// deleteDraft removes the released draft, skipping whatever a previous
+// attempt already deleted.
+func (uc *SagaUsecase) deleteDraft(ctx context.Context, saga *entities.RoadmapSaga) error {
+	draft, err := uc.repo.GetByID(ctx, saga.SourceRoadmapInfoID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft roadmap info: %w", err)
+	}
+
+	if draft == nil {
+		return nil
+	}
+
+	resp, err := uc.roadmapClient.Delete(ctx, &roadmapclient.DeleteRequest{Id: draft.RoadmapID})
+	if err != nil {
+		return fmt.Errorf("failed to delete draft roadmap: %w", err)
+	}
+
+	if resp.Error != "" && !errs.IsNotFoundError(errors.New(resp.Error)) {
+		return fmt.Errorf("failed to delete draft roadmap: %s", resp.Error)
+	}
+
+	if err := uc.repo.Delete(ctx, draft.ID); err != nil {
+		return fmt.Errorf("failed to delete draft roadmap info: %w", err)
+	}
+
+	return nil
+}
+
+// compensate deletes the copied roadmap. If a previous attempt managed to
+// create the roadmap info after all, the saga goes forward instead. A release
+// has changed nothing before its draft is applied, so there is nothing to
+// undo.
+func (uc *SagaUsecase) compensate(ctx context.Context, saga *entities.RoadmapSaga) error {
+	if saga.Kind == entities.RoadmapSagaRelease {
+		saga.Status = entities.RoadmapSagaCompensated
+		saga.Step = entities.RoadmapSagaStepDone
+		return nil
+	}
+
+	existing, err := uc.repo.GetByRoadmapID(ctx, saga.RoadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap info: %w", err)
+	}
+
+	if existing != nil {
+		saga.RoadmapInfoID = &existing.ID
+		saga.Status = entities.RoadmapSagaRunning
+		saga.Step = entities.RoadmapSagaStepCreateRoadmapInfo
+		saga.Step = nextSagaStep(saga)
+		return nil
+	}
+
+	resp, err := uc.roadmapClient.Delete(ctx, &roadmapclient.DeleteRequest{Id: saga.RoadmapID})
+	if err != nil {
+		return fmt.Errorf("failed to delete roadmap: %w", err)
+	}
+
+	if resp.Error != "" && !errs.IsNotFoundError(errors.New(resp.Error)) {
+		return fmt.Errorf("failed to delete roadmap: %s", resp.Error)
+	}
+
+	saga.Status = entities.RoadmapSagaCompensated
+	saga.Step = entities.RoadmapSagaStepDone
+	return nil
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"github.com/F0urward/proftwist-backend/config"
+	"github.com/F0urward/proftwist-backend/internal/entities"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/chatclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
+	"github.com/F0urward/proftwist-backend/services/roadmapinfo"
+)
+
+type sagaRepoStub struct {
+	roadmapinfo.Repository
+
+	infos    map[uuid.UUID]*entities.RoadmapInfo
+	releases []*entities.RoadmapRelease
+}
+
+func (r *sagaRepoStub) CreateSaga(_ context.Context, saga *entities.RoadmapSaga) (*entities.RoadmapSaga, error) {
+	created := *saga
+	created.ID = uuid.New()
+	return &created, nil
+}
+
+func (r *sagaRepoStub) UpdateSaga(context.Context, *entities.RoadmapSaga) error {
+	return nil
+}
+
+func (r *sagaRepoStub) GetByID(_ context.Context, id uuid.UUID) (*entities.RoadmapInfo, error) {
+	info, ok := r.infos[id]
+	if !ok {
+		return nil, nil
+	}
+	stored := *info
+	return &stored, nil
+}
+
+func (r *sagaRepoStub) GetByRoadmapID(_ context.Context, roadmapID string) (*entities.RoadmapInfo, error) {
+	for _, info := range r.infos {
+		if info.RoadmapID == roadmapID {
+			stored := *info
+			return &stored, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *sagaRepoStub) Update(_ context.Context, info *entities.RoadmapInfo) error {
+	r.infos[info.ID] = info
+	return nil
+}
+
+func (r *sagaRepoStub) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.infos, id)
+	return nil
+}
+
+func (r *sagaRepoStub) GetReleaseByDraftRoadmapInfoID(_ context.Context, draftRoadmapInfoID uuid.UUID) (*entities.RoadmapRelease, error) {
+	for _, release := range r.releases {
+		if release.DraftRoadmapInfoID != nil && *release.DraftRoadmapInfoID == draftRoadmapInfoID {
+			stored := *release
+			return &stored, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *sagaRepoStub) GetLatestReleaseVersion(context.Context, uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (r *sagaRepoStub) UpdateRelease(_ context.Context, release *entities.RoadmapRelease) error {
+	for i := range r.releases {
+		if r.releases[i].ID == release.ID {
+			r.releases[i] = release
+		}
+	}
+	return nil
+}
+
+type sagaRoadmapClientStub struct {
+	roadmapclient.RoadmapServiceClient
+
+	applyError string
+	applied    []*roadmapclient.ApplyDraftRequest
+	deleted    []string
+}
+
+func (c *sagaRoadmapClientStub) ApplyDraft(_ context.Context, in *roadmapclient.ApplyDraftRequest, _ ...grpc.CallOption) (*roadmapclient.ApplyDraftResponse, error) {
+	if c.applyError != "" {
+		return &roadmapclient.ApplyDraftResponse{Error: c.applyError}, nil
+	}
+	c.applied = append(c.applied, in)
+	return &roadmapclient.ApplyDraftResponse{AddedNodes: 1}, nil
+}
+
+func (c *sagaRoadmapClientStub) GetByIDWithMaterials(_ context.Context, in *roadmapclient.GetByIDWithMaterialsRequest, _ ...grpc.CallOption) (*roadmapclient.GetByIDWithMaterialsResponse, error) {
+	return &roadmapclient.GetByIDWithMaterialsResponse{
+		Roadmap: &roadmapclient.RoadmapWithMaterials{
+			Id:    in.Id,
+			Nodes: []*roadmapclient.NodeWithMaterials{{Id: "added-node"}},
+		},
+	}, nil
+}
+
+func (c *sagaRoadmapClientStub) Delete(_ context.Context, in *roadmapclient.DeleteRequest, _ ...grpc.CallOption) (*roadmapclient.DeleteResponse, error) {
+	c.deleted = append(c.deleted, in.Id)
+	return &roadmapclient.DeleteResponse{}, nil
+}
+
+type sagaChatClientStub struct {
+	chatclient.ChatServiceClient
+
+	created []string
+}
+
+func (c *sagaChatClientStub) GetGroupChatByNode(context.Context, *chatclient.GetGroupChatByNodeRequest, ...grpc.CallOption) (*chatclient.GetGroupChatByNodeResponse, error) {
+	return &chatclient.GetGroupChatByNodeResponse{Error: "chat not found"}, nil
+}
+
+func (c *sagaChatClientStub) CreateGroupChat(_ context.Context, in *chatclient.CreateGroupChatRequest, _ ...grpc.CallOption) (*chatclient.CreateGroupChatResponse, error) {
+	c.created = append(c.created, in.RoadmapNodeId)
+	return &chatclient.CreateGroupChatResponse{}, nil
+}
+
+func newReleaseSaga() (*sagaRepoStub, *entities.RoadmapSaga) {
+	published := &entities.RoadmapInfo{ID: uuid.New(), RoadmapID: "published-roadmap", Name: "Go", IsPublic: true}
+	draft := &entities.RoadmapInfo{ID: uuid.New(), RoadmapID: "draft-roadmap", Name: "Go 2"}
+
+	repo := &sagaRepoStub{
+		infos: map[uuid.UUID]*entities.RoadmapInfo{published.ID: published, draft.ID: draft},
+		releases: []*entities.RoadmapRelease{{
+			ID:                 uuid.New(),
+			RoadmapInfoID:      published.ID,
+			DraftRoadmapInfoID: &draft.ID,
+			Status:             entities.RoadmapReleaseApproved,
+		}},
+	}
+
+	return repo, &entities.RoadmapSaga{
+		Kind:                entities.RoadmapSagaRelease,
+		UserID:              uuid.New(),
+		IdempotencyKey:      uuid.NewString(),
+		SourceRoadmapInfoID: draft.ID,
+		RoadmapID:           published.RoadmapID,
+	}
+}
+
+func newTestSagaUsecase(repo *sagaRepoStub, roadmapClient *sagaRoadmapClientStub, chatClient *sagaChatClientStub) *SagaUsecase {
+	cfg := &config.Config{}
+	cfg.Workers.Saga = config.SagaConfig{MaxAttempts: 1}
+	return NewSagaUsecase(cfg, repo, roadmapClient, chatClient).(*SagaUsecase)
+}
+
+func TestReleaseSagaReleasesDraft(t *testing.T) {
+	repo, saga := newReleaseSaga()
+	draftID := saga.SourceRoadmapInfoID
+	roadmapClient := &sagaRoadmapClientStub{}
+	chatClient := &sagaChatClientStub{}
+
+	result, err := newTestSagaUsecase(repo, roadmapClient, chatClient).Start(context.Background(), saga)
+	if err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if result.Status != entities.RoadmapSagaCompleted || result.RoadmapID != "published-roadmap" || result.RoadmapInfoID == nil {
+		t.Fatalf("got saga %+v, want a completed release of the published roadmap", result)
+	}
+	if len(roadmapClient.applied) != 1 || roadmapClient.applied[0].DraftRoadmapId != "draft-roadmap" || roadmapClient.applied[0].RoadmapId != "published-roadmap" {
+		t.Fatalf("got ApplyDraft calls %+v", roadmapClient.applied)
+	}
+	if published := repo.infos[*result.RoadmapInfoID]; published.Name != "Go 2" {
+		t.Fatalf("got published name %q, want the draft name", published.Name)
+	}
+	if len(chatClient.created) != 1 || chatClient.created[0] != "added-node" {
+		t.Fatalf("got chats created for %v, want the added node", chatClient.created)
+	}
+	if release := repo.releases[0]; release.Status != entities.RoadmapReleaseReleased || release.Version != 1 || release.DraftRoadmapInfoID != nil {
+		t.Fatalf("got release %+v, want it released as version 1", release)
+	}
+	if _, ok := repo.infos[draftID]; ok || len(roadmapClient.deleted) != 1 || roadmapClient.deleted[0] != "draft-roadmap" {
+		t.Fatalf("draft was not deleted, deleted roadmaps: %v", roadmapClient.deleted)
+	}
+}
+
+func TestReleaseSagaFailingToApplyDraftChangesNothing(t *testing.T) {
+	repo, saga := newReleaseSaga()
+	roadmapClient := &sagaRoadmapClientStub{applyError: "attempt to update roadmap that was modified concurrently"}
+
+	result, err := newTestSagaUsecase(repo, roadmapClient, &sagaChatClientStub{}).Start(context.Background(), saga)
+	if err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if result.Status != entities.RoadmapSagaCompensated || result.RoadmapInfoID != nil {
+		t.Fatalf("got saga %+v, want it given up", result)
+	}
+	if len(roadmapClient.deleted) != 0 {
+		t.Fatalf("compensation deleted roadmaps %v", roadmapClient.deleted)
+	}
+	if release := repo.releases[0]; release.Status != entities.RoadmapReleaseApproved || release.DraftRoadmapInfoID == nil {
+		t.Fatalf("got release %+v, want it still approved", release)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/F0urward/proftwist-backend/internal/entities"
 	"github.com/F0urward/proftwist-backend/internal/entities/errs"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/authclient"
+	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/friendclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/moderationclient"
 	"github.com/F0urward/proftwist-backend/internal/infrastructure/client/roadmapclient"
 	"github.com/F0urward/proftwist-backend/pkg/ctxutil"
@@ -18,11 +19,16 @@ import (
 	"github.com/F0urward/proftwist-backend/services/roadmapinfo/dto"
 )
 
+const maxIdempotencyKeyLength = 255
+
 type RoadmapInfoUsecase struct {
-	repo             roadmapinfo.Repository
-	roadmapClient    roadmapclient.RoadmapServiceClient
-	authClient       authclient.AuthServiceClient
-	moderationClient moderationclient.ModerationServiceClient
+	repo                  roadmapinfo.Repository
+	roadmapClient         roadmapclient.RoadmapServiceClient
+	authClient            authclient.AuthServiceClient
+	moderationClient      moderationclient.ModerationServiceClient
+	friendClient          friendclient.FriendServiceClient
+	sagaUsecase           roadmapinfo.SagaUsecase
+	notificationPublisher roadmapinfo.NotificationPublisher
 }
 
 func NewRoadmapInfoUsecase(
@@ -30,12 +36,18 @@ func NewRoadmapInfoUsecase(
 	roadmapClient roadmapclient.RoadmapServiceClient,
 	authClient authclient.AuthServiceClient,
 	moderationClient moderationclient.ModerationServiceClient,
+	friendClient friendclient.FriendServiceClient,
+	sagaUsecase roadmapinfo.SagaUsecase,
+	notificationPublisher roadmapinfo.NotificationPublisher,
 ) roadmapinfo.Usecase {
 	return &RoadmapInfoUsecase{
-		repo:             repo,
-		roadmapClient:    roadmapClient,
-		authClient:       authClient,
-		moderationClient: moderationClient,
+		repo:                  repo,
+		roadmapClient:         roadmapClient,
+		authClient:            authClient,
+		moderationClient:      moderationClient,
+		friendClient:          friendClient,
+		sagaUsecase:           sagaUsecase,
+		notificationPublisher: notificationPublisher,
 	}
 }
 
@@ -283,11 +295,18 @@ func (uc *RoadmapInfoUsecase) UpdatePrivate(ctx context.Context, roadmapInfoID u
 		return fmt.Errorf("attempt to update public roadmap")
 	}
 
-	if !uc.isUserOwner(existing, userID) {
+	role, err := uc.userRole(ctx, existing, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !role.CanEdit() {
 		logger.WithFields(map[string]interface{}{
 			"request_user_id": userID.String(),
 			"author_id":       existing.AuthorID.String(),
-		}).Warn("user is not author of the roadmap info")
+			"role":            role,
+		}).Warn("user cannot edit the roadmap info")
 		return errs.ErrForbidden
 	}
 
@@ -337,11 +356,18 @@ func (uc *RoadmapInfoUsecase) Delete(ctx context.Context, roadmapInfoID uuid.UUI
 		return errs.ErrNotFound
 	}
 
-	if !uc.isUserOwner(roadmapInfo, userID) {
+	role, err := uc.userRole(ctx, roadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !role.CanManage() {
 		logger.WithFields(map[string]interface{}{
 			"request_user_id": userID.String(),
 			"author_id":       roadmapInfo.AuthorID.String(),
-		}).Warn("user is not author of the roadmap info")
+			"role":            role,
+		}).Warn("user cannot manage the roadmap info")
 		return errs.ErrForbidden
 	}
 
@@ -366,14 +392,7 @@ func (uc *RoadmapInfoUsecase) Delete(ctx context.Context, roadmapInfoID uuid.UUI
 	return nil
 }
 
-func (uc *RoadmapInfoUsecase) isUserOwner(roadmapInfo *entities.RoadmapInfo, userID uuid.UUID) bool {
-	if roadmapInfo == nil {
-		return false
-	}
-	return roadmapInfo.AuthorID == userID
-}
-
-func (uc *RoadmapInfoUsecase) Fork(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) (*dto.CreatePrivateRoadmapInfoResponseDTO, error) {
+func (uc *RoadmapInfoUsecase) Fork(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID, carryProgress bool, idempotencyKey string) (*dto.CreatePrivateRoadmapInfoResponseDTO, error) {
 	const op = "RoadmapInfoUsecase.Fork"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
 		"op":              op,
@@ -397,85 +416,32 @@ func (uc *RoadmapInfoUsecase) Fork(ctx context.Context, roadmapInfoID uuid.UUID,
 		return nil, errs.ErrForbidden
 	}
 
-	originalRoadmap, err := uc.roadmapClient.GetByIDWithMaterials(ctx, &roadmapclient.GetByIDWithMaterialsRequest{Id: originalRoadmapInfo.RoadmapID})
-	if err != nil {
-		logger.WithError(err).Error("failed to get original roadmap")
-		return nil, fmt.Errorf("failed to get original roadmap: %w", err)
-	}
-
-	if originalRoadmap == nil || originalRoadmap.Roadmap == nil {
-		logger.Error("original roadmap is nil")
-		return nil, fmt.Errorf("failed to get original roadmap")
+	if originalRoadmapInfo.UnpublishedAt != nil {
+		logger.Warn("attempt to fork unpublished roadmap")
+		return nil, fmt.Errorf("attempt to fork unpublished roadmap")
 	}
 
-	regeneratedRoadmap, err := uc.roadmapClient.RegenerateNodeIDs(ctx, &roadmapclient.RegenerateNodeIDsRequest{
-		Roadmap: originalRoadmap.Roadmap,
+	forkedRoadmapInfo, err := uc.runSaga(ctx, &entities.RoadmapSaga{
+		Kind:                entities.RoadmapSagaFork,
+		UserID:              userID,
+		IdempotencyKey:      idempotencyKey,
+		SourceRoadmapInfoID: originalRoadmapInfo.ID,
+		CarryProgress:       carryProgress,
 	})
 	if err != nil {
-		logger.WithError(err).Error("failed to regenerate node IDs for forked roadmap")
-		return nil, fmt.Errorf("failed to regenerate node IDs: %w", err)
-	}
-
-	if regeneratedRoadmap == nil || regeneratedRoadmap.Roadmap == nil {
-		logger.Error("regenerated roadmap is nil")
-		return nil, fmt.Errorf("failed to regenerate node IDs")
-	}
-
-	forkRoadmapRequest := &roadmapclient.CreateRequest{
-		Id:       primitive.NewObjectID().Hex(),
-		IsPublic: false,
-		AuthorId: userID.String(),
-		Nodes:    regeneratedRoadmap.Roadmap.Nodes,
-		Edges:    regeneratedRoadmap.Roadmap.Edges,
-	}
-
-	forkedRoadmap, err := uc.roadmapClient.Create(ctx, forkRoadmapRequest)
-	if err != nil {
-		logger.WithError(err).Error("failed to create forked roadmap")
-		return nil, fmt.Errorf("failed to create forked roadmap: %w", err)
-	}
-
-	if forkedRoadmap == nil || forkedRoadmap.Roadmap == nil {
-		logger.Error("forked roadmap is nil")
-		return nil, fmt.Errorf("failed to create forked roadmap")
-	}
-
-	forkedRoadmapInfo := &entities.RoadmapInfo{
-		ID:                      uuid.New(),
-		RoadmapID:               forkedRoadmap.Roadmap.Id,
-		Name:                    originalRoadmapInfo.Name,
-		Description:             originalRoadmapInfo.Description,
-		CategoryID:              originalRoadmapInfo.CategoryID,
-		AuthorID:                userID,
-		IsPublic:                false,
-		ReferencedRoadmapInfoID: &originalRoadmapInfo.ID,
-		CreatedAt:               originalRoadmapInfo.CreatedAt,
-		UpdatedAt:               originalRoadmapInfo.UpdatedAt,
-	}
-
-	createdRoadmapInfo, err := uc.repo.Create(ctx, forkedRoadmapInfo)
-	if err != nil {
-		if _, deleteErr := uc.roadmapClient.Delete(ctx, &roadmapclient.DeleteRequest{
-			Id: forkedRoadmap.Roadmap.Id,
-		}); deleteErr != nil {
-			logger.WithError(deleteErr).Error("failed to rollback roadmap creation")
-		}
-		logger.WithError(err).Error("failed to create forked roadmap info")
-		return nil, fmt.Errorf("failed to create forked roadmap info: %w", err)
+		logger.WithError(err).Error("failed to fork roadmap")
+		return nil, err
 	}
 
-	author := uc.fetchSingleUserData(ctx, createdRoadmapInfo.AuthorID)
-	roadmapInfoDTO := dto.RoadmapInfoToDTO(createdRoadmapInfo, author)
-
 	logger.WithFields(map[string]interface{}{
-		"forked_roadmap_info_id": createdRoadmapInfo.ID.String(),
-		"forked_roadmap_id":      createdRoadmapInfo.RoadmapID,
+		"forked_roadmap_info_id": forkedRoadmapInfo.ID,
+		"forked_roadmap_id":      forkedRoadmapInfo.RoadmapID,
 	}).Info("successfully forked roadmap info")
 
-	return &dto.CreatePrivateRoadmapInfoResponseDTO{RoadmapInfo: roadmapInfoDTO}, nil
+	return &dto.CreatePrivateRoadmapInfoResponseDTO{RoadmapInfo: *forkedRoadmapInfo}, nil
 }
 
-func (uc *RoadmapInfoUsecase) Publish(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID) (*dto.CreatePrivateRoadmapInfoResponseDTO, error) {
+func (uc *RoadmapInfoUsecase) Publish(ctx context.Context, roadmapInfoID uuid.UUID, userID uuid.UUID, carryProgress bool, idempotencyKey string) (*dto.CreatePrivateRoadmapInfoResponseDTO, error) {
 	const op = "RoadmapInfoUsecase.Publish"
 	logger := ctxutil.GetLogger(ctx).WithFields(map[string]interface{}{
 		"op":              op,
@@ -499,90 +465,89 @@ func (uc *RoadmapInfoUsecase) Publish(ctx context.Context, roadmapInfoID uuid.UU
 		return nil, fmt.Errorf("attempt to publish public roadmap")
 	}
 
-	if !uc.isUserOwner(originalRoadmapInfo, userID) {
+	role, err := uc.userRole(ctx, originalRoadmapInfo, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get user role")
+		return nil, fmt.Errorf("failed to get user role: %w", err)
+	}
+
+	if !role.CanManage() {
 		logger.WithFields(map[string]interface{}{
 			"request_user_id": userID.String(),
 			"author_id":       originalRoadmapInfo.AuthorID.String(),
-		}).Warn("user is not author of the roadmap info")
+			"role":            role,
+		}).Warn("user cannot manage the roadmap info")
 		return nil, errs.ErrForbidden
 	}
 
-	originalRoadmap, err := uc.roadmapClient.GetByIDWithMaterials(ctx, &roadmapclient.GetByIDWithMaterialsRequest{Id: originalRoadmapInfo.RoadmapID})
+	draftRelease, err := uc.repo.GetReleaseByDraftRoadmapInfoID(ctx, originalRoadmapInfo.ID)
 	if err != nil {
-		logger.WithError(err).Error("failed to get original roadmap")
-		return nil, fmt.Errorf("failed to get original roadmap: %w", err)
+		logger.WithError(err).Error("failed to get draft release")
+		return nil, fmt.Errorf("failed to get draft release: %w", err)
 	}
 
-	if originalRoadmap == nil || originalRoadmap.Roadmap == nil {
-		logger.Error("original roadmap is nil")
-		return nil, fmt.Errorf("failed to get original roadmap")
+	if draftRelease != nil {
+		logger.Warn("attempt to publish draft")
+		return nil, fmt.Errorf("attempt to publish a draft: submit it for review instead")
 	}
 
-	regeneratedRoadmap, err := uc.roadmapClient.RegenerateNodeIDs(ctx, &roadmapclient.RegenerateNodeIDsRequest{
-		Roadmap: originalRoadmap.Roadmap,
+	publishedRoadmapInfo, err := uc.runSaga(ctx, &entities.RoadmapSaga{
+		Kind:                entities.RoadmapSagaPublish,
+		UserID:              userID,
+		IdempotencyKey:      idempotencyKey,
+		SourceRoadmapInfoID: originalRoadmapInfo.ID,
+		CarryProgress:       carryProgress,
 	})
 	if err != nil {
-		logger.WithError(err).Error("failed to regenerate node IDs for published roadmap")
-		return nil, fmt.Errorf("failed to regenerate node IDs: %w", err)
+		logger.WithError(err).Error("failed to publish roadmap")
+		return nil, err
 	}
 
-	if regeneratedRoadmap == nil || regeneratedRoadmap.Roadmap == nil {
-		logger.Error("regenerated roadmap is nil")
-		return nil, fmt.Errorf("failed to regenerate node IDs")
-	}
+	logger.WithFields(map[string]interface{}{
+		"published_roadmap_info_id": publishedRoadmapInfo.ID,
+		"published_roadmap_id":      publishedRoadmapInfo.RoadmapID,
+	}).Info("successfully published roadmap info")
 
-	publishRoadmapRequest := &roadmapclient.CreateRequest{
-		Id:       primitive.NewObjectID().Hex(),
-		IsPublic: true,
-		AuthorId: userID.String(),
-		Nodes:    regeneratedRoadmap.Roadmap.Nodes,
-		Edges:    regeneratedRoadmap.Roadmap.Edges,
+	return &dto.CreatePrivateRoadmapInfoResponseDTO{RoadmapInfo: *publishedRoadmapInfo}, nil
+}
+
+// runSaga starts the saga and returns the roadmap info it created, or the
+// published one a release updated. Repeating a request with the same idempotency key returns the
+// result of the first one instead of making another copy.
+func (uc *RoadmapInfoUsecase) runSaga(ctx context.Context, saga *entities.RoadmapSaga) (*dto.RoadmapInfoDTO, error) {
+	if saga.IdempotencyKey == "" {
+		saga.IdempotencyKey = uuid.NewString()
 	}
 
-	publishedRoadmap, err := uc.roadmapClient.Create(ctx, publishRoadmapRequest)
-	if err != nil {
-		logger.WithError(err).Error("failed to create published roadmap")
-		return nil, fmt.Errorf("failed to create published roadmap: %w", err)
+	if len(saga.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("invalid idempotency key: must be at most %d characters", maxIdempotencyKeyLength)
 	}
 
-	if publishedRoadmap == nil || publishedRoadmap.Roadmap == nil {
-		logger.Error("published roadmap is nil")
-		return nil, fmt.Errorf("failed to create published roadmap")
+	result, err := uc.sagaUsecase.Start(ctx, saga)
+	if err != nil {
+		return nil, err
 	}
 
-	publishedRoadmapInfo := &entities.RoadmapInfo{
-		ID:                      uuid.New(),
-		RoadmapID:               publishedRoadmap.Roadmap.Id,
-		Name:                    originalRoadmapInfo.Name,
-		Description:             originalRoadmapInfo.Description,
-		CategoryID:              originalRoadmapInfo.CategoryID,
-		AuthorID:                userID,
-		IsPublic:                true,
-		ReferencedRoadmapInfoID: originalRoadmapInfo.ReferencedRoadmapInfoID,
-		CreatedAt:               originalRoadmapInfo.CreatedAt,
-		UpdatedAt:               originalRoadmapInfo.UpdatedAt,
+	if result.RoadmapInfoID == nil {
+		if result.Status == entities.RoadmapSagaCompensating || result.Status == entities.RoadmapSagaCompensated {
+			return nil, fmt.Errorf("failed to %s roadmap: %s", result.Kind, result.LastError)
+		}
+		return nil, fmt.Errorf("attempt to repeat a %s that is still in progress", result.Kind)
 	}
 
-	createdRoadmapInfo, err := uc.repo.Create(ctx, publishedRoadmapInfo)
+	roadmapInfo, err := uc.repo.GetByID(ctx, *result.RoadmapInfoID)
 	if err != nil {
-		if _, deleteErr := uc.roadmapClient.Delete(ctx, &roadmapclient.DeleteRequest{
-			Id: publishedRoadmap.Roadmap.Id,
-		}); deleteErr != nil {
-			logger.WithError(deleteErr).Error("failed to rollback roadmap creation")
-		}
-		logger.WithError(err).Error("failed to create published roadmap info")
-		return nil, fmt.Errorf("failed to create published roadmap info: %w", err)
+		return nil, fmt.Errorf("failed to get roadmap info: %w", err)
 	}
 
-	author := uc.fetchSingleUserData(ctx, createdRoadmapInfo.AuthorID)
-	roadmapInfoDTO := dto.RoadmapInfoToDTO(createdRoadmapInfo, author)
+	if roadmapInfo == nil {
+		return nil, errs.ErrNotFound
+	}
 
-	logger.WithFields(map[string]interface{}{
-		"published_roadmap_info_id": createdRoadmapInfo.ID.String(),
-		"published_roadmap_id":      createdRoadmapInfo.RoadmapID,
-	}).Info("successfully published roadmap info")
+	author := uc.fetchSingleUserData(ctx, roadmapInfo.AuthorID)
+	roadmapInfoDTO := dto.RoadmapInfoToDTO(roadmapInfo, author)
 
-	return &dto.CreatePrivateRoadmapInfoResponseDTO{RoadmapInfo: roadmapInfoDTO}, nil
+	return &roadmapInfoDTO, nil
 }
 
 func (uc *RoadmapInfoUsecase) Subscribe(ctx context.Context, roadmapInfoID, userID uuid.UUID) error {
@@ -609,6 +574,11 @@ func (uc *RoadmapInfoUsecase) Subscribe(ctx context.Context, roadmapInfoID, user
 		return errs.ErrForbidden
 	}
 
+	if roadmap.UnpublishedAt != nil {
+		logger.Warn("attempt to subscribe to unpublished roadmap")
+		return fmt.Errorf("attempt to subscribe to unpublished roadmap")
+	}
+
 	if roadmap.AuthorID == userID {
 		logger.Warn("attempt to subscribe to own roadmap")
 		return fmt.Errorf("cannot subscribe to your own roadmap")